@@ -0,0 +1,31 @@
+package access
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/access/access.go -destination=mocks/domain/access/mock_access.go -package=mocks
+type DomainItf interface {
+	GetRoles(ctx context.Context) ([]entity.Role, error)
+	GetRolePermissions(ctx context.Context, role entity.UserRole) ([]entity.RolePermission, error)
+	ReplaceRolePermissions(ctx context.Context, role entity.UserRole, permissions []entity.Permission) error
+}
+
+type access struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitAccessDomain(opt Option) DomainItf {
+	a := &access{
+		db: opt.DB,
+	}
+
+	return a
+}
@@ -0,0 +1,63 @@
+package access
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (a *access) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	db := pkg.GetTransactionFromCtx(ctx, a.db)
+
+	var roles []entity.Role
+	if err := db.WithContext(ctx).Order("id ASC").Find(&roles).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch roles")
+	}
+
+	return roles, nil
+}
+
+// GetRolePermissions returns the permissions granted to role, or those of
+// every role when role is empty.
+func (a *access) GetRolePermissions(ctx context.Context, role entity.UserRole) ([]entity.RolePermission, error) {
+	db := pkg.GetTransactionFromCtx(ctx, a.db)
+
+	query := db.WithContext(ctx).Model(&entity.RolePermission{})
+	if role != "" {
+		query = query.Where("role = ?", role)
+	}
+
+	var permissions []entity.RolePermission
+	if err := query.Order("permission ASC").Find(&permissions).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch role permissions")
+	}
+
+	return permissions, nil
+}
+
+func (a *access) ReplaceRolePermissions(ctx context.Context, role entity.UserRole, permissions []entity.Permission) error {
+	db := pkg.GetTransactionFromCtx(ctx, a.db)
+
+	if err := db.WithContext(ctx).Where("role = ?", role).Delete(&entity.RolePermission{}).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to clear role permissions")
+	}
+
+	if len(permissions) == 0 {
+		return nil
+	}
+
+	rows := make([]entity.RolePermission, 0, len(permissions))
+	for _, p := range permissions {
+		rows = append(rows, entity.RolePermission{Role: role, Permission: p})
+	}
+
+	if err := db.WithContext(ctx).Create(&rows).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to save role permissions")
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/access"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetRolePermissions(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "role_permissions" WHERE role = \$1 ORDER BY permission ASC`).
+		WithArgs("manager").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "role", "permission"}).
+			AddRow(1, "manager", "attendance:read_team").
+			AddRow(2, "manager", "employee:read"))
+
+	a := access.InitAccessDomain(access.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	result, err := a.GetRolePermissions(ctx, entity.RoleManager)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.RolePermission{
+		{ID: 1, Role: entity.RoleManager, Permission: entity.PermAttendanceReadTeam},
+		{ID: 2, Role: entity.RoleManager, Permission: entity.PermEmployeeRead},
+	}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaceRolePermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions []entity.Permission
+		mockSetup   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			name:        "replaces permissions",
+			permissions: []entity.Permission{entity.PermEmployeeRead, entity.PermTravelReview},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM "role_permissions" WHERE role = \$1`).
+					WithArgs("manager").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectQuery(`INSERT INTO "role_permissions"`).
+					WithArgs("manager", "employee:read", "manager", "travel:review").
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4).AddRow(5))
+			},
+		},
+		{
+			name: "clears permissions",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`DELETE FROM "role_permissions" WHERE role = \$1`).
+					WithArgs("manager").
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			a := access.InitAccessDomain(access.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := a.ReplaceRolePermissions(ctx, entity.RoleManager, tt.permissions)
+
+			assert.NoError(t, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
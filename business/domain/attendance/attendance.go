@@ -13,6 +13,7 @@ type DomainItf interface {
 	UpdateAttendance(ctx context.Context, data entity.UpdateAttendance) error
 
 	GetAttendance(ctx context.Context, filter entity.GetAttendance) ([]entity.Attendance, error)
+	GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error)
 
 	CreateOvertime(ctx context.Context, data entity.CreateOvertimeData) error
 	GetOvertime(ctx context.Context, filter entity.GetOvertimeFilter) ([]entity.Overtime, error)
@@ -20,6 +21,16 @@ type DomainItf interface {
 	CreateAttendancePeriod(ctx context.Context, data entity.AttendancePeriod) error
 	UpdateAttendancePeriod(ctx context.Context, data entity.UpdateAttendancePeriod) error
 	GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error)
+	CreateAttendancePeriodTransition(ctx context.Context, data entity.AttendancePeriodTransition) error
+	GetAttendancePeriodTransitions(ctx context.Context, filter entity.GetAttendancePeriodTransitionFilter) ([]entity.AttendancePeriodTransition, error)
+
+	CreateAttendanceCorrection(ctx context.Context, data entity.AttendanceCorrection) error
+	UpdateAttendanceCorrection(ctx context.Context, data entity.UpdateAttendanceCorrection) error
+	GetAttendanceCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error)
+
+	CreateAttendanceBreak(ctx context.Context, data entity.AttendanceBreak) error
+	UpdateAttendanceBreak(ctx context.Context, data entity.UpdateAttendanceBreak) error
+	GetAttendanceBreaks(ctx context.Context, filter entity.GetAttendanceBreakFilter) ([]entity.AttendanceBreak, error)
 }
 
 type attendance struct {
@@ -16,17 +16,46 @@ import (
 func (p *attendance) CreateAttendance(ctx context.Context, data entity.CreateAttendance) error {
 	db := pkg.GetTransactionFromCtx(ctx, p.db)
 	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// Default to a live check-in; corrections pass the requested time instead
+	checkIn := now
+	if !data.CheckInAt.IsZero() {
+		checkIn = data.CheckInAt
+	}
+	today := time.Date(checkIn.Year(), checkIn.Month(), checkIn.Day(), 0, 0, 0, 0, checkIn.Location())
+	if !data.Date.IsZero() {
+		today = time.Date(data.Date.Year(), data.Date.Month(), data.Date.Day(), 0, 0, 0, 0, data.Date.Location())
+	}
+
+	workMode := data.WorkMode
+	if workMode == "" {
+		workMode = entity.WorkModeOffice
+	}
 
 	// Insert new attendance
 	attendance := entity.Attendance{
 		UserID:             data.UserID,
 		Date:               today,
 		AttendancePeriodID: data.AttendancePeriodID,
-		CheckedInAt:        &now,
+		ShiftID:            data.ShiftID,
+		WorkMode:           workMode,
+		CheckedInAt:        &checkIn,
+		CheckedOutAt:       data.CheckOutAt,
+		WorkedMinutes:      data.WorkedMinutes,
+		LateMinutes:        data.LateMinutes,
+		EarlyLeaveMinutes:  data.EarlyLeaveMinutes,
+		CheckInLocationID:  data.CheckInLocationID,
+		CheckInKioskID:     data.CheckInKioskID,
+		OutsideGeofence:    data.OutsideGeofence,
 		CreatedAt:          now,
 	}
 
+	if data.CheckInPoint != nil {
+		attendance.CheckInLatitude = &data.CheckInPoint.Latitude
+		attendance.CheckInLongitude = &data.CheckInPoint.Longitude
+		attendance.CheckInAccuracy = &data.CheckInPoint.AccuracyMeters
+	}
+
 	if err := db.WithContext(ctx).Create(&attendance).Error; err != nil {
 		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to check in")
 	}
@@ -44,13 +73,51 @@ func (p *attendance) UpdateAttendance(ctx context.Context, data entity.UpdateAtt
 	updates := map[string]interface{}{}
 
 	if data.CheckInAt != nil {
-		updates["check_in_at"] = data.CheckInAt
+		updates["checked_in_at"] = data.CheckInAt
 	}
 
 	if data.CheckOutAt != nil {
 		updates["checked_out_at"] = data.CheckOutAt
 	}
 
+	if data.WorkedMinutes != nil {
+		updates["worked_minutes"] = *data.WorkedMinutes
+	}
+
+	if data.BreakMinutes != nil {
+		updates["break_minutes"] = *data.BreakMinutes
+	}
+
+	if data.LateMinutes != nil {
+		updates["late_minutes"] = *data.LateMinutes
+	}
+
+	if data.EarlyLeaveMinutes != nil {
+		updates["early_leave_minutes"] = *data.EarlyLeaveMinutes
+	}
+
+	if data.CheckOutPoint != nil {
+		updates["check_out_latitude"] = data.CheckOutPoint.Latitude
+		updates["check_out_longitude"] = data.CheckOutPoint.Longitude
+		updates["check_out_accuracy"] = data.CheckOutPoint.AccuracyMeters
+	}
+
+	if data.CheckOutLocationID != nil {
+		updates["check_out_location_id"] = *data.CheckOutLocationID
+	}
+
+	if data.CheckOutKioskID != nil {
+		updates["check_out_kiosk_id"] = *data.CheckOutKioskID
+	}
+
+	if data.OutsideGeofence != nil {
+		updates["outside_geofence"] = *data.OutsideGeofence
+	}
+
+	if data.AutoClosed != nil {
+		updates["auto_closed"] = *data.AutoClosed
+	}
+
 	if len(updates) == 0 {
 		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
 	}
@@ -117,6 +184,14 @@ func (p *attendance) GetOvertime(ctx context.Context, filter entity.GetOvertimeF
 		db = db.Where("date = ?", filter.Date)
 	}
 
+	if filter.StartDate != nil {
+		db = db.Where("date >= ?", filter.StartDate.Format("2006-01-02"))
+	}
+
+	if filter.EndDate != nil {
+		db = db.Where("date <= ?", filter.EndDate.Format("2006-01-02"))
+	}
+
 	// Order and execute
 	err := db.Order("date ASC").Find(&result).Error
 	if err != nil {
@@ -138,13 +213,33 @@ func (r *attendance) GetAttendance(ctx context.Context, filter entity.GetAttenda
 	}
 
 	if !filter.Date.IsZero() {
-		db = db.Where("DATE(checked_in_at) = ?", filter.Date.Format("2006-01-02"))
+		db = db.Where("date = ?", filter.Date.Format("2006-01-02"))
+	}
+
+	if filter.StartDate != nil {
+		db = db.Where("date >= ?", filter.StartDate.Format("2006-01-02"))
+	}
+
+	if filter.EndDate != nil {
+		db = db.Where("date <= ?", filter.EndDate.Format("2006-01-02"))
 	}
 
 	if filter.AttendancePeriodID > 0 {
 		db = db.Where("attendance_period_id = ?", filter.AttendancePeriodID)
 	}
 
+	if filter.Open {
+		db = db.Where("checked_out_at IS NULL")
+	}
+
+	if filter.WorkMode != "" {
+		db = db.Where("work_mode = ?", filter.WorkMode)
+	}
+
+	if filter.CheckedInBefore != nil {
+		db = db.Where("checked_in_at < ?", *filter.CheckedInBefore)
+	}
+
 	// Query execution
 	err := db.Order("checked_in_at ASC").Find(&att).Error
 	if err != nil {
@@ -191,15 +286,28 @@ func (r *attendance) UpdateAttendancePeriod(ctx context.Context, data entity.Upd
 		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
 	}
 
-	if err := db.WithContext(ctx).
+	query := db.WithContext(ctx).
 		Model(&entity.AttendancePeriod{}).
-		Where("id = ?", data.ID).
-		Updates(updates).Error; err != nil {
-		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to update attendance period")
+		Where("id = ?", data.ID)
+
+	// Status changes are guarded so two concurrent transitions cannot both apply
+	if data.ExpectedStatus != "" {
+		query = query.Where("status = ?", data.ExpectedStatus)
 	}
 
-	return nil
+	tx := query.Updates(updates)
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update attendance period")
+	}
 
+	if tx.RowsAffected == 0 {
+		if data.ExpectedStatus != "" {
+			return x.NewWithCode(http.StatusConflict, "attendance period was changed by someone else, please retry")
+		}
+		return x.NewWithCode(http.StatusNotFound, "attendance period not found")
+	}
+
+	return nil
 }
 
 func (r *attendance) GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error) {
@@ -214,6 +322,10 @@ func (r *attendance) GetAttendancePeriods(ctx context.Context, filter entity.Get
 		db = db.Where("status = ?", filter.Status)
 	}
 
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+
 	if filter.StartDate != nil {
 		db = db.Where("start_date >= ?", filter.StartDate)
 	}
@@ -230,6 +342,14 @@ func (r *attendance) GetAttendancePeriods(ctx context.Context, filter entity.Get
 		db = db.Where("start_date <= ? AND end_date >= ?", filter.ContainsDate, filter.ContainsDate)
 	}
 
+	if filter.OverlapStart != nil && filter.OverlapEnd != nil {
+		db = db.Where("start_date <= ? AND end_date >= ?", filter.OverlapEnd, filter.OverlapStart)
+	}
+
+	if filter.ExcludeID > 0 {
+		db = db.Where("id <> ?", filter.ExcludeID)
+	}
+
 	err := db.Order("start_date DESC").Find(&result).Error
 	if err != nil {
 		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch attendance periods")
@@ -237,3 +357,180 @@ func (r *attendance) GetAttendancePeriods(ctx context.Context, filter entity.Get
 
 	return result, nil
 }
+
+func (r *attendance) CreateAttendancePeriodTransition(ctx context.Context, data entity.AttendancePeriodTransition) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to record attendance period transition")
+	}
+	return nil
+}
+
+func (r *attendance) GetAttendancePeriodTransitions(ctx context.Context, filter entity.GetAttendancePeriodTransitionFilter) ([]entity.AttendancePeriodTransition, error) {
+	var result []entity.AttendancePeriodTransition
+	db := pkg.GetTransactionFromCtx(ctx, r.db).WithContext(ctx).Model(&entity.AttendancePeriodTransition{})
+
+	if filter.AttendancePeriodID > 0 {
+		db = db.Where("attendance_period_id = ?", filter.AttendancePeriodID)
+	}
+
+	err := db.Order("created_at ASC, id ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch attendance period transitions")
+	}
+
+	return result, nil
+}
+
+func (r *attendance) CreateAttendanceCorrection(ctx context.Context, data entity.AttendanceCorrection) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create attendance correction")
+	}
+	return nil
+}
+
+func (r *attendance) UpdateAttendanceCorrection(ctx context.Context, data entity.UpdateAttendanceCorrection) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	if data.ID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "attendance correction ID is required")
+	}
+
+	if data.Status == "" {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	updates := map[string]interface{}{
+		"status":      data.Status,
+		"reviewed_by": data.ReviewedBy,
+		"reviewed_at": data.ReviewedAt,
+		"review_note": data.ReviewNote,
+	}
+
+	if data.AttendanceID != nil {
+		updates["attendance_id"] = *data.AttendanceID
+	}
+	if data.OriginalCheckInAt != nil {
+		updates["original_check_in_at"] = *data.OriginalCheckInAt
+	}
+	if data.OriginalCheckOutAt != nil {
+		updates["original_check_out_at"] = *data.OriginalCheckOutAt
+	}
+
+	// Only pending corrections can be reviewed, so a concurrent review loses
+	tx := db.WithContext(ctx).
+		Model(&entity.AttendanceCorrection{}).
+		Where("id = ? AND status = ?", data.ID, entity.CorrectionStatusPending).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update attendance correction")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusConflict, "attendance correction was already reviewed")
+	}
+
+	return nil
+}
+
+func (r *attendance) GetAttendanceCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error) {
+	var result []entity.AttendanceCorrection
+	db := pkg.GetTransactionFromCtx(ctx, r.db).WithContext(ctx).Model(&entity.AttendanceCorrection{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.UserIDs) > 0 {
+		db = db.Where("user_id IN ?", filter.UserIDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if !filter.Date.IsZero() {
+		db = db.Where("date = ?", filter.Date.Format("2006-01-02"))
+	}
+
+	err := db.Order("created_at DESC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch attendance corrections")
+	}
+
+	return result, nil
+}
+
+func (p *attendance) CreateAttendanceBreak(ctx context.Context, data entity.AttendanceBreak) error {
+	db := pkg.GetTransactionFromCtx(ctx, p.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to start break")
+	}
+	return nil
+}
+
+func (p *attendance) UpdateAttendanceBreak(ctx context.Context, data entity.UpdateAttendanceBreak) error {
+	db := pkg.GetTransactionFromCtx(ctx, p.db)
+
+	// Only a break in progress can be ended
+	tx := db.WithContext(ctx).
+		Model(&entity.AttendanceBreak{}).
+		Where("id = ? AND ended_at IS NULL", data.ID).
+		Updates(map[string]interface{}{
+			"ended_at": data.EndedAt,
+		})
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to end break")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "no break in progress")
+	}
+
+	return nil
+}
+
+func (p *attendance) GetAttendanceBreaks(ctx context.Context, filter entity.GetAttendanceBreakFilter) ([]entity.AttendanceBreak, error) {
+	var result []entity.AttendanceBreak
+	db := pkg.GetTransactionFromCtx(ctx, p.db).WithContext(ctx).Model(&entity.AttendanceBreak{})
+
+	if filter.AttendanceID > 0 {
+		db = db.Where("attendance_id = ?", filter.AttendanceID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Open {
+		db = db.Where("ended_at IS NULL")
+	}
+
+	err := db.Order("started_at ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch breaks")
+	}
+
+	return result, nil
+}
+
+func (p *attendance) GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error) {
+	var result []entity.WorkModeSummary
+	db := pkg.GetTransactionFromCtx(ctx, p.db).WithContext(ctx).
+		Model(&entity.Attendance{}).
+		Select("user_id, work_mode, COUNT(*) AS days, COALESCE(SUM(worked_minutes), 0) AS worked_minutes").
+		Where("attendance_period_id = ?", filter.AttendancePeriodID)
+
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+
+	err := db.Group("user_id, work_mode").Order("user_id ASC, work_mode ASC").Scan(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to summarize work modes")
+	}
+
+	return result, nil
+}
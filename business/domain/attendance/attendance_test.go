@@ -66,7 +66,15 @@ func TestCreateAttendance(t *testing.T) {
 				} else {
 					mock.ExpectBegin()
 					mock.ExpectQuery(`INSERT INTO "attendances"`).
-						WithArgs(tt.input.UserID, today, tt.input.AttendancePeriodID, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+						WithArgs(
+							tt.input.UserID, today, tt.input.AttendancePeriodID,
+							sqlmock.AnyArg(), entity.WorkModeOffice, sqlmock.AnyArg(), sqlmock.AnyArg(), // shift, work mode, check-in, check-out
+							sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), // worked, break, late, early leave
+							sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), // check-in location and kiosk
+							sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), // check-out location and kiosk
+							sqlmock.AnyArg(), sqlmock.AnyArg(), // outside geofence, auto-closed
+							sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+						).
 						WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 					mock.ExpectCommit()
 				}
@@ -230,6 +238,36 @@ func TestUpdateAttendancePeriod(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "Status changed concurrently",
+			input: entity.UpdateAttendancePeriod{
+				ID:             1,
+				Status:         &status,
+				ExpectedStatus: entity.AttendancePeriodPayrollProcessing,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.UpdateAttendancePeriod) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_periods" SET .* WHERE id = \$3 AND status = \$4`).
+					WithArgs(status, sqlmock.AnyArg(), 1, entity.AttendancePeriodPayrollProcessing).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "attendance period was changed by someone else",
+		},
+		{
+			name: "Attendance period not found",
+			input: entity.UpdateAttendancePeriod{
+				ID:      9,
+				EndDate: &now,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.UpdateAttendancePeriod) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_periods"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "attendance period not found",
+		},
 		{
 			name: "Missing attendance period ID",
 			input: entity.UpdateAttendancePeriod{
@@ -407,6 +445,7 @@ func TestCreateAttendancePeriod(t *testing.T) {
 						input.StartDate,
 						input.EndDate,
 						input.Status,
+						nil,
 						sqlmock.AnyArg(),
 						sqlmock.AnyArg(),
 					).
@@ -588,6 +627,29 @@ func TestGetAttendance(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Open attendances checked in before cutoff",
+			filter: entity.GetAttendance{
+				Open:            true,
+				CheckedInBefore: pkg.TimePtr(now),
+			},
+			mockQuery: `SELECT \* FROM "attendances" WHERE checked_out_at IS NULL AND checked_in_at < \$1 ORDER BY checked_in_at ASC`,
+			mockRows: sqlmock.NewRows([]string{
+				"id", "user_id", "checked_in_at", "attendance_period_id", "created_at",
+			}).AddRow(
+				1, 1, now.Add(-20*time.Hour), 2, now,
+			),
+			expectError: false,
+			expectedData: []entity.Attendance{
+				{
+					ID:                 1,
+					UserID:             1,
+					CheckedInAt:        pkg.TimePtr(now.Add(-20 * time.Hour)),
+					AttendancePeriodID: 2,
+					CreatedAt:          now,
+				},
+			},
+		},
 		{
 			name: "No attendance found",
 			filter: entity.GetAttendance{
@@ -683,6 +745,18 @@ func TestGetAttendancePeriods(t *testing.T) {
 			expectError:  false,
 			expectedData: []entity.AttendancePeriod{},
 		},
+		{
+			name: "Overlapping another period",
+			filter: entity.GetAttendancePeriodFilter{
+				OverlapStart: &now,
+				OverlapEnd:   &now,
+				ExcludeID:    2,
+			},
+			mockQuery:    `SELECT \* FROM "attendance_periods" WHERE \(start_date <= \$1 AND end_date >= \$2\) AND id <> \$3 ORDER BY start_date DESC`,
+			mockRows:     sqlmock.NewRows([]string{"id", "status", "start_date", "end_date"}),
+			expectError:  false,
+			expectedData: []entity.AttendancePeriod{},
+		},
 		{
 			name: "Database error",
 			filter: entity.GetAttendancePeriodFilter{
@@ -721,3 +795,343 @@ func TestGetAttendancePeriods(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateAttendanceCorrection(t *testing.T) {
+	now := time.Now()
+	checkOut := now.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		input       entity.AttendanceCorrection
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "Success create correction",
+			input: entity.AttendanceCorrection{
+				UserID:              1,
+				AttendanceID:        pkg.UintPtr(10),
+				Date:                now,
+				Type:                entity.CorrectionMissingCheckOut,
+				RequestedCheckOutAt: &checkOut,
+				Reason:              "forgot to check out",
+				Status:              entity.CorrectionStatusPending,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO "attendance_corrections"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+			expectError: false,
+		},
+		{
+			name: "DB error on insert",
+			input: entity.AttendanceCorrection{
+				UserID: 1,
+				Type:   entity.CorrectionMissingCheckIn,
+				Reason: "forgot to check in",
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO "attendance_corrections"`).
+					WillReturnError(errors.New("insert error"))
+			},
+			expectError: true,
+			errorText:   "failed to create attendance correction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := a.CreateAttendanceCorrection(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateAttendanceCorrection(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateAttendanceCorrection
+		mockSetup   func(mock sqlmock.Sqlmock, input entity.UpdateAttendanceCorrection)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "Success approve with original values",
+			input: entity.UpdateAttendanceCorrection{
+				ID:                1,
+				Status:            entity.CorrectionStatusApproved,
+				AttendanceID:      pkg.UintPtr(5),
+				OriginalCheckInAt: &now,
+				ReviewedBy:        2,
+				ReviewedAt:        now,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.UpdateAttendanceCorrection) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_corrections" SET .* WHERE id = \$\d+ AND status = \$\d+`).
+					WithArgs(
+						*input.AttendanceID, *input.OriginalCheckInAt, sqlmock.AnyArg(),
+						input.ReviewedAt, input.ReviewedBy, input.Status, sqlmock.AnyArg(),
+						input.ID, entity.CorrectionStatusPending,
+					).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:        "Missing correction ID",
+			input:       entity.UpdateAttendanceCorrection{Status: entity.CorrectionStatusRejected},
+			expectError: true,
+			errorText:   "attendance correction ID is required",
+		},
+		{
+			name:        "Missing status",
+			input:       entity.UpdateAttendanceCorrection{ID: 1},
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+		{
+			name: "Already reviewed",
+			input: entity.UpdateAttendanceCorrection{
+				ID:         3,
+				Status:     entity.CorrectionStatusRejected,
+				ReviewedBy: 2,
+				ReviewedAt: now,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.UpdateAttendanceCorrection) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_corrections"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "attendance correction was already reviewed",
+		},
+		{
+			name: "DB error on update",
+			input: entity.UpdateAttendanceCorrection{
+				ID:         4,
+				Status:     entity.CorrectionStatusRejected,
+				ReviewedBy: 2,
+				ReviewedAt: now,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.UpdateAttendanceCorrection) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_corrections"`).
+					WillReturnError(errors.New("update failed"))
+			},
+			expectError: true,
+			errorText:   "failed to update attendance correction",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			if tt.mockSetup != nil {
+				tt.mockSetup(mock, tt.input)
+			}
+
+			a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := a.UpdateAttendanceCorrection(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetAttendanceCorrections(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		filter       entity.GetAttendanceCorrectionFilter
+		mockRows     *sqlmock.Rows
+		expectError  bool
+		expectedData []entity.AttendanceCorrection
+	}{
+		{
+			name:   "Success filter by user and status",
+			filter: entity.GetAttendanceCorrectionFilter{UserID: 1, Status: entity.CorrectionStatusPending},
+			mockRows: sqlmock.NewRows([]string{"id", "user_id", "type", "reason", "status", "created_at"}).
+				AddRow(1, 1, "missing_check_out", "forgot", "pending", now),
+			expectedData: []entity.AttendanceCorrection{
+				{ID: 1, UserID: 1, Type: entity.CorrectionMissingCheckOut, Reason: "forgot", Status: "pending", CreatedAt: now},
+			},
+		},
+		{
+			name:        "Database error",
+			filter:      entity.GetAttendanceCorrectionFilter{ID: 9},
+			mockRows:    nil,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			query := mock.ExpectQuery(`SELECT \* FROM "attendance_corrections" WHERE .* ORDER BY created_at DESC`)
+			if tt.mockRows != nil {
+				query.WillReturnRows(tt.mockRows)
+			} else {
+				query.WillReturnError(errors.New("db error"))
+			}
+
+			a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+			result, err := a.GetAttendanceCorrections(context.Background(), tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedData, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateAttendanceBreak(t *testing.T) {
+	endedAt := time.Date(2025, 6, 10, 12, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateAttendanceBreak
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "Success end break in progress",
+			input: entity.UpdateAttendanceBreak{ID: 4, EndedAt: endedAt},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_breaks" SET .* WHERE id = \$3 AND ended_at IS NULL`).
+					WithArgs(endedAt, sqlmock.AnyArg(), 4).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:  "Break already ended",
+			input: entity.UpdateAttendanceBreak{ID: 4, EndedAt: endedAt},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "attendance_breaks" SET`).
+					WithArgs(endedAt, sqlmock.AnyArg(), 4).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "no break in progress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := a.UpdateAttendanceBreak(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetAttendanceBreaks(t *testing.T) {
+	startedAt := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(`SELECT \* FROM "attendance_breaks" WHERE attendance_id = \$1 AND ended_at IS NULL ORDER BY started_at ASC`).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "attendance_id", "user_id", "started_at"}).
+			AddRow(4, 100, 1, startedAt))
+
+	a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+	result, err := a.GetAttendanceBreaks(context.Background(), entity.GetAttendanceBreakFilter{
+		AttendanceID: 100,
+		Open:         true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.AttendanceBreak{{ID: 4, AttendanceID: 100, UserID: 1, StartedAt: startedAt}}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetWorkModeSummary(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(`SELECT user_id, work_mode, COUNT\(\*\) AS days, COALESCE\(SUM\(worked_minutes\), 0\) AS worked_minutes FROM "attendances" WHERE attendance_period_id = \$1 GROUP BY user_id, work_mode ORDER BY user_id ASC, work_mode ASC`).
+		WithArgs(10).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "work_mode", "days", "worked_minutes"}).
+			AddRow(1, "office", 15, 6300).
+			AddRow(1, "wfh", 4, 1680))
+
+	a := attendance.InitAttendanceDomain(attendance.Option{DB: db})
+	result, err := a.GetWorkModeSummary(context.Background(), entity.GetWorkModeSummaryFilter{AttendancePeriodID: 10})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.WorkModeSummary{
+		{UserID: 1, WorkMode: entity.WorkModeOffice, Days: 15, WorkedMinutes: 6300},
+		{UserID: 1, WorkMode: entity.WorkModeWFH, Days: 4, WorkedMinutes: 1680},
+	}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,35 @@
+package device
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/device/device.go -destination=mocks/domain/device/mock_device.go -package=mocks
+type DomainItf interface {
+	CreateDevice(ctx context.Context, data entity.MobileDevice) (*entity.MobileDevice, error)
+	UpdateDevice(ctx context.Context, data entity.UpdateDevice) error
+	GetDevices(ctx context.Context, filter entity.GetDeviceFilter) ([]entity.MobileDevice, error)
+
+	// CreateSyncEvent returns 409 when the device already stored the event
+	CreateSyncEvent(ctx context.Context, data entity.SyncEvent) error
+	GetSyncEvents(ctx context.Context, filter entity.GetSyncEventFilter) ([]entity.SyncEvent, error)
+}
+
+type device struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitDeviceDomain(opt Option) DomainItf {
+	d := &device{
+		db: opt.DB,
+	}
+
+	return d
+}
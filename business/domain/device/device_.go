@@ -0,0 +1,109 @@
+package device
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"gorm.io/gorm/clause"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (d *device) CreateDevice(ctx context.Context, data entity.MobileDevice) (*entity.MobileDevice, error) {
+	db := pkg.GetTransactionFromCtx(ctx, d.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to register device")
+	}
+	return &data, nil
+}
+
+func (d *device) UpdateDevice(ctx context.Context, data entity.UpdateDevice) error {
+	db := pkg.GetTransactionFromCtx(ctx, d.db)
+
+	if data.ID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "device ID is required")
+	}
+
+	updates := map[string]interface{}{}
+
+	if data.Active != nil {
+		updates["active"] = *data.Active
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.MobileDevice{}).
+		Where("id = ? AND user_id = ?", data.ID, data.UserID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update device")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "device not found")
+	}
+
+	return nil
+}
+
+func (d *device) GetDevices(ctx context.Context, filter entity.GetDeviceFilter) ([]entity.MobileDevice, error) {
+	var result []entity.MobileDevice
+	db := pkg.GetTransactionFromCtx(ctx, d.db).WithContext(ctx).Model(&entity.MobileDevice{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Active != nil {
+		db = db.Where("active = ?", *filter.Active)
+	}
+
+	err := db.Order("id ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch devices")
+	}
+
+	return result, nil
+}
+
+func (d *device) CreateSyncEvent(ctx context.Context, data entity.SyncEvent) error {
+	db := pkg.GetTransactionFromCtx(ctx, d.db)
+
+	// The device and client ID are unique, so a second copy of the event is skipped
+	res := db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&data)
+	if res.Error != nil {
+		return x.WrapWithCode(res.Error, http.StatusInternalServerError, "failed to store sync event")
+	}
+	if res.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusConflict, "sync event was already applied")
+	}
+	return nil
+}
+
+func (d *device) GetSyncEvents(ctx context.Context, filter entity.GetSyncEventFilter) ([]entity.SyncEvent, error) {
+	var result []entity.SyncEvent
+	db := pkg.GetTransactionFromCtx(ctx, d.db).WithContext(ctx).Model(&entity.SyncEvent{})
+
+	if filter.DeviceID > 0 {
+		db = db.Where("device_id = ?", filter.DeviceID)
+	}
+	if filter.ClientID != "" {
+		db = db.Where("client_id = ?", filter.ClientID)
+	}
+
+	err := db.Order("id ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch sync events")
+	}
+
+	return result, nil
+}
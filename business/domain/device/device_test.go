@@ -0,0 +1,141 @@
+package device_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/device"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestUpdateDevice(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdateDevice
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "revoke own device",
+			input: entity.UpdateDevice{
+				ID:     5,
+				UserID: 1,
+				Active: pkg.BoolPtr(false),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "mobile_devices" SET .* WHERE id = \$3 AND user_id = \$4`).
+					WithArgs(false, sqlmock.AnyArg(), 5, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name: "device of another user",
+			input: entity.UpdateDevice{
+				ID:     5,
+				UserID: 2,
+				Active: pkg.BoolPtr(false),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "mobile_devices" SET`).
+					WithArgs(false, sqlmock.AnyArg(), 5, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "device not found",
+		},
+		{
+			name:        "no updates",
+			input:       entity.UpdateDevice{ID: 5, UserID: 1},
+			mockSetup:   func(mock sqlmock.Sqlmock) { mock.ExpectBegin() },
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			d := device.InitDeviceDomain(device.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.UpdateDevice(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCreateSyncEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "new event",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO "sync_events" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+		},
+		{
+			name: "event stored by a concurrent sync",
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`INSERT INTO "sync_events" .* ON CONFLICT DO NOTHING RETURNING "id"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			expectError: true,
+			errorText:   "sync event was already applied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			d := device.InitDeviceDomain(device.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.CreateSyncEvent(ctx, entity.SyncEvent{UserID: 1, DeviceID: 5, ClientID: "a", Type: entity.SyncCheckIn})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
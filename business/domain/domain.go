@@ -1,11 +1,29 @@
 package domain
 
 import (
+	"github.com/redis/go-redis/v9"
+	"github.com/zuhrulumam/go-hris/business/domain/access"
 	"github.com/zuhrulumam/go-hris/business/domain/attendance"
+	"github.com/zuhrulumam/go-hris/business/domain/device"
+	"github.com/zuhrulumam/go-hris/business/domain/holiday"
+	"github.com/zuhrulumam/go-hris/business/domain/kiosk"
+	"github.com/zuhrulumam/go-hris/business/domain/location"
+	"github.com/zuhrulumam/go-hris/business/domain/mailer"
+	"github.com/zuhrulumam/go-hris/business/domain/notification"
+	"github.com/zuhrulumam/go-hris/business/domain/organization"
 	"github.com/zuhrulumam/go-hris/business/domain/payslip"
 	"github.com/zuhrulumam/go-hris/business/domain/reimbursement"
+	"github.com/zuhrulumam/go-hris/business/domain/security"
+	"github.com/zuhrulumam/go-hris/business/domain/session"
+	"github.com/zuhrulumam/go-hris/business/domain/shift"
+	"github.com/zuhrulumam/go-hris/business/domain/signingkey"
+	"github.com/zuhrulumam/go-hris/business/domain/sso"
+	"github.com/zuhrulumam/go-hris/business/domain/timeclock"
 	"github.com/zuhrulumam/go-hris/business/domain/transaction"
+	"github.com/zuhrulumam/go-hris/business/domain/travel"
+	"github.com/zuhrulumam/go-hris/business/domain/twofactor"
 	"github.com/zuhrulumam/go-hris/business/domain/user"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"gorm.io/gorm"
 )
 
@@ -15,10 +33,29 @@ type Domain struct {
 	Reimbursement reimbursement.DomainItf
 	Payslip       payslip.DomainItf
 	User          user.DomainItf
+	Shift         shift.DomainItf
+	Location      location.DomainItf
+	Kiosk         kiosk.DomainItf
+	TimeClock     timeclock.DomainItf
+	Device        device.DomainItf
+	Notification  notification.DomainItf
+	Travel        travel.DomainItf
+	Holiday       holiday.DomainItf
+	Organization  organization.DomainItf
+	Access        access.DomainItf
+	Session       session.DomainItf
+	SigningKey    signingkey.DomainItf
+	TwoFactor     twofactor.DomainItf
+	Security      security.DomainItf
+	Mailer        mailer.DomainItf
+	SSO           sso.DomainItf
 }
 
 type Option struct {
-	DB *gorm.DB
+	DB    *gorm.DB
+	Redis *redis.Client
+	SMTP  entity.SMTPConfig
+	OIDC  entity.OIDCConfig
 }
 
 func Init(opt Option) *Domain {
@@ -38,6 +75,57 @@ func Init(opt Option) *Domain {
 		User: user.InitUserDomain(user.Option{
 			DB: opt.DB,
 		}),
+		Shift: shift.InitShiftDomain(shift.Option{
+			DB: opt.DB,
+		}),
+		Location: location.InitLocationDomain(location.Option{
+			DB: opt.DB,
+		}),
+		Kiosk: kiosk.InitKioskDomain(kiosk.Option{
+			DB: opt.DB,
+		}),
+		TimeClock: timeclock.InitTimeClockDomain(timeclock.Option{
+			DB: opt.DB,
+		}),
+		Device: device.InitDeviceDomain(device.Option{
+			DB: opt.DB,
+		}),
+		Notification: notification.InitNotificationDomain(notification.Option{
+			DB: opt.DB,
+		}),
+		Travel: travel.InitTravelDomain(travel.Option{
+			DB: opt.DB,
+		}),
+		Holiday: holiday.InitHolidayDomain(holiday.Option{
+			DB: opt.DB,
+		}),
+		Organization: organization.InitOrganizationDomain(organization.Option{
+			DB: opt.DB,
+		}),
+		Access: access.InitAccessDomain(access.Option{
+			DB: opt.DB,
+		}),
+		Session: session.InitSessionDomain(session.Option{
+			DB:    opt.DB,
+			Redis: opt.Redis,
+		}),
+		SigningKey: signingkey.InitSigningKeyDomain(signingkey.Option{
+			DB: opt.DB,
+		}),
+		TwoFactor: twofactor.InitTwoFactorDomain(twofactor.Option{
+			DB: opt.DB,
+		}),
+		Security: security.InitSecurityDomain(security.Option{
+			DB:    opt.DB,
+			Redis: opt.Redis,
+		}),
+		Mailer: mailer.InitMailerDomain(mailer.Option{
+			SMTP: opt.SMTP,
+		}),
+		SSO: sso.InitSSODomain(sso.Option{
+			DB:   opt.DB,
+			OIDC: opt.OIDC,
+		}),
 	}
 
 	return d
@@ -0,0 +1,31 @@
+package holiday
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/holiday/holiday.go -destination=mocks/domain/holiday/mock_holiday.go -package=mocks
+type DomainItf interface {
+	CreateHoliday(ctx context.Context, data entity.Holiday) error
+	DeleteHoliday(ctx context.Context, id uint) error
+	GetHolidays(ctx context.Context, filter entity.GetHolidayFilter) ([]entity.Holiday, error)
+}
+
+type holiday struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitHolidayDomain(opt Option) DomainItf {
+	h := &holiday{
+		db: opt.DB,
+	}
+
+	return h
+}
@@ -0,0 +1,53 @@
+package holiday
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (h *holiday) CreateHoliday(ctx context.Context, data entity.Holiday) error {
+	db := pkg.GetTransactionFromCtx(ctx, h.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create holiday")
+	}
+	return nil
+}
+
+func (h *holiday) DeleteHoliday(ctx context.Context, id uint) error {
+	db := pkg.GetTransactionFromCtx(ctx, h.db)
+
+	tx := db.WithContext(ctx).Where("id = ?", id).Delete(&entity.Holiday{})
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to delete holiday")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "holiday not found")
+	}
+
+	return nil
+}
+
+func (h *holiday) GetHolidays(ctx context.Context, filter entity.GetHolidayFilter) ([]entity.Holiday, error) {
+	var result []entity.Holiday
+	db := pkg.GetTransactionFromCtx(ctx, h.db).WithContext(ctx).Model(&entity.Holiday{})
+
+	if filter.StartDate != nil {
+		db = db.Where("date >= ?", filter.StartDate.Format("2006-01-02"))
+	}
+	if filter.EndDate != nil {
+		db = db.Where("date <= ?", filter.EndDate.Format("2006-01-02"))
+	}
+
+	err := db.Order("date ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch holidays")
+	}
+
+	return result, nil
+}
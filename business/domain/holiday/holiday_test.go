@@ -0,0 +1,85 @@
+package holiday_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/holiday"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetHolidays(t *testing.T) {
+	start := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC)
+
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(`SELECT \* FROM "holidays" WHERE date >= \$1 AND date <= \$2 ORDER BY date ASC`).
+		WithArgs("2025-08-01", "2025-08-31").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "date", "name"}).
+			AddRow(1, day, "Independence Day"))
+
+	d := holiday.InitHolidayDomain(holiday.Option{DB: db})
+	result, err := d.GetHolidays(context.Background(), entity.GetHolidayFilter{
+		StartDate: &start,
+		EndDate:   &end,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.Holiday{{ID: 1, Date: day, Name: "Independence Day"}}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteHoliday(t *testing.T) {
+	tests := []struct {
+		name        string
+		rows        int64
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "deleted",
+			rows: 1,
+		},
+		{
+			name:        "not found",
+			rows:        0,
+			expectError: true,
+			errorText:   "holiday not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`DELETE FROM "holidays" WHERE id = \$1`).
+				WithArgs(3).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			d := holiday.InitHolidayDomain(holiday.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.DeleteHoliday(ctx, 3)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package kiosk
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/kiosk/kiosk.go -destination=mocks/domain/kiosk/mock_kiosk.go -package=mocks
+type DomainItf interface {
+	CreateKiosk(ctx context.Context, data entity.Kiosk) (*entity.Kiosk, error)
+	UpdateKiosk(ctx context.Context, data entity.UpdateKiosk) error
+	GetKiosks(ctx context.Context, filter entity.GetKioskFilter) ([]entity.Kiosk, error)
+
+	CreateKioskScan(ctx context.Context, data entity.KioskScan) error
+	CountKioskScans(ctx context.Context, filter entity.GetKioskScanFilter) (int64, error)
+}
+
+type kiosk struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitKioskDomain(opt Option) DomainItf {
+	k := &kiosk{
+		db: opt.DB,
+	}
+
+	return k
+}
@@ -0,0 +1,104 @@
+package kiosk
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (k *kiosk) CreateKiosk(ctx context.Context, data entity.Kiosk) (*entity.Kiosk, error) {
+	db := pkg.GetTransactionFromCtx(ctx, k.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to register kiosk")
+	}
+	return &data, nil
+}
+
+func (k *kiosk) UpdateKiosk(ctx context.Context, data entity.UpdateKiosk) error {
+	db := pkg.GetTransactionFromCtx(ctx, k.db)
+
+	if data.ID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "kiosk ID is required")
+	}
+
+	updates := map[string]interface{}{}
+
+	if data.Name != nil {
+		updates["name"] = *data.Name
+	}
+	if data.Active != nil {
+		updates["active"] = *data.Active
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.Kiosk{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update kiosk")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "kiosk not found")
+	}
+
+	return nil
+}
+
+func (k *kiosk) GetKiosks(ctx context.Context, filter entity.GetKioskFilter) ([]entity.Kiosk, error) {
+	var result []entity.Kiosk
+	db := pkg.GetTransactionFromCtx(ctx, k.db).WithContext(ctx).Model(&entity.Kiosk{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.Active != nil {
+		db = db.Where("active = ?", *filter.Active)
+	}
+
+	err := db.Order("id ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch kiosks")
+	}
+
+	return result, nil
+}
+
+func (k *kiosk) CreateKioskScan(ctx context.Context, data entity.KioskScan) error {
+	db := pkg.GetTransactionFromCtx(ctx, k.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to record kiosk scan")
+	}
+	return nil
+}
+
+func (k *kiosk) CountKioskScans(ctx context.Context, filter entity.GetKioskScanFilter) (int64, error) {
+	var count int64
+	db := pkg.GetTransactionFromCtx(ctx, k.db).WithContext(ctx).Model(&entity.KioskScan{})
+
+	if filter.KioskID > 0 {
+		db = db.Where("kiosk_id = ?", filter.KioskID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Nonce != "" {
+		db = db.Where("nonce = ?", filter.Nonce)
+	}
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check kiosk scans")
+	}
+
+	return count, nil
+}
@@ -0,0 +1,149 @@
+package kiosk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/kiosk"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestUpdateKiosk(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdateKiosk
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "deactivate kiosk",
+			input: entity.UpdateKiosk{
+				ID:     1,
+				Active: pkg.BoolPtr(false),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "kiosks" SET`).
+					WithArgs(false, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:        "no updates",
+			input:       entity.UpdateKiosk{ID: 1},
+			mockSetup:   func(mock sqlmock.Sqlmock) { mock.ExpectBegin() },
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+		{
+			name: "kiosk not found",
+			input: entity.UpdateKiosk{
+				ID:   9,
+				Name: pkg.StringPtr("Lobby"),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "kiosks" SET`).
+					WithArgs("Lobby", sqlmock.AnyArg(), 9).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "kiosk not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			k := kiosk.InitKioskDomain(kiosk.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := k.UpdateKiosk(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCountKioskScans(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      entity.GetKioskScanFilter
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		expectCount int64
+	}{
+		{
+			name: "token already scanned",
+			filter: entity.GetKioskScanFilter{
+				KioskID: 1,
+				UserID:  7,
+				Nonce:   "abc",
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "kiosk_scans" WHERE kiosk_id = \$1 AND user_id = \$2 AND nonce = \$3`).
+					WithArgs(1, 7, "abc").
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expectError: false,
+			expectCount: 1,
+		},
+		{
+			name:   "db error",
+			filter: entity.GetKioskScanFilter{KioskID: 1},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "kiosk_scans"`).
+					WillReturnError(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			k := kiosk.InitKioskDomain(kiosk.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			count, err := k.CountKioskScans(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectCount, count)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package location
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/location/location.go -destination=mocks/domain/location/mock_location.go -package=mocks
+type DomainItf interface {
+	CreateOfficeLocation(ctx context.Context, data entity.OfficeLocation) error
+	UpdateOfficeLocation(ctx context.Context, data entity.UpdateOfficeLocation) error
+	GetOfficeLocations(ctx context.Context, filter entity.GetOfficeLocationFilter) ([]entity.OfficeLocation, error)
+}
+
+type location struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitLocationDomain(opt Option) DomainItf {
+	l := &location{
+		db: opt.DB,
+	}
+
+	return l
+}
@@ -0,0 +1,84 @@
+package location
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (l *location) CreateOfficeLocation(ctx context.Context, data entity.OfficeLocation) error {
+	db := pkg.GetTransactionFromCtx(ctx, l.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create office location")
+	}
+	return nil
+}
+
+func (l *location) UpdateOfficeLocation(ctx context.Context, data entity.UpdateOfficeLocation) error {
+	db := pkg.GetTransactionFromCtx(ctx, l.db)
+
+	if data.ID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "office location ID is required")
+	}
+
+	updates := map[string]interface{}{}
+
+	if data.Name != nil {
+		updates["name"] = *data.Name
+	}
+	if data.Latitude != nil {
+		updates["latitude"] = *data.Latitude
+	}
+	if data.Longitude != nil {
+		updates["longitude"] = *data.Longitude
+	}
+	if data.RadiusMeters != nil {
+		updates["radius_meters"] = *data.RadiusMeters
+	}
+	if data.Active != nil {
+		updates["active"] = *data.Active
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.OfficeLocation{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update office location")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "office location not found")
+	}
+
+	return nil
+}
+
+func (l *location) GetOfficeLocations(ctx context.Context, filter entity.GetOfficeLocationFilter) ([]entity.OfficeLocation, error) {
+	var result []entity.OfficeLocation
+	db := pkg.GetTransactionFromCtx(ctx, l.db).WithContext(ctx).Model(&entity.OfficeLocation{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.Active != nil {
+		db = db.Where("active = ?", *filter.Active)
+	}
+
+	err := db.Order("name ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch office locations")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/location"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestUpdateOfficeLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdateOfficeLocation
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "deactivate office",
+			input: entity.UpdateOfficeLocation{
+				ID:     1,
+				Active: pkg.BoolPtr(false),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "office_locations" SET`).
+					WithArgs(false, sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:        "no updates",
+			input:       entity.UpdateOfficeLocation{ID: 1},
+			mockSetup:   func(mock sqlmock.Sqlmock) { mock.ExpectBegin() },
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+		{
+			name: "office not found",
+			input: entity.UpdateOfficeLocation{
+				ID:           9,
+				RadiusMeters: pkg.Float64Ptr(150),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "office_locations" SET`).
+					WithArgs(float64(150), sqlmock.AnyArg(), 9).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "office location not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			l := location.InitLocationDomain(location.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := l.UpdateOfficeLocation(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetOfficeLocations(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      entity.GetOfficeLocationFilter
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		expectLen   int
+	}{
+		{
+			name:   "active offices only",
+			filter: entity.GetOfficeLocationFilter{Active: pkg.BoolPtr(true)},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "office_locations" WHERE active = \$1 ORDER BY name ASC`).
+					WithArgs(true).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "latitude", "longitude", "radius_meters", "active"}).
+						AddRow(1, "HQ", -6.175392, 106.827153, 100, true))
+			},
+			expectError: false,
+			expectLen:   1,
+		},
+		{
+			name:   "db error",
+			filter: entity.GetOfficeLocationFilter{},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "office_locations"`).
+					WillReturnError(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			l := location.InitLocationDomain(location.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			result, err := l.GetOfficeLocations(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectLen)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package mailer
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+//go:generate mockgen -source=business/domain/mailer/mailer.go -destination=mocks/domain/mailer/mock_mailer.go -package=mocks
+type DomainItf interface {
+	Send(ctx context.Context, email entity.Email) error
+}
+
+type Option struct {
+	SMTP entity.SMTPConfig
+}
+
+// InitMailerDomain sends through the SMTP server when one is configured.
+// Otherwise emails are dropped with a log line, which is enough for local
+// development and tests.
+func InitMailerDomain(opt Option) DomainItf {
+	if opt.SMTP.Host == "" {
+		return &logMailer{}
+	}
+
+	return &smtpMailer{
+		cfg: opt.SMTP,
+	}
+}
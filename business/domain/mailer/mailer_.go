@@ -0,0 +1,66 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+type smtpMailer struct {
+	cfg entity.SMTPConfig
+}
+
+func (m *smtpMailer) Send(ctx context.Context, email entity.Email) error {
+	addr := net.JoinHostPort(m.cfg.Host, strconv.Itoa(m.cfg.Port))
+
+	var auth smtp.Auth
+	if m.cfg.Username != "" {
+		auth = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, m.cfg.Host)
+	}
+
+	if err := smtp.SendMail(addr, auth, m.cfg.From, []string{email.To}, m.message(email)); err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to send email")
+	}
+
+	return nil
+}
+
+func (m *smtpMailer) message(email entity.Email) []byte {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, "From: %s\r\n", headerValue(m.cfg.From))
+	fmt.Fprintf(&b, "To: %s\r\n", headerValue(email.To))
+	fmt.Fprintf(&b, "Subject: %s\r\n", headerValue(email.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+
+	body := strings.ReplaceAll(email.Body, "\r\n", "\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+
+	return b.Bytes()
+}
+
+// headerValue keeps user data from adding headers of its own.
+func headerValue(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
+
+type logMailer struct{}
+
+func (m *logMailer) Send(ctx context.Context, email entity.Email) error {
+	log.Printf("SMTP is not configured, email %q to %s was not sent", email.Subject, email.To)
+	return nil
+}
@@ -0,0 +1,132 @@
+package mailer_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/mailer"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+// fakeSMTP accepts a single message on a local port and hands over the
+// envelope and data once the client quits.
+type fakeSMTP struct {
+	host     string
+	port     int
+	received chan smtpMessage
+}
+
+type smtpMessage struct {
+	From string
+	To   []string
+	Data string
+}
+
+func startFakeSMTP(t *testing.T) *fakeSMTP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+
+	s := &fakeSMTP{host: host, port: p, received: make(chan smtpMessage, 1)}
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+
+		var msg smtpMessage
+		reply("220 localhost ESMTP")
+
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.TrimRight(line, "\r\n")
+
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				msg.From = strings.Trim(strings.TrimPrefix(cmd, "MAIL FROM:"), "<>")
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				msg.To = append(msg.To, strings.Trim(strings.TrimPrefix(cmd, "RCPT TO:"), "<>"))
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 End data with <CR><LF>.<CR><LF>")
+
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				msg.Data = data.String()
+				reply("250 OK")
+			case cmd == "QUIT":
+				reply("221 Bye")
+				s.received <- msg
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+
+	return s
+}
+
+func TestSend_SMTP(t *testing.T) {
+	server := startFakeSMTP(t)
+
+	m := mailer.InitMailerDomain(mailer.Option{SMTP: entity.SMTPConfig{
+		Host: server.host,
+		Port: server.port,
+		From: "hris@example.com",
+	}})
+
+	err := m.Send(context.Background(), entity.Email{
+		To:      "jdoe@example.com",
+		Subject: "Reset your password\r\nBcc: someone@example.com",
+		Body:    "Open the link:\nhttps://hris.example.com/reset?token=abc",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	msg := <-server.received
+	assert.Equal(t, "hris@example.com", msg.From)
+	assert.Equal(t, []string{"jdoe@example.com"}, msg.To)
+	assert.Contains(t, msg.Data, "To: jdoe@example.com\r\n")
+	assert.Contains(t, msg.Data, "Subject: Reset your passwordBcc: someone@example.com\r\n")
+	assert.Contains(t, msg.Data, "\r\n\r\nOpen the link:\r\nhttps://hris.example.com/reset?token=abc\r\n")
+	assert.NotContains(t, msg.Data, "\r\nBcc:")
+}
+
+func TestSend_NoSMTP(t *testing.T) {
+	m := mailer.InitMailerDomain(mailer.Option{})
+
+	err := m.Send(context.Background(), entity.Email{To: "jdoe@example.com", Subject: "Hello"})
+	assert.NoError(t, err)
+}
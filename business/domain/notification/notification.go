@@ -0,0 +1,31 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/notification/notification.go -destination=mocks/domain/notification/mock_notification.go -package=mocks
+type DomainItf interface {
+	CreateNotification(ctx context.Context, data entity.Notification) error
+	GetNotifications(ctx context.Context, filter entity.GetNotificationFilter) ([]entity.Notification, error)
+	MarkNotificationRead(ctx context.Context, data entity.MarkNotificationRead) error
+}
+
+type notification struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitNotificationDomain(opt Option) DomainItf {
+	n := &notification{
+		db: opt.DB,
+	}
+
+	return n
+}
@@ -0,0 +1,58 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (n *notification) CreateNotification(ctx context.Context, data entity.Notification) error {
+	db := pkg.GetTransactionFromCtx(ctx, n.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create notification")
+	}
+	return nil
+}
+
+func (n *notification) GetNotifications(ctx context.Context, filter entity.GetNotificationFilter) ([]entity.Notification, error) {
+	var result []entity.Notification
+	db := pkg.GetTransactionFromCtx(ctx, n.db).WithContext(ctx).Model(&entity.Notification{})
+
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Unread {
+		db = db.Where("read_at IS NULL")
+	}
+
+	err := db.Order("created_at DESC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch notifications")
+	}
+
+	return result, nil
+}
+
+func (n *notification) MarkNotificationRead(ctx context.Context, data entity.MarkNotificationRead) error {
+	db := pkg.GetTransactionFromCtx(ctx, n.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.Notification{}).
+		Where("id = ? AND user_id = ? AND read_at IS NULL", data.ID, data.UserID).
+		Update("read_at", time.Now())
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to mark notification as read")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "unread notification not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/notification"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestMarkNotificationRead(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.MarkNotificationRead
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "mark own unread notification",
+			input: entity.MarkNotificationRead{ID: 3, UserID: 1},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "notifications" SET .* WHERE id = \$2 AND user_id = \$3 AND read_at IS NULL`).
+					WithArgs(sqlmock.AnyArg(), 3, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:  "already read or another user's notification",
+			input: entity.MarkNotificationRead{ID: 3, UserID: 2},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "notifications" SET`).
+					WithArgs(sqlmock.AnyArg(), 3, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "unread notification not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			d := notification.InitNotificationDomain(notification.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.MarkNotificationRead(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
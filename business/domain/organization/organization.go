@@ -0,0 +1,35 @@
+package organization
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/organization/organization.go -destination=mocks/domain/organization/mock_organization.go -package=mocks
+type DomainItf interface {
+	CreateDepartment(ctx context.Context, data entity.Department) error
+	UpdateDepartment(ctx context.Context, data entity.UpdateDepartment) error
+	GetDepartments(ctx context.Context, filter entity.GetDepartmentFilter) ([]entity.Department, error)
+
+	CreatePosition(ctx context.Context, data entity.Position) error
+	UpdatePosition(ctx context.Context, data entity.UpdatePosition) error
+	GetPositions(ctx context.Context, filter entity.GetPositionFilter) ([]entity.Position, error)
+}
+
+type organization struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitOrganizationDomain(opt Option) DomainItf {
+	o := &organization{
+		db: opt.DB,
+	}
+
+	return o
+}
@@ -0,0 +1,127 @@
+package organization
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (o *organization) CreateDepartment(ctx context.Context, data entity.Department) error {
+	db := pkg.GetTransactionFromCtx(ctx, o.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create department")
+	}
+	return nil
+}
+
+func (o *organization) UpdateDepartment(ctx context.Context, data entity.UpdateDepartment) error {
+	db := pkg.GetTransactionFromCtx(ctx, o.db)
+
+	updates := map[string]interface{}{}
+
+	if data.Name != nil {
+		updates["name"] = *data.Name
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.Department{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update department")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "department not found")
+	}
+
+	return nil
+}
+
+func (o *organization) GetDepartments(ctx context.Context, filter entity.GetDepartmentFilter) ([]entity.Department, error) {
+	var result []entity.Department
+	db := pkg.GetTransactionFromCtx(ctx, o.db).WithContext(ctx).Model(&entity.Department{})
+
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.Name != "" {
+		db = db.Where("LOWER(name) = LOWER(?)", filter.Name)
+	}
+
+	if err := db.Order("name ASC").Find(&result).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch departments")
+	}
+
+	return result, nil
+}
+
+func (o *organization) CreatePosition(ctx context.Context, data entity.Position) error {
+	db := pkg.GetTransactionFromCtx(ctx, o.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create position")
+	}
+	return nil
+}
+
+func (o *organization) UpdatePosition(ctx context.Context, data entity.UpdatePosition) error {
+	db := pkg.GetTransactionFromCtx(ctx, o.db)
+
+	updates := map[string]interface{}{}
+
+	if data.Name != nil {
+		updates["name"] = *data.Name
+	}
+	if data.DepartmentID != nil {
+		updates["department_id"] = *data.DepartmentID
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.Position{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update position")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "position not found")
+	}
+
+	return nil
+}
+
+func (o *organization) GetPositions(ctx context.Context, filter entity.GetPositionFilter) ([]entity.Position, error) {
+	var result []entity.Position
+	db := pkg.GetTransactionFromCtx(ctx, o.db).WithContext(ctx).Model(&entity.Position{})
+
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.Name != "" {
+		db = db.Where("LOWER(name) = LOWER(?)", filter.Name)
+	}
+	if filter.DepartmentID > 0 {
+		db = db.Where("department_id = ?", filter.DepartmentID)
+	}
+
+	if err := db.Order("name ASC").Find(&result).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch positions")
+	}
+
+	return result, nil
+}
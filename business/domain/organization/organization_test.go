@@ -0,0 +1,157 @@
+package organization_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/organization"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestCreateDepartment(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "departments"`).
+		WithArgs("Engineering", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	o := organization.InitOrganizationDomain(organization.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	err := o.CreateDepartment(ctx, entity.Department{Name: "Engineering"})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdatePosition
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "success update",
+			input: entity.UpdatePosition{ID: 3, Name: pkg.StringPtr("Lead"), DepartmentID: pkg.UintPtr(2)},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "positions" SET "department_id"=\$1,"name"=\$2,"updated_at"=\$3 WHERE id = \$4`).
+					WithArgs(2, "Lead", sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:  "not found",
+			input: entity.UpdatePosition{ID: 3, Name: pkg.StringPtr("Lead")},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "positions"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "position not found",
+		},
+		{
+			name:  "no updates",
+			input: entity.UpdatePosition{ID: 3},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+			},
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			o := organization.InitOrganizationDomain(organization.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := o.UpdatePosition(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetPositions(t *testing.T) {
+	tests := []struct {
+		name        string
+		filter      entity.GetPositionFilter
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		expectLen   int
+	}{
+		{
+			name:   "by name within department",
+			filter: entity.GetPositionFilter{Name: "Lead", DepartmentID: 2},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "positions" WHERE LOWER\(name\) = LOWER\(\$1\) AND department_id = \$2 ORDER BY name ASC`).
+					WithArgs("Lead", 2).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "department_id"}).AddRow(3, "Lead", 2))
+			},
+			expectLen: 1,
+		},
+		{
+			name:   "db error",
+			filter: entity.GetPositionFilter{},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "positions"`).
+					WillReturnError(errors.New("db down"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			o := organization.InitOrganizationDomain(organization.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			positions, err := o.GetPositions(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to fetch positions")
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, positions, tt.expectLen)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -11,8 +11,12 @@ import (
 type DomainItf interface {
 	GetPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error)
 	GetPayrollSummary(ctx context.Context, req entity.GetPayrollSummaryRequest) (*entity.GetPayrollSummaryResponse, error)
+	GetPayslipComponents(ctx context.Context, periodIDs []uint) ([]entity.PayslipComponentItem, error)
 
 	CreatePayslip(ctx context.Context, payslips []entity.Payslip) error
+	// VoidPayslips voids the issued payslips of a period, only the user's
+	// when userID is set.
+	VoidPayslips(ctx context.Context, periodID, userID uint) error
 	CreatePayrollJob(ctx context.Context, data entity.PayrollJob) (*entity.PayrollJob, error)
 	UpdatePayslipJob(ctx context.Context, data entity.UpdatePayslipJob) error
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/pkg"
 	x "github.com/zuhrulumam/go-hris/pkg/errors"
+	"gorm.io/gorm/clause"
 )
 
 func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error) {
@@ -18,6 +19,9 @@ func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipReques
 	if filter.UserID != nil {
 		query = query.Where("user_id = ?", *filter.UserID)
 	}
+	if len(filter.UserIDs) > 0 {
+		query = query.Where("user_id IN ?", filter.UserIDs)
+	}
 	if filter.AttendancePeriodID != nil {
 		query = query.Where("attendance_period_id = ?", *filter.AttendancePeriodID)
 	}
@@ -36,7 +40,7 @@ func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipReques
 	page := 1
 	offset := 0
 
-	if filter.Limit > 1 {
+	if filter.Limit > 0 {
 		limit = filter.Limit
 	}
 
@@ -45,6 +49,13 @@ func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipReques
 	}
 	offset = (page - 1) * limit
 
+	// Apply sorting, newest period first by default
+	if filter.SortBy != "" {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: filter.SortBy}, Desc: filter.SortDesc})
+	} else {
+		query = query.Order("attendance_period_id DESC")
+	}
+
 	// Apply pagination
 	query = query.Limit(limit).Offset(offset)
 
@@ -72,7 +83,7 @@ func (p *payslip) GetPayrollSummary(ctx context.Context, req entity.GetPayrollSu
 		Table("payslips").
 		Select("payslips.user_id, users.username, SUM(payslips.total_pay) AS total_pay").
 		Joins("JOIN users ON payslips.user_id = users.id").
-		Where("payslips.attendance_period_id IN ?", req.AttendancePeriodIDs).
+		Where("payslips.attendance_period_id IN ? AND payslips.status = ?", req.AttendancePeriodIDs, entity.PayslipStatusIssued).
 		Group("payslips.user_id, users.username").
 		Order("username ASC").
 		Scan(&results).Error
@@ -92,6 +103,31 @@ func (p *payslip) GetPayrollSummary(ctx context.Context, req entity.GetPayrollSu
 	}, nil
 }
 
+func (p *payslip) GetPayslipComponents(ctx context.Context, periodIDs []uint) ([]entity.PayslipComponentItem, error) {
+	db := pkg.GetTransactionFromCtx(ctx, p.db)
+
+	if len(periodIDs) == 0 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "no attendance period IDs provided")
+	}
+
+	var results []entity.PayslipComponentItem
+	err := db.WithContext(ctx).
+		Table("payslips").
+		Select("payslips.user_id, users.username, payslips.attendance_period_id, "+
+			"payslips.base_salary, payslips.attendance_amount, payslips.overtime_pay, "+
+			"payslips.reimbursement_total, payslips.lateness_deduction, payslips.total_pay").
+		Joins("JOIN users ON payslips.user_id = users.id").
+		Where("payslips.attendance_period_id IN ? AND payslips.status = ?", periodIDs, entity.PayslipStatusIssued).
+		Order("username ASC").
+		Scan(&results).Error
+
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch payslip components")
+	}
+
+	return results, nil
+}
+
 func (p *payslip) CreatePayslip(ctx context.Context, payslips []entity.Payslip) error {
 	db := pkg.GetTransactionFromCtx(ctx, p.db)
 
@@ -106,6 +142,22 @@ func (p *payslip) CreatePayslip(ctx context.Context, payslips []entity.Payslip)
 	return nil
 }
 
+func (p *payslip) VoidPayslips(ctx context.Context, periodID, userID uint) error {
+	db := pkg.GetTransactionFromCtx(ctx, p.db).WithContext(ctx).
+		Model(&entity.Payslip{}).
+		Where("attendance_period_id = ? AND status = ?", periodID, entity.PayslipStatusIssued)
+
+	if userID > 0 {
+		db = db.Where("user_id = ?", userID)
+	}
+
+	if err := db.Update("status", entity.PayslipStatusVoid).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to void payslips")
+	}
+
+	return nil
+}
+
 func (p *payslip) CreatePayrollJob(ctx context.Context, job entity.PayrollJob) (*entity.PayrollJob, error) {
 	db := pkg.GetTransactionFromCtx(ctx, p.db)
 
@@ -49,6 +49,34 @@ func TestGetPayslip(t *testing.T) {
 					ID:                 1,
 					UserID:             1,
 					AttendancePeriodID: 2,
+					Status:             "approved",
+					CreatedAt:          now,
+				},
+			},
+			expectedTotal: 1,
+			expectedPages: 1,
+		},
+		{
+			name: "Success with custom sort",
+			filter: entity.GetPayslipRequest{
+				UserID:   pkg.UintPtr(1),
+				SortBy:   "total_pay",
+				SortDesc: true,
+			},
+			mockQuery: `SELECT .* FROM "payslips" WHERE user_id = \$1 ORDER BY "total_pay" DESC LIMIT \$2`,
+			mockCount: sqlmock.NewRows([]string{"count"}).AddRow(1),
+			mockData: sqlmock.NewRows([]string{
+				"id", "user_id", "attendance_period_id", "status", "created_at",
+			}).AddRow(
+				1, 1, 2, "issued", now,
+			),
+			expectError: false,
+			expectedData: []entity.Payslip{
+				{
+					ID:                 1,
+					UserID:             1,
+					AttendancePeriodID: 2,
+					Status:             "issued",
 					CreatedAt:          now,
 				},
 			},
@@ -194,6 +222,72 @@ func TestGetPayrollSummary(t *testing.T) {
 	}
 }
 
+func TestGetPayslipComponents(t *testing.T) {
+	tests := []struct {
+		name         string
+		periodIDs    []uint
+		mockRows     *sqlmock.Rows
+		expectError  bool
+		expectedData []entity.PayslipComponentItem
+	}{
+		{
+			name:      "Success - components for two periods",
+			periodIDs: []uint{1, 2},
+			mockRows: sqlmock.NewRows([]string{
+				"user_id", "username", "attendance_period_id", "base_salary",
+				"attendance_amount", "overtime_pay", "reimbursement_total", "total_pay",
+			}).
+				AddRow(1, "user1", 1, 5000000, 4500000, 0, 0, 4500000).
+				AddRow(1, "user1", 2, 5000000, 5000000, 100000, 50000, 5150000),
+			expectError: false,
+			expectedData: []entity.PayslipComponentItem{
+				{UserID: 1, Username: "user1", AttendancePeriodID: 1, BaseSalary: 5000000, AttendanceAmount: 4500000, TotalPay: 4500000},
+				{UserID: 1, Username: "user1", AttendancePeriodID: 2, BaseSalary: 5000000, AttendanceAmount: 5000000, OvertimePay: 100000, ReimbursementTotal: 50000, TotalPay: 5150000},
+			},
+		},
+		{
+			name:        "Error - no attendance period IDs",
+			periodIDs:   []uint{},
+			expectError: true,
+		},
+		{
+			name:        "Error - DB query fails",
+			periodIDs:   []uint{1, 2},
+			mockRows:    nil,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			if len(tt.periodIDs) > 0 {
+				query := mock.ExpectQuery(`SELECT payslips\.user_id, users\.username, payslips\.attendance_period_id.* FROM "payslips" JOIN users`)
+				if tt.mockRows != nil {
+					query.WillReturnRows(tt.mockRows)
+				} else {
+					query.WillReturnError(errors.New("query failed"))
+				}
+			}
+
+			p := payslip.InitPayslipDomain(payslip.Option{DB: db})
+			result, err := p.GetPayslipComponents(context.Background(), tt.periodIDs)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedData, result)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestCreatePayslip(t *testing.T) {
 	now := time.Now()
 
@@ -217,13 +311,15 @@ func TestCreatePayslip(t *testing.T) {
 						input[0].UserID, input[0].AttendancePeriodID, input[0].BaseSalary,
 						sqlmock.AnyArg(), sqlmock.AnyArg(),
 						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
 						input[0].TotalPay,
-						sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(),
 						input[1].UserID, input[1].AttendancePeriodID, input[1].BaseSalary,
 						sqlmock.AnyArg(), sqlmock.AnyArg(),
 						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
 						input[1].TotalPay,
-						sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(),
 					).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
 			},
@@ -248,8 +344,9 @@ func TestCreatePayslip(t *testing.T) {
 						input[0].UserID, input[0].AttendancePeriodID, input[0].BaseSalary,
 						sqlmock.AnyArg(), sqlmock.AnyArg(),
 						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
 						input[0].TotalPay,
-						sqlmock.AnyArg(),
+						sqlmock.AnyArg(), sqlmock.AnyArg(),
 					).
 					WillReturnError(errors.New("insert error"))
 				// mock.ExpectRollback()
@@ -502,3 +599,69 @@ func TestUpdatePayslipJob(t *testing.T) {
 		})
 	}
 }
+
+func TestVoidPayslips(t *testing.T) {
+	tests := []struct {
+		name        string
+		periodID    uint
+		userID      uint
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+	}{
+		{
+			name:     "whole period",
+			periodID: 7,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "payslips" SET "status"=\$1 WHERE attendance_period_id = \$2 AND status = \$3$`).
+					WithArgs(entity.PayslipStatusVoid, 7, entity.PayslipStatusIssued).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+			},
+		},
+		{
+			name:     "one user",
+			periodID: 7,
+			userID:   2,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "payslips" SET "status"=\$1 WHERE \(attendance_period_id = \$2 AND status = \$3\) AND user_id = \$4`).
+					WithArgs(entity.PayslipStatusVoid, 7, entity.PayslipStatusIssued, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			name:     "DB error",
+			periodID: 7,
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "payslips"`).
+					WillReturnError(errors.New("db update failed"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			p := payslip.InitPayslipDomain(payslip.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := p.VoidPayslips(ctx, tt.periodID, tt.userID)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package security
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/security/security.go -destination=mocks/domain/security/mock_security.go -package=mocks
+type DomainItf interface {
+	// Failed logins and lockouts live in Redis so every instance sees them
+	// and they expire on their own. A subject is a username or IP address,
+	// such as "user:jdoe" or "ip:10.0.0.1".
+	RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error)
+	GetFailures(ctx context.Context, subject string) (int, error)
+	ClearFailures(ctx context.Context, subject string) error
+	Lock(ctx context.Context, subject string, until time.Time) error
+	// LockedUntil returns nil when the subject is not locked out.
+	LockedUntil(ctx context.Context, subject string) (*time.Time, error)
+	// Unlock lifts a lockout and forgets earlier failures. It reports
+	// whether the subject was locked.
+	Unlock(ctx context.Context, subject string) (bool, error)
+
+	CreateSecurityEvent(ctx context.Context, data entity.SecurityEvent) error
+	GetSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error)
+}
+
+type security struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+type Option struct {
+	DB    *gorm.DB
+	Redis *redis.Client
+}
+
+func InitSecurityDomain(opt Option) DomainItf {
+	s := &security{
+		db:    opt.DB,
+		redis: opt.Redis,
+	}
+
+	return s
+}
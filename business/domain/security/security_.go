@@ -0,0 +1,152 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *security) RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error) {
+	key := failuresKey(subject)
+
+	// The window starts at the first failure and is not extended by later ones
+	pipe := s.redis.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to record login failure")
+	}
+
+	return int(incr.Val()), nil
+}
+
+func (s *security) GetFailures(ctx context.Context, subject string) (int, error) {
+	n, err := s.redis.Get(ctx, failuresKey(subject)).Int()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	} else if err != nil {
+		return 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to read login failures")
+	}
+
+	return n, nil
+}
+
+func (s *security) ClearFailures(ctx context.Context, subject string) error {
+	if err := s.redis.Del(ctx, failuresKey(subject)).Err(); err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to clear login failures")
+	}
+	return nil
+}
+
+func (s *security) Lock(ctx context.Context, subject string, until time.Time) error {
+	err := s.redis.Set(ctx, lockKey(subject), until.Unix(), time.Until(until)).Err()
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to lock login")
+	}
+	return nil
+}
+
+func (s *security) LockedUntil(ctx context.Context, subject string) (*time.Time, error) {
+	v, err := s.redis.Get(ctx, lockKey(subject)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	} else if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check login lock")
+	}
+
+	unix, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "invalid login lock")
+	}
+
+	until := time.Unix(unix, 0)
+	return &until, nil
+}
+
+func (s *security) Unlock(ctx context.Context, subject string) (bool, error) {
+	n, err := s.redis.Del(ctx, lockKey(subject), failuresKey(subject)).Result()
+	if err != nil {
+		return false, x.WrapWithCode(err, http.StatusInternalServerError, "failed to unlock login")
+	}
+
+	return n > 0, nil
+}
+
+func (s *security) CreateSecurityEvent(ctx context.Context, data entity.SecurityEvent) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to record security event")
+	}
+	return nil
+}
+
+// GetSecurityEvents returns events newest first.
+func (s *security) GetSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error) {
+	query := pkg.GetTransactionFromCtx(ctx, s.db).WithContext(ctx).Model(&entity.SecurityEvent{})
+
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+
+	if filter.Username != "" {
+		query = query.Where("username = ?", filter.Username)
+	}
+
+	if filter.IPAddress != "" {
+		query = query.Where("ip_address = ?", filter.IPAddress)
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to count security events")
+	}
+
+	limit := 10
+	page := 1
+
+	if filter.Limit > 0 {
+		limit = filter.Limit
+	}
+
+	if filter.Page > 0 {
+		page = filter.Page
+	}
+
+	var events []entity.SecurityEvent
+	if err := query.Order("created_at DESC").Order("id DESC").Limit(limit).Offset((page - 1) * limit).Find(&events).Error; err != nil {
+		return nil, 0, 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to get security events")
+	}
+
+	totalPage := int((totalCount + int64(limit) - 1) / int64(limit))
+
+	return events, totalCount, totalPage, nil
+}
+
+func failuresKey(subject string) string {
+	return "login:failures:" + subject
+}
+
+func lockKey(subject string) string {
+	return "login:lock:" + subject
+}
@@ -0,0 +1,48 @@
+package security_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/security"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetSecurityEvents(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "security_events" WHERE type = \$1 AND username = \$2 AND created_at >= \$3`).
+		WithArgs("login_failed", "jdoe", from).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+	mock.ExpectQuery(`SELECT \* FROM "security_events" WHERE .* ORDER BY created_at DESC,id DESC LIMIT \$4 OFFSET \$5`).
+		WithArgs("login_failed", "jdoe", from, 5, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "username", "ip_address"}).
+			AddRow(7, "login_failed", "jdoe", "10.0.0.1"))
+
+	s := security.InitSecurityDomain(security.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	events, total, pages, err := s.GetSecurityEvents(ctx, entity.GetSecurityEventFilter{
+		Type:     entity.SecurityEventLoginFailed,
+		Username: "jdoe",
+		From:     &from,
+		Page:     2,
+		Limit:    5,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), total)
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, []entity.SecurityEvent{{ID: 7, Type: entity.SecurityEventLoginFailed, Username: "jdoe", IPAddress: "10.0.0.1"}}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
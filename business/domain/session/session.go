@@ -0,0 +1,43 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/session/session.go -destination=mocks/domain/session/mock_session.go -package=mocks
+type DomainItf interface {
+	CreateSession(ctx context.Context, data entity.Session) (*entity.Session, error)
+	GetSessions(ctx context.Context, filter entity.GetSessionFilter) ([]entity.Session, error)
+	UpdateSession(ctx context.Context, data entity.UpdateSession) error
+	RevokeSessions(ctx context.Context, ids []uint, at time.Time) error
+
+	// Revocations are mirrored to Redis so every request can be checked
+	// without a database round trip. Entries only need to outlive the
+	// access tokens issued for the session.
+	MarkRevoked(ctx context.Context, ids []uint, ttl time.Duration) error
+	IsRevoked(ctx context.Context, id uint) (bool, error)
+}
+
+type session struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+type Option struct {
+	DB    *gorm.DB
+	Redis *redis.Client
+}
+
+func InitSessionDomain(opt Option) DomainItf {
+	s := &session{
+		db:    opt.DB,
+		redis: opt.Redis,
+	}
+
+	return s
+}
@@ -0,0 +1,141 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *session) CreateSession(ctx context.Context, data entity.Session) (*entity.Session, error) {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to create session")
+	}
+	return &data, nil
+}
+
+func (s *session) GetSessions(ctx context.Context, filter entity.GetSessionFilter) ([]entity.Session, error) {
+	var result []entity.Session
+	db := pkg.GetTransactionFromCtx(ctx, s.db).WithContext(ctx).Model(&entity.Session{})
+
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.RefreshTokenHash != "" {
+		db = db.Where("refresh_token_hash = ?", filter.RefreshTokenHash)
+	}
+	if filter.PreviousTokenHash != "" {
+		db = db.Where("previous_token_hash = ?", filter.PreviousTokenHash)
+	}
+	if filter.ActiveOnly {
+		db = db.Where("revoked_at IS NULL AND expires_at > ?", time.Now())
+	}
+
+	if err := db.Order("last_used_at DESC").Find(&result).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch sessions")
+	}
+
+	return result, nil
+}
+
+func (s *session) UpdateSession(ctx context.Context, data entity.UpdateSession) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	updates := map[string]interface{}{}
+
+	if data.RefreshTokenHash != nil {
+		updates["refresh_token_hash"] = *data.RefreshTokenHash
+	}
+	if data.PreviousTokenHash != nil {
+		updates["previous_token_hash"] = *data.PreviousTokenHash
+	}
+	if data.LastUsedAt != nil {
+		updates["last_used_at"] = *data.LastUsedAt
+	}
+	if data.RevokedAt != nil {
+		updates["revoked_at"] = *data.RevokedAt
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	query := db.WithContext(ctx).
+		Model(&entity.Session{}).
+		Where("id = ?", data.ID)
+
+	if data.CurrentTokenHash != nil {
+		query = query.Where("refresh_token_hash = ?", *data.CurrentTokenHash)
+	}
+
+	tx := query.Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update session")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "session not found")
+	}
+
+	return nil
+}
+
+// RevokeSessions ends the given sessions. Sessions already revoked keep
+// their original revocation time.
+func (s *session) RevokeSessions(ctx context.Context, ids []uint, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	err := db.WithContext(ctx).
+		Model(&entity.Session{}).
+		Where("id IN ? AND revoked_at IS NULL", ids).
+		Update("revoked_at", at).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to revoke sessions")
+	}
+
+	return nil
+}
+
+func (s *session) MarkRevoked(ctx context.Context, ids []uint, ttl time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	pipe := s.redis.Pipeline()
+	for _, id := range ids {
+		pipe.Set(ctx, revokedKey(id), 1, ttl)
+	}
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to record revoked sessions")
+	}
+
+	return nil
+}
+
+func (s *session) IsRevoked(ctx context.Context, id uint) (bool, error) {
+	n, err := s.redis.Exists(ctx, revokedKey(id)).Result()
+	if err != nil {
+		return false, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check session")
+	}
+
+	return n > 0, nil
+}
+
+func revokedKey(id uint) string {
+	return fmt.Sprintf("session:revoked:%d", id)
+}
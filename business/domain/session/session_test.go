@@ -0,0 +1,136 @@
+package session_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/session"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetSessions(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "sessions" WHERE user_id = \$1 AND \(revoked_at IS NULL AND expires_at > \$2\) ORDER BY last_used_at DESC`).
+		WithArgs(3, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "user_agent"}).
+			AddRow(7, 3, "Mozilla/5.0"))
+
+	s := session.InitSessionDomain(session.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	result, err := s.GetSessions(ctx, entity.GetSessionFilter{UserID: 3, ActiveOnly: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.Session{{ID: 7, UserID: 3, UserAgent: "Mozilla/5.0"}}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateSession(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateSession
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "rotate refresh token",
+			input: entity.UpdateSession{ID: 7, RefreshTokenHash: pkg.StringPtr("new"), PreviousTokenHash: pkg.StringPtr("old"), LastUsedAt: &now},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sessions" SET "last_used_at"=\$1,"previous_token_hash"=\$2,"refresh_token_hash"=\$3 WHERE id = \$4`).
+					WithArgs(now, "old", "new", 7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:  "rotate only while the token is current",
+			input: entity.UpdateSession{ID: 7, CurrentTokenHash: pkg.StringPtr("old"), RefreshTokenHash: pkg.StringPtr("new"), LastUsedAt: &now},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sessions" SET "last_used_at"=\$1,"refresh_token_hash"=\$2 WHERE id = \$3 AND refresh_token_hash = \$4`).
+					WithArgs(now, "new", 7, "old").
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "session not found",
+		},
+		{
+			name:  "not found",
+			input: entity.UpdateSession{ID: 7, LastUsedAt: &now},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "sessions"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "session not found",
+		},
+		{
+			name:  "no updates",
+			input: entity.UpdateSession{ID: 7},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+			},
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			s := session.InitSessionDomain(session.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := s.UpdateSession(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRevokeSessions(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "sessions" SET "revoked_at"=\$1 WHERE id IN \(\$2,\$3\) AND revoked_at IS NULL`).
+		WithArgs(now, 7, 8).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	s := session.InitSessionDomain(session.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	err := s.RevokeSessions(ctx, []uint{7, 8}, now)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
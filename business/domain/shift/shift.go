@@ -0,0 +1,35 @@
+package shift
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/shift/shift.go -destination=mocks/domain/shift/mock_shift.go -package=mocks
+type DomainItf interface {
+	CreateShift(ctx context.Context, data entity.Shift) error
+	UpdateShift(ctx context.Context, data entity.UpdateShift) error
+	GetShifts(ctx context.Context, filter entity.GetShiftFilter) ([]entity.Shift, error)
+
+	CreateRosters(ctx context.Context, rosters []entity.ShiftRoster) error
+	DeleteRosters(ctx context.Context, filter entity.DeleteShiftRosterFilter) error
+	GetRosters(ctx context.Context, filter entity.GetShiftRosterFilter) ([]entity.ShiftRoster, error)
+}
+
+type shift struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitShiftDomain(opt Option) DomainItf {
+	s := &shift{
+		db: opt.DB,
+	}
+
+	return s
+}
@@ -0,0 +1,138 @@
+package shift
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *shift) CreateShift(ctx context.Context, data entity.Shift) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create shift")
+	}
+	return nil
+}
+
+func (s *shift) UpdateShift(ctx context.Context, data entity.UpdateShift) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	if data.ID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "shift ID is required")
+	}
+
+	updates := map[string]interface{}{}
+
+	if data.Name != nil {
+		updates["name"] = *data.Name
+	}
+	if data.StartTime != nil {
+		updates["start_time"] = *data.StartTime
+	}
+	if data.EndTime != nil {
+		updates["end_time"] = *data.EndTime
+	}
+	if data.BreakMinutes != nil {
+		updates["break_minutes"] = *data.BreakMinutes
+	}
+	if data.CrossesMidnight != nil {
+		updates["crosses_midnight"] = *data.CrossesMidnight
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.Shift{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update shift")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "shift not found")
+	}
+
+	return nil
+}
+
+func (s *shift) GetShifts(ctx context.Context, filter entity.GetShiftFilter) ([]entity.Shift, error) {
+	var result []entity.Shift
+	db := pkg.GetTransactionFromCtx(ctx, s.db).WithContext(ctx).Model(&entity.Shift{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if len(filter.IDs) > 0 {
+		db = db.Where("id IN ?", filter.IDs)
+	}
+
+	err := db.Order("start_time ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch shifts")
+	}
+
+	return result, nil
+}
+
+func (s *shift) CreateRosters(ctx context.Context, rosters []entity.ShiftRoster) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	if len(rosters) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no roster data provided")
+	}
+
+	if err := db.WithContext(ctx).Omit("Shift").Create(&rosters).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create shift rosters")
+	}
+
+	return nil
+}
+
+func (s *shift) DeleteRosters(ctx context.Context, filter entity.DeleteShiftRosterFilter) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	if filter.UserID < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "user ID is required")
+	}
+
+	err := db.WithContext(ctx).
+		Where("user_id = ? AND date >= ? AND date <= ?", filter.UserID, filter.StartDate, filter.EndDate).
+		Delete(&entity.ShiftRoster{}).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to delete shift rosters")
+	}
+
+	return nil
+}
+
+func (s *shift) GetRosters(ctx context.Context, filter entity.GetShiftRosterFilter) ([]entity.ShiftRoster, error) {
+	var result []entity.ShiftRoster
+	db := pkg.GetTransactionFromCtx(ctx, s.db).WithContext(ctx).Model(&entity.ShiftRoster{}).Preload("Shift")
+
+	// Dynamic filters
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if filter.StartDate != nil {
+		db = db.Where("date >= ?", filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		db = db.Where("date <= ?", filter.EndDate)
+	}
+
+	err := db.Order("date ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch shift rosters")
+	}
+
+	return result, nil
+}
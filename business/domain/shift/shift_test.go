@@ -0,0 +1,166 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/shift"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestUpdateShift(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdateShift
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "success update",
+			input: entity.UpdateShift{
+				ID:   1,
+				Name: pkg.StringPtr("Morning"),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "shifts" SET`).
+					WithArgs("Morning", sqlmock.AnyArg(), 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:        "missing ID",
+			input:       entity.UpdateShift{Name: pkg.StringPtr("Morning")},
+			mockSetup:   func(mock sqlmock.Sqlmock) { mock.ExpectBegin() },
+			expectError: true,
+			errorText:   "shift ID is required",
+		},
+		{
+			name:        "no updates",
+			input:       entity.UpdateShift{ID: 1},
+			mockSetup:   func(mock sqlmock.Sqlmock) { mock.ExpectBegin() },
+			expectError: true,
+			errorText:   "no updates provided",
+		},
+		{
+			name: "shift not found",
+			input: entity.UpdateShift{
+				ID:   99,
+				Name: pkg.StringPtr("Morning"),
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "shifts" SET`).
+					WithArgs("Morning", sqlmock.AnyArg(), 99).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "shift not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			s := shift.InitShiftDomain(shift.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := s.UpdateShift(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetRosters(t *testing.T) {
+	start := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filter      entity.GetShiftRosterFilter
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		expectLen   int
+	}{
+		{
+			name: "success with shift preloaded",
+			filter: entity.GetShiftRosterFilter{
+				UserID:    1,
+				StartDate: &start,
+				EndDate:   &end,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "shift_rosters" WHERE user_id = \$1 AND date >= \$2 AND date <= \$3 ORDER BY date ASC`).
+					WithArgs(1, start, end).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "shift_id", "date"}).
+						AddRow(1, 1, 3, start))
+				mock.ExpectQuery(`SELECT \* FROM "shifts" WHERE "shifts"."id" = \$1`).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "start_time", "end_time", "crosses_midnight"}).
+						AddRow(3, "Night", "22:00", "06:00", true))
+			},
+			expectError: false,
+			expectLen:   1,
+		},
+		{
+			name:   "db error",
+			filter: entity.GetShiftRosterFilter{UserID: 1},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "shift_rosters"`).
+					WillReturnError(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			s := shift.InitShiftDomain(shift.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			result, err := s.GetRosters(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectLen)
+				assert.Equal(t, "Night", result[0].Shift.Name)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package signingkey
+
+import (
+	"context"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/signingkey/signingkey.go -destination=mocks/domain/signingkey/mock_signingkey.go -package=mocks
+type DomainItf interface {
+	CreateSigningKey(ctx context.Context, data entity.SigningKey) (*entity.SigningKey, error)
+	GetSigningKeys(ctx context.Context, filter entity.GetSigningKeyFilter) ([]entity.SigningKey, error)
+	RetireSigningKeys(ctx context.Context, ids []uint, at time.Time) error
+}
+
+type signingKey struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitSigningKeyDomain(opt Option) DomainItf {
+	s := &signingKey{
+		db: opt.DB,
+	}
+
+	return s
+}
@@ -0,0 +1,54 @@
+package signingkey
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *signingKey) CreateSigningKey(ctx context.Context, data entity.SigningKey) (*entity.SigningKey, error) {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to create signing key")
+	}
+	return &data, nil
+}
+
+// GetSigningKeys returns keys newest first.
+func (s *signingKey) GetSigningKeys(ctx context.Context, filter entity.GetSigningKeyFilter) ([]entity.SigningKey, error) {
+	var result []entity.SigningKey
+	db := pkg.GetTransactionFromCtx(ctx, s.db).WithContext(ctx).Model(&entity.SigningKey{})
+
+	if filter.ActiveOnly {
+		db = db.Where("retired_at IS NULL")
+	}
+
+	if err := db.Order("created_at DESC").Order("id DESC").Find(&result).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch signing keys")
+	}
+
+	return result, nil
+}
+
+func (s *signingKey) RetireSigningKeys(ctx context.Context, ids []uint, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	err := db.WithContext(ctx).
+		Model(&entity.SigningKey{}).
+		Where("id IN ? AND retired_at IS NULL", ids).
+		Update("retired_at", at).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to retire signing keys")
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package signingkey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/signingkey"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetSigningKeys(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "signing_keys" WHERE retired_at IS NULL ORDER BY created_at DESC,id DESC`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key_id", "algorithm"}).
+			AddRow(2, "b2", "RS256").
+			AddRow(1, "a1", "RS256"))
+
+	s := signingkey.InitSigningKeyDomain(signingkey.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	result, err := s.GetSigningKeys(ctx, entity.GetSigningKeyFilter{ActiveOnly: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.SigningKey{
+		{ID: 2, KeyID: "b2", Algorithm: "RS256"},
+		{ID: 1, KeyID: "a1", Algorithm: "RS256"},
+	}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRetireSigningKeys(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "signing_keys" SET "retired_at"=\$1 WHERE id IN \(\$2,\$3\) AND retired_at IS NULL`).
+		WithArgs(now, 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	s := signingkey.InitSigningKeyDomain(signingkey.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	err := s.RetireSigningKeys(ctx, []uint{1, 2}, now)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
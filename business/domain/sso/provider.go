@@ -0,0 +1,339 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+const (
+	// keysRefreshInterval limits how often an unknown kid makes us fetch
+	// the provider's keys again.
+	keysRefreshInterval = time.Minute
+	// keysFetchTimeout bounds a fetch of the provider's keys, which every
+	// callback needing a new key waits for.
+	keysFetchTimeout = 10 * time.Second
+
+	maxResponseSize = 1 << 20
+)
+
+// idTokenAlgorithms are the signing algorithms accepted on ID tokens.
+// Symmetric ones are left out since the client secret is not a signing key
+// we want to trust.
+var idTokenAlgorithms = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// providerMetadata is the part of the discovery document we use.
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type idTokenClaims struct {
+	Email           string   `json:"email"`
+	EmailVerified   flexBool `json:"email_verified"`
+	Name            string   `json:"name"`
+	Nonce           string   `json:"nonce"`
+	AuthorizedParty string   `json:"azp"`
+	jwt.RegisteredClaims
+}
+
+// flexBool accepts the "true" some providers send instead of true.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null", "":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+func (s *sso) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	m, err := s.providerMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(m.AuthorizationEndpoint)
+	if err != nil {
+		return "", x.WrapWithCode(err, http.StatusBadGateway, "identity provider has an invalid authorization endpoint")
+	}
+
+	q := u.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", s.cfg.ClientID)
+	q.Set("redirect_uri", s.cfg.RedirectURL)
+	q.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	q.Set("state", state)
+	q.Set("nonce", nonce)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	u.RawQuery = q.Encode()
+
+	return u.String(), nil
+}
+
+func (s *sso) Exchange(ctx context.Context, code, codeVerifier string) (entity.OIDCClaims, error) {
+	m, err := s.providerMetadata(ctx)
+	if err != nil {
+		return entity.OIDCClaims{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {s.cfg.RedirectURL},
+		"code_verifier": {codeVerifier},
+		"client_id":     {s.cfg.ClientID},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return entity.OIDCClaims{}, x.WrapWithCode(err, http.StatusBadGateway, "identity provider has an invalid token endpoint")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	// client_secret_basic form-encodes the credentials (RFC 6749 2.3.1)
+	if s.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(s.cfg.ClientID), url.QueryEscape(s.cfg.ClientSecret))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return entity.OIDCClaims{}, x.WrapWithCode(err, http.StatusBadGateway, "failed to reach identity provider")
+	}
+	defer resp.Body.Close()
+
+	var token tokenResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(&token); err != nil && resp.StatusCode == http.StatusOK {
+		return entity.OIDCClaims{}, x.WrapWithCode(err, http.StatusBadGateway, "identity provider sent an invalid token response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return entity.OIDCClaims{}, x.NewWithCode(http.StatusUnauthorized,
+			fmt.Sprintf("identity provider rejected the sign-in: %s %s", token.Error, token.ErrorDescription))
+	}
+
+	if token.IDToken == "" {
+		return entity.OIDCClaims{}, x.NewWithCode(http.StatusBadGateway, "identity provider returned no ID token")
+	}
+
+	return s.verifyIDToken(ctx, m, token.IDToken)
+}
+
+func (s *sso) verifyIDToken(ctx context.Context, m *providerMetadata, raw string) (entity.OIDCClaims, error) {
+	var claims idTokenClaims
+
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return s.signingKey(ctx, m, kid)
+	},
+		jwt.WithValidMethods(idTokenAlgorithms),
+		jwt.WithIssuer(m.Issuer),
+		jwt.WithAudience(s.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return entity.OIDCClaims{}, x.WrapWithCode(err, http.StatusUnauthorized, "invalid ID token")
+	}
+
+	// A token meant for several clients has to name us as the one it was
+	// issued to
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != s.cfg.ClientID {
+		return entity.OIDCClaims{}, x.NewWithCode(http.StatusUnauthorized, "invalid ID token: issued to another client")
+	}
+
+	if claims.Subject == "" {
+		return entity.OIDCClaims{}, x.NewWithCode(http.StatusUnauthorized, "invalid ID token: missing subject")
+	}
+
+	return entity.OIDCClaims{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: bool(claims.EmailVerified),
+		Name:          claims.Name,
+		Nonce:         claims.Nonce,
+	}, nil
+}
+
+// providerMetadata reads the provider's discovery document once.
+func (s *sso) providerMetadata(ctx context.Context) (*providerMetadata, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.metadata != nil {
+		return s.metadata, nil
+	}
+
+	var m providerMetadata
+	if err := s.getJSON(ctx, strings.TrimSuffix(s.cfg.IssuerURL, "/")+"/.well-known/openid-configuration", &m); err != nil {
+		return nil, err
+	}
+
+	if m.Issuer != s.cfg.IssuerURL {
+		return nil, x.NewWithCode(http.StatusBadGateway,
+			fmt.Sprintf("identity provider issuer %q does not match %q", m.Issuer, s.cfg.IssuerURL))
+	}
+
+	if m.AuthorizationEndpoint == "" || m.TokenEndpoint == "" || m.JWKSURI == "" {
+		return nil, x.NewWithCode(http.StatusBadGateway, "identity provider discovery document is incomplete")
+	}
+
+	s.metadata = &m
+
+	return s.metadata, nil
+}
+
+// signingKey finds the provider key for kid, fetching the provider's keys
+// again when it has rotated in a new one. The fetch runs without the lock
+// so a slow provider only holds up callbacks that need the new key, and
+// those stop waiting when their own context ends.
+func (s *sso) signingKey(ctx context.Context, m *providerMetadata, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+
+	if key := s.lookupKey(kid); key != nil {
+		s.mu.Unlock()
+		return key, nil
+	}
+
+	done := s.keysFetch
+	if done == nil {
+		if !s.keysAt.IsZero() && time.Since(s.keysAt) < keysRefreshInterval {
+			s.mu.Unlock()
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+
+		done = make(chan struct{})
+		s.keysFetch = done
+		s.mu.Unlock()
+
+		keys, err := s.fetchKeys(ctx, m)
+
+		s.mu.Lock()
+		if err == nil {
+			s.keys, s.keysAt = keys, time.Now()
+		}
+		s.keysFetch = nil
+		close(done)
+		s.mu.Unlock()
+
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		s.mu.Unlock()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			return nil, x.WrapWithCode(ctx.Err(), http.StatusBadGateway, "timed out waiting for identity provider keys")
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if key := s.lookupKey(kid); key != nil {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// fetchKeys reads the provider's published signing keys.
+func (s *sso) fetchKeys(ctx context.Context, m *providerMetadata) (map[string]crypto.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, keysFetchTimeout)
+	defer cancel()
+
+	var set struct {
+		Keys []pkg.JWK `json:"keys"`
+	}
+	if err := s.getJSON(ctx, m.JWKSURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+
+		// Keys we cannot use are skipped rather than failing the rest
+		key, err := jwk.PublicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	return keys, nil
+}
+
+// lookupKey also takes a token without a kid when the provider has a
+// single key.
+func (s *sso) lookupKey(kid string) crypto.PublicKey {
+	if key, ok := s.keys[kid]; ok {
+		return key
+	}
+
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (s *sso) getJSON(ctx context.Context, endpoint string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusBadGateway, "invalid identity provider URL")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusBadGateway, "failed to reach identity provider")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return x.NewWithCode(http.StatusBadGateway, fmt.Sprintf("identity provider answered %s for %s", resp.Status, endpoint))
+	}
+
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v); err != nil {
+		return x.WrapWithCode(err, http.StatusBadGateway, "identity provider sent invalid JSON")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package sso
+
+import (
+	"context"
+	"crypto"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/sso/sso.go -destination=mocks/domain/sso/mock_sso.go -package=mocks
+type DomainItf interface {
+	// AuthCodeURL is the provider's sign-in page for an authorization code
+	// login with PKCE.
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	// Exchange trades an authorization code for an ID token and returns
+	// its claims once the signature, issuer, audience and expiry check
+	// out. The nonce is left to the caller.
+	Exchange(ctx context.Context, code, codeVerifier string) (entity.OIDCClaims, error)
+
+	CreateSSOState(ctx context.Context, data entity.SSOState) error
+	// GetSSOState returns nil when no state has the hash.
+	GetSSOState(ctx context.Context, stateHash string) (*entity.SSOState, error)
+	UseSSOState(ctx context.Context, id uint, at time.Time) error
+
+	// GetUserIdentity returns nil when the provider account is not linked.
+	GetUserIdentity(ctx context.Context, issuer, subject string) (*entity.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, data entity.UserIdentity) error
+}
+
+type sso struct {
+	db     *gorm.DB
+	cfg    entity.OIDCConfig
+	client *http.Client
+
+	// Provider metadata and signing keys are fetched on first use so the
+	// server starts while the provider is down
+	mu       sync.Mutex
+	metadata *providerMetadata
+	keys     map[string]crypto.PublicKey
+	keysAt   time.Time
+	// keysFetch is closed when the fetch of the provider's keys that is
+	// under way ends; nil when none is
+	keysFetch chan struct{}
+}
+
+type Option struct {
+	DB         *gorm.DB
+	OIDC       entity.OIDCConfig
+	HTTPClient *http.Client // defaults to one with a 10 second timeout
+}
+
+func InitSSODomain(opt Option) DomainItf {
+	s := &sso{
+		db:     opt.DB,
+		cfg:    opt.OIDC,
+		client: opt.HTTPClient,
+	}
+
+	if s.client == nil {
+		s.client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return s
+}
@@ -0,0 +1,85 @@
+package sso
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *sso) CreateSSOState(ctx context.Context, data entity.SSOState) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create sign-in state")
+	}
+	return nil
+}
+
+func (s *sso) GetSSOState(ctx context.Context, stateHash string) (*entity.SSOState, error) {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	var result []entity.SSOState
+	err := db.WithContext(ctx).
+		Where("state_hash = ?", stateHash).
+		Limit(1).
+		Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch sign-in state")
+	}
+
+	if len(result) < 1 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+func (s *sso) UseSSOState(ctx context.Context, id uint, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.SSOState{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", at)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to use sign-in state")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "sign-in was already completed")
+	}
+
+	return nil
+}
+
+func (s *sso) GetUserIdentity(ctx context.Context, issuer, subject string) (*entity.UserIdentity, error) {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+
+	var result []entity.UserIdentity
+	err := db.WithContext(ctx).
+		Where("issuer = ? AND subject = ?", issuer, subject).
+		Limit(1).
+		Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch user identity")
+	}
+
+	if len(result) < 1 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+func (s *sso) CreateUserIdentity(ctx context.Context, data entity.UserIdentity) error {
+	db := pkg.GetTransactionFromCtx(ctx, s.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to link user identity")
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+package sso_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/sso"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+// mockProvider is a local OpenID Connect provider. It answers discovery
+// and JWKS requests and trades the code "good-code" for an ID token built
+// from claims, signed with the published key "k1".
+type mockProvider struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+	forger *rsa.PrivateKey // signs ID tokens instead of key when set
+	hold   chan struct{}   // JWKS requests wait for it to close when set
+	asked  chan struct{}   // receives when a JWKS request arrives, when set
+	claims jwt.MapClaims
+	form   url.Values
+	user   string
+	secret string
+}
+
+func newMockProvider(t *testing.T) *mockProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &mockProvider{key: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		if p.asked != nil {
+			p.asked <- struct{}{}
+		}
+		if p.hold != nil {
+			select {
+			case <-p.hold:
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string][]pkg.JWK{"keys": {{
+			Kty: "RSA",
+			Kid: "k1",
+			Alg: "RS256",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		p.form = r.PostForm
+		p.user, p.secret, _ = r.BasicAuth()
+
+		if r.PostForm.Get("code") != "good-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "opaque",
+			"token_type":   "Bearer",
+			"id_token":     p.sign(t, p.claims, "k1"),
+		})
+	})
+
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+
+	return p
+}
+
+func (p *mockProvider) sign(t *testing.T, claims jwt.MapClaims, kid string) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+
+	key := p.key
+	if p.forger != nil {
+		key = p.forger
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func (p *mockProvider) validClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            p.server.URL,
+		"sub":            "idp-user-1",
+		"aud":            "hris",
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          "n-1",
+		"email":          "jdoe@example.com",
+		"email_verified": "true",
+		"name":           "John Doe",
+	}
+}
+
+func (p *mockProvider) config() entity.OIDCConfig {
+	return entity.OIDCConfig{
+		IssuerURL:    p.server.URL,
+		ClientID:     "hris",
+		ClientSecret: "s3cret",
+		RedirectURL:  "https://hris.example.com/sso/callback",
+		Scopes:       []string{"openid", "email"},
+	}
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	p := newMockProvider(t)
+	s := sso.InitSSODomain(sso.Option{OIDC: p.config()})
+
+	raw, err := s.AuthCodeURL(context.Background(), "st", "n-1", "challenge")
+	assert.NoError(t, err)
+
+	u, err := url.Parse(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, p.server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, url.Values{
+		"response_type":         {"code"},
+		"client_id":             {"hris"},
+		"redirect_uri":          {"https://hris.example.com/sso/callback"},
+		"scope":                 {"openid email"},
+		"state":                 {"st"},
+		"nonce":                 {"n-1"},
+		"code_challenge":        {"challenge"},
+		"code_challenge_method": {"S256"},
+	}, u.Query())
+}
+
+func TestAuthCodeURL_IssuerMismatch(t *testing.T) {
+	p := newMockProvider(t)
+	cfg := p.config()
+	cfg.IssuerURL = p.server.URL + "/"
+
+	s := sso.InitSSODomain(sso.Option{OIDC: cfg})
+
+	_, err := s.AuthCodeURL(context.Background(), "st", "n-1", "challenge")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match")
+}
+
+func TestExchange(t *testing.T) {
+	tests := []struct {
+		name        string
+		code        string
+		claims      func(p *mockProvider) jwt.MapClaims
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:   "valid ID token",
+			code:   "good-code",
+			claims: func(p *mockProvider) jwt.MapClaims { return p.validClaims() },
+		},
+		{
+			name: "another client's token",
+			code: "good-code",
+			claims: func(p *mockProvider) jwt.MapClaims {
+				c := p.validClaims()
+				c["aud"] = "payroll"
+				return c
+			},
+			expectErr:   true,
+			errorString: "invalid ID token",
+		},
+		{
+			name: "shared audience without azp",
+			code: "good-code",
+			claims: func(p *mockProvider) jwt.MapClaims {
+				c := p.validClaims()
+				c["aud"] = []string{"hris", "payroll"}
+				return c
+			},
+			expectErr:   true,
+			errorString: "issued to another client",
+		},
+		{
+			name: "expired",
+			code: "good-code",
+			claims: func(p *mockProvider) jwt.MapClaims {
+				c := p.validClaims()
+				c["exp"] = time.Now().Add(-time.Hour).Unix()
+				return c
+			},
+			expectErr:   true,
+			errorString: "invalid ID token",
+		},
+		{
+			name: "other issuer",
+			code: "good-code",
+			claims: func(p *mockProvider) jwt.MapClaims {
+				c := p.validClaims()
+				c["iss"] = "https://evil.example.com"
+				return c
+			},
+			expectErr:   true,
+			errorString: "invalid ID token",
+		},
+		{
+			name:        "code rejected",
+			code:        "stale-code",
+			claims:      func(p *mockProvider) jwt.MapClaims { return p.validClaims() },
+			expectErr:   true,
+			errorString: "invalid_grant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newMockProvider(t)
+			p.claims = tt.claims(p)
+
+			s := sso.InitSSODomain(sso.Option{OIDC: p.config()})
+
+			claims, err := s.Exchange(context.Background(), tt.code, "verifier")
+
+			assert.Equal(t, "verifier", p.form.Get("code_verifier"))
+			assert.Equal(t, "https://hris.example.com/sso/callback", p.form.Get("redirect_uri"))
+			assert.Equal(t, "hris", p.user)
+			assert.Equal(t, "s3cret", p.secret)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.OIDCClaims{
+				Issuer:        p.server.URL,
+				Subject:       "idp-user-1",
+				Email:         "jdoe@example.com",
+				EmailVerified: true,
+				Name:          "John Doe",
+				Nonce:         "n-1",
+			}, claims)
+		})
+	}
+}
+
+func TestExchange_ForgedSignature(t *testing.T) {
+	p := newMockProvider(t)
+	p.claims = p.validClaims()
+
+	forger, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.forger = forger
+
+	s := sso.InitSSODomain(sso.Option{OIDC: p.config()})
+
+	_, err = s.Exchange(context.Background(), "good-code", "verifier")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid ID token")
+}
+
+func TestExchange_SlowProviderKeys(t *testing.T) {
+	p := newMockProvider(t)
+	p.claims = p.validClaims()
+	p.hold = make(chan struct{})
+	p.asked = make(chan struct{}, 1)
+
+	s := sso.InitSSODomain(sso.Option{OIDC: p.config()})
+
+	// Discovery is done before the key fetch hangs
+	_, err := s.AuthCodeURL(context.Background(), "st", "n-1", "challenge")
+	assert.NoError(t, err)
+
+	first := make(chan error, 1)
+	go func() {
+		_, err := s.Exchange(context.Background(), "good-code", "verifier")
+		first <- err
+	}()
+	<-p.asked
+
+	// Other sign-ins are not stuck behind the fetch
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = s.AuthCodeURL(ctx, "st-2", "n-2", "challenge")
+	assert.NoError(t, err)
+
+	// A callback that needs the keys gives up when its own context ends
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = s.Exchange(ctx, "good-code", "verifier")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "timed out waiting for identity provider keys")
+
+	close(p.hold)
+	assert.NoError(t, <-first)
+}
+
+func TestGetSSOState(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	expires := time.Date(2025, 3, 1, 9, 10, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "sso_states" WHERE state_hash = \$1 LIMIT \$2`).
+		WithArgs("abc", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "state_hash", "nonce", "code_verifier", "expires_at"}).
+			AddRow(2, "abc", "n-1", "verifier", expires))
+
+	s := sso.InitSSODomain(sso.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	state, err := s.GetSSOState(ctx, "abc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entity.SSOState{ID: 2, StateHash: "abc", Nonce: "n-1", CodeVerifier: "verifier", ExpiresAt: expires}, state)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseSSOState(t *testing.T) {
+	at := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		rows      int64
+		expectErr bool
+	}{
+		{name: "first use", rows: 1},
+		{name: "replayed", rows: 0, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "sso_states" SET "used_at"=\$1 WHERE id = \$2 AND used_at IS NULL`).
+				WithArgs(at, 2).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			s := sso.InitSSODomain(sso.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := s.UseSSOState(ctx, 2, at)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "already completed")
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package timeclock
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/timeclock/timeclock.go -destination=mocks/domain/timeclock/mock_timeclock.go -package=mocks
+type DomainItf interface {
+	CreateTimeClock(ctx context.Context, data entity.TimeClock) (*entity.TimeClock, error)
+	GetTimeClocks(ctx context.Context, filter entity.GetTimeClockFilter) ([]entity.TimeClock, error)
+
+	CreateTimeClockEmployee(ctx context.Context, data entity.TimeClockEmployee) error
+	UpdateTimeClockEmployee(ctx context.Context, data entity.UpdateTimeClockEmployee) error
+	GetTimeClockEmployees(ctx context.Context, filter entity.GetTimeClockEmployeeFilter) ([]entity.TimeClockEmployee, error)
+
+	CreateTimeClockPunch(ctx context.Context, data entity.TimeClockPunch) error
+	GetTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) ([]entity.TimeClockPunch, error)
+	CountTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) (int64, error)
+}
+
+type timeClock struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitTimeClockDomain(opt Option) DomainItf {
+	t := &timeClock{
+		db: opt.DB,
+	}
+
+	return t
+}
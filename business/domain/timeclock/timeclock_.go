@@ -0,0 +1,140 @@
+package timeclock
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"gorm.io/gorm"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (t *timeClock) CreateTimeClock(ctx context.Context, data entity.TimeClock) (*entity.TimeClock, error) {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to register time clock")
+	}
+	return &data, nil
+}
+
+func (t *timeClock) GetTimeClocks(ctx context.Context, filter entity.GetTimeClockFilter) ([]entity.TimeClock, error) {
+	var result []entity.TimeClock
+	db := pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.TimeClock{})
+
+	// Dynamic filters
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.Active != nil {
+		db = db.Where("active = ?", *filter.Active)
+	}
+
+	err := db.Order("id ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch time clocks")
+	}
+
+	return result, nil
+}
+
+func (t *timeClock) CreateTimeClockEmployee(ctx context.Context, data entity.TimeClockEmployee) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to map employee code")
+	}
+	return nil
+}
+
+func (t *timeClock) UpdateTimeClockEmployee(ctx context.Context, data entity.UpdateTimeClockEmployee) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.TimeClockEmployee{}).
+		Where("id = ?", data.ID).
+		Updates(map[string]interface{}{
+			"user_id":    data.UserID,
+			"updated_at": time.Now(),
+		})
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to map employee code")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "employee code mapping not found")
+	}
+
+	return nil
+}
+
+func (t *timeClock) GetTimeClockEmployees(ctx context.Context, filter entity.GetTimeClockEmployeeFilter) ([]entity.TimeClockEmployee, error) {
+	var result []entity.TimeClockEmployee
+	db := pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.TimeClockEmployee{})
+
+	if filter.EmployeeCode != "" {
+		db = db.Where("employee_code = ?", filter.EmployeeCode)
+	}
+
+	err := db.Order("employee_code ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch employee codes")
+	}
+
+	return result, nil
+}
+
+func (t *timeClock) CreateTimeClockPunch(ctx context.Context, data entity.TimeClockPunch) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to store punch")
+	}
+	return nil
+}
+
+func (t *timeClock) GetTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) ([]entity.TimeClockPunch, error) {
+	var result []entity.TimeClockPunch
+	db := applyPunchFilter(pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.TimeClockPunch{}), filter)
+
+	err := db.Order("punched_at ASC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch punches")
+	}
+
+	return result, nil
+}
+
+func (t *timeClock) CountTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) (int64, error) {
+	var count int64
+	db := applyPunchFilter(pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.TimeClockPunch{}), filter)
+
+	if err := db.Count(&count).Error; err != nil {
+		return 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check punches")
+	}
+
+	return count, nil
+}
+
+func applyPunchFilter(db *gorm.DB, filter entity.GetTimeClockPunchFilter) *gorm.DB {
+	if filter.TimeClockID > 0 {
+		db = db.Where("time_clock_id = ?", filter.TimeClockID)
+	}
+	if filter.EmployeeCode != "" {
+		db = db.Where("employee_code = ?", filter.EmployeeCode)
+	}
+	if filter.PunchedAt != nil {
+		db = db.Where("punched_at = ?", *filter.PunchedAt)
+	}
+	if len(filter.Statuses) > 0 {
+		db = db.Where("status IN ?", filter.Statuses)
+	}
+	if filter.StartDate != nil {
+		db = db.Where("punched_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		db = db.Where("punched_at < ?", *filter.EndDate)
+	}
+	return db
+}
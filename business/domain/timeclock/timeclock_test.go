@@ -0,0 +1,141 @@
+package timeclock_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/timeclock"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetTimeClockPunches(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filter      entity.GetTimeClockPunchFilter
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		expectLen   int
+	}{
+		{
+			name: "punches needing reconciliation",
+			filter: entity.GetTimeClockPunchFilter{
+				TimeClockID: 2,
+				Statuses:    []entity.TimeClockPunchStatus{entity.PunchUnmapped, entity.PunchUnpaired},
+				StartDate:   &start,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "time_clock_punches" WHERE time_clock_id = \$1 AND status IN \(\$2,\$3\) AND punched_at >= \$4 ORDER BY punched_at ASC`).
+					WithArgs(2, entity.PunchUnmapped, entity.PunchUnpaired, start).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "time_clock_id", "employee_code", "status"}).
+						AddRow(1, 2, "99999", "unmapped").
+						AddRow(2, 2, "00042", "unpaired"))
+			},
+			expectError: false,
+			expectLen:   2,
+		},
+		{
+			name:   "db error",
+			filter: entity.GetTimeClockPunchFilter{},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT \* FROM "time_clock_punches"`).
+					WillReturnError(errors.New("db error"))
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			tc := timeclock.InitTimeClockDomain(timeclock.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			result, err := tc.GetTimeClockPunches(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectLen)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateTimeClockEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.UpdateTimeClockEmployee
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "remap code",
+			input: entity.UpdateTimeClockEmployee{ID: 1, UserID: 7},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "time_clock_employees" SET`).
+					WithArgs(sqlmock.AnyArg(), 7, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			expectError: false,
+		},
+		{
+			name:  "mapping not found",
+			input: entity.UpdateTimeClockEmployee{ID: 9, UserID: 7},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "time_clock_employees" SET`).
+					WithArgs(sqlmock.AnyArg(), 7, 9).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "employee code mapping not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			tc := timeclock.InitTimeClockDomain(timeclock.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := tc.UpdateTimeClockEmployee(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorText != "" {
+					assert.Contains(t, err.Error(), tt.errorText)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
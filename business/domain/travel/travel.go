@@ -0,0 +1,31 @@
+package travel
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/travel/travel.go -destination=mocks/domain/travel/mock_travel.go -package=mocks
+type DomainItf interface {
+	CreateTravelRequest(ctx context.Context, data entity.TravelRequest) error
+	UpdateTravelRequest(ctx context.Context, data entity.UpdateTravelRequest) error
+	GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error)
+}
+
+type travel struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitTravelDomain(opt Option) DomainItf {
+	t := &travel{
+		db: opt.DB,
+	}
+
+	return t
+}
@@ -0,0 +1,75 @@
+package travel
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (t *travel) CreateTravelRequest(ctx context.Context, data entity.TravelRequest) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create travel request")
+	}
+	return nil
+}
+
+func (t *travel) UpdateTravelRequest(ctx context.Context, data entity.UpdateTravelRequest) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	updates := map[string]interface{}{
+		"status":      data.Status,
+		"reviewed_by": data.ReviewedBy,
+		"reviewed_at": data.ReviewedAt,
+		"review_note": data.ReviewNote,
+	}
+
+	// Only pending requests can be reviewed, so a concurrent review loses
+	tx := db.WithContext(ctx).
+		Model(&entity.TravelRequest{}).
+		Where("id = ? AND status = ?", data.ID, entity.TravelStatusPending).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update travel request")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusConflict, "travel request was already reviewed")
+	}
+
+	return nil
+}
+
+func (t *travel) GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error) {
+	var result []entity.TravelRequest
+	db := pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.TravelRequest{})
+
+	if filter.ID > 0 {
+		db = db.Where("id = ?", filter.ID)
+	}
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.UserIDs) > 0 {
+		db = db.Where("user_id IN ?", filter.UserIDs)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.ContainsDate != nil {
+		day := filter.ContainsDate.Format("2006-01-02")
+		db = db.Where("start_date <= ? AND end_date >= ?", day, day)
+	}
+
+	err := db.Order("start_date DESC").Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch travel requests")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package travel_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/travel"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetTravelRequests(t *testing.T) {
+	day := time.Date(2025, 6, 11, 9, 0, 0, 0, time.UTC)
+	start := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 12, 0, 0, 0, 0, time.UTC)
+
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectQuery(`SELECT \* FROM "travel_requests" WHERE user_id = \$1 AND status = \$2 AND \(start_date <= \$3 AND end_date >= \$4\) ORDER BY start_date DESC`).
+		WithArgs(1, entity.TravelStatusApproved, "2025-06-11", "2025-06-11").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "destination", "start_date", "end_date", "status"}).
+			AddRow(5, 1, "Surabaya", start, end, entity.TravelStatusApproved))
+
+	d := travel.InitTravelDomain(travel.Option{DB: db})
+	result, err := d.GetTravelRequests(context.Background(), entity.GetTravelRequestFilter{
+		UserID:       1,
+		Status:       entity.TravelStatusApproved,
+		ContainsDate: &day,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.TravelRequest{{
+		ID:          5,
+		UserID:      1,
+		Destination: "Surabaya",
+		StartDate:   start,
+		EndDate:     end,
+		Status:      entity.TravelStatusApproved,
+	}}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateTravelRequest(t *testing.T) {
+	reviewedAt := time.Date(2025, 6, 9, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		rows        int64
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "review pending request",
+			rows: 1,
+		},
+		{
+			name:        "already reviewed",
+			rows:        0,
+			expectError: true,
+			errorText:   "travel request was already reviewed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "travel_requests" SET .* WHERE id = \$6 AND status = \$7`).
+				WithArgs("looks fine", reviewedAt, 99, entity.TravelStatusApproved, sqlmock.AnyArg(), 3, entity.TravelStatusPending).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			d := travel.InitTravelDomain(travel.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.UpdateTravelRequest(ctx, entity.UpdateTravelRequest{
+				ID:         3,
+				Status:     entity.TravelStatusApproved,
+				ReviewedBy: 99,
+				ReviewedAt: reviewedAt,
+				ReviewNote: "looks fine",
+			})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
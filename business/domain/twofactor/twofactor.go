@@ -0,0 +1,48 @@
+package twofactor
+
+import (
+	"context"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=business/domain/twofactor/twofactor.go -destination=mocks/domain/twofactor/mock_twofactor.go -package=mocks
+type DomainItf interface {
+	// GetTwoFactor returns nil when the user has never started enrolment.
+	GetTwoFactor(ctx context.Context, userID uint) (*entity.TwoFactor, error)
+	CreateTwoFactor(ctx context.Context, data entity.TwoFactor) error
+	EnableTwoFactor(ctx context.Context, userID uint, step int64, at time.Time) error
+	// UseTOTPStep records the step of an accepted code and fails if that
+	// step, or a later one, was already used.
+	UseTOTPStep(ctx context.Context, userID uint, step int64) error
+	// DeleteTwoFactor removes the enrolment and its recovery codes.
+	DeleteTwoFactor(ctx context.Context, userID uint) error
+
+	CreateRecoveryCodes(ctx context.Context, codes []entity.RecoveryCode) error
+	GetRecoveryCodes(ctx context.Context, filter entity.GetRecoveryCodeFilter) ([]entity.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, id uint, at time.Time) error
+	DeleteRecoveryCodes(ctx context.Context, userID uint) error
+
+	CreateLoginChallenge(ctx context.Context, data entity.LoginChallenge) error
+	// GetLoginChallenge returns nil when no challenge has the token hash.
+	GetLoginChallenge(ctx context.Context, tokenHash string) (*entity.LoginChallenge, error)
+	UpdateLoginChallenge(ctx context.Context, data entity.UpdateLoginChallenge) error
+}
+
+type twoFactor struct {
+	db *gorm.DB
+}
+
+type Option struct {
+	DB *gorm.DB
+}
+
+func InitTwoFactorDomain(opt Option) DomainItf {
+	t := &twoFactor{
+		db: opt.DB,
+	}
+
+	return t
+}
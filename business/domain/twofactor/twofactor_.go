@@ -0,0 +1,223 @@
+package twofactor
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (t *twoFactor) GetTwoFactor(ctx context.Context, userID uint) (*entity.TwoFactor, error) {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	var result []entity.TwoFactor
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Limit(1).
+		Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch two-factor settings")
+	}
+
+	if len(result) < 1 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+func (t *twoFactor) CreateTwoFactor(ctx context.Context, data entity.TwoFactor) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create two-factor settings")
+	}
+	return nil
+}
+
+func (t *twoFactor) EnableTwoFactor(ctx context.Context, userID uint, step int64, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.TwoFactor{}).
+		Where("user_id = ? AND enabled_at IS NULL", userID).
+		Updates(map[string]interface{}{
+			"enabled_at":     at,
+			"last_used_step": step,
+		})
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to enable two-factor authentication")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusConflict, "two-factor enrolment not found or already enabled")
+	}
+
+	return nil
+}
+
+func (t *twoFactor) UseTOTPStep(ctx context.Context, userID uint, step int64) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.TwoFactor{}).
+		Where("user_id = ? AND last_used_step < ?", userID, step).
+		Update("last_used_step", step)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to record verification code")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusUnauthorized, "verification code was already used")
+	}
+
+	return nil
+}
+
+func (t *twoFactor) DeleteTwoFactor(ctx context.Context, userID uint) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	if err := t.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return err
+	}
+
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&entity.TwoFactor{}).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to delete two-factor settings")
+	}
+
+	return nil
+}
+
+func (t *twoFactor) CreateRecoveryCodes(ctx context.Context, codes []entity.RecoveryCode) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&codes).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create recovery codes")
+	}
+	return nil
+}
+
+func (t *twoFactor) GetRecoveryCodes(ctx context.Context, filter entity.GetRecoveryCodeFilter) ([]entity.RecoveryCode, error) {
+	var result []entity.RecoveryCode
+	db := pkg.GetTransactionFromCtx(ctx, t.db).WithContext(ctx).Model(&entity.RecoveryCode{})
+
+	if filter.UserID > 0 {
+		db = db.Where("user_id = ?", filter.UserID)
+	}
+
+	if filter.CodeHash != "" {
+		db = db.Where("code_hash = ?", filter.CodeHash)
+	}
+
+	if filter.UnusedOnly {
+		db = db.Where("used_at IS NULL")
+	}
+
+	if err := db.Find(&result).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch recovery codes")
+	}
+
+	return result, nil
+}
+
+func (t *twoFactor) UseRecoveryCode(ctx context.Context, id uint, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.RecoveryCode{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", at)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to use recovery code")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusUnauthorized, "recovery code was already used")
+	}
+
+	return nil
+}
+
+func (t *twoFactor) DeleteRecoveryCodes(ctx context.Context, userID uint) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	err := db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&entity.RecoveryCode{}).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to delete recovery codes")
+	}
+
+	return nil
+}
+
+func (t *twoFactor) CreateLoginChallenge(ctx context.Context, data entity.LoginChallenge) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create login challenge")
+	}
+	return nil
+}
+
+func (t *twoFactor) GetLoginChallenge(ctx context.Context, tokenHash string) (*entity.LoginChallenge, error) {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	var result []entity.LoginChallenge
+	err := db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		Limit(1).
+		Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch login challenge")
+	}
+
+	if len(result) < 1 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+func (t *twoFactor) UpdateLoginChallenge(ctx context.Context, data entity.UpdateLoginChallenge) error {
+	db := pkg.GetTransactionFromCtx(ctx, t.db)
+
+	updates := map[string]interface{}{}
+
+	if data.Attempts != nil {
+		updates["attempts"] = *data.Attempts
+	}
+	if data.CompletedAt != nil {
+		updates["completed_at"] = *data.CompletedAt
+	}
+
+	if len(updates) == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no updates provided")
+	}
+
+	tx := db.WithContext(ctx).
+		Model(&entity.LoginChallenge{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to update login challenge")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "login challenge not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package twofactor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/domain/twofactor"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func TestGetTwoFactor(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     *sqlmock.Rows
+		expected *entity.TwoFactor
+	}{
+		{
+			name:     "enrolled",
+			rows:     sqlmock.NewRows([]string{"id", "user_id", "secret"}).AddRow(1, 3, "SECRET"),
+			expected: &entity.TwoFactor{ID: 1, UserID: 3, Secret: "SECRET"},
+		},
+		{
+			name: "not enrolled",
+			rows: sqlmock.NewRows([]string{"id", "user_id", "secret"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT \* FROM "two_factors" WHERE user_id = \$1 LIMIT \$2`).
+				WithArgs(3, 1).
+				WillReturnRows(tt.rows)
+
+			d := twofactor.InitTwoFactorDomain(twofactor.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			result, err := d.GetTwoFactor(ctx, 3)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUseTOTPStep(t *testing.T) {
+	tests := []struct {
+		name        string
+		affected    int64
+		expectError bool
+	}{
+		{name: "new step", affected: 1},
+		{name: "replayed step", affected: 0, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "two_factors" SET "last_used_step"=\$1,"updated_at"=\$2 WHERE user_id = \$3 AND last_used_step < \$4`).
+				WithArgs(100, sqlmock.AnyArg(), 3, 100).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			d := twofactor.InitTwoFactorDomain(twofactor.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := d.UseTOTPStep(ctx, 3, 100)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "already used")
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteTwoFactor(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM "recovery_codes" WHERE user_id = \$1`).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 10))
+	mock.ExpectExec(`DELETE FROM "two_factors" WHERE user_id = \$1`).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	d := twofactor.InitTwoFactorDomain(twofactor.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	err := d.DeleteTwoFactor(ctx, 3)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateLoginChallenge(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "login_challenges" SET "completed_at"=\$1 WHERE id = \$2`).
+		WithArgs(now, 5).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	d := twofactor.InitTwoFactorDomain(twofactor.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	err := d.UpdateLoginChallenge(ctx, entity.UpdateLoginChallenge{ID: 5, CompletedAt: &now})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
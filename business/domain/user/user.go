@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/zuhrulumam/go-hris/business/entity"
 	"gorm.io/gorm"
@@ -12,7 +13,27 @@ type DomainItf interface {
 	Register(ctx context.Context, req entity.RegisterRequest) error
 	Login(ctx context.Context, req entity.LoginRequest) (*entity.User, error)
 
+	CreateUser(ctx context.Context, req entity.RegisterRequest) (*entity.User, error)
+	UpdateUser(ctx context.Context, data entity.UpdateUser) error
 	GetUsers(ctx context.Context, filter entity.GetUserFilter) ([]entity.User, error)
+	ListUsers(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error)
+
+	// SetPassword stores a new password for the user and clears
+	// must_change_password.
+	SetPassword(ctx context.Context, userID uint, password string, at time.Time) error
+	// CheckPassword fails unless password is the user's current password.
+	CheckPassword(ctx context.Context, userID uint, password string) error
+
+	CreatePasswordResetToken(ctx context.Context, data entity.PasswordResetToken) error
+	// GetPasswordResetToken returns nil when no token has the hash.
+	GetPasswordResetToken(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error)
+	UsePasswordResetToken(ctx context.Context, id uint, at time.Time) error
+	// InvalidatePasswordResetTokens marks the user's unused tokens as used.
+	InvalidatePasswordResetTokens(ctx context.Context, userID uint, at time.Time) error
+
+	CreateEmployeeProfile(ctx context.Context, data entity.EmployeeProfile) error
+	UpdateEmployeeProfile(ctx context.Context, data entity.UpdateEmployeeProfile) error
+	GetEmployeeProfiles(ctx context.Context, filter entity.GetEmployeeProfileFilter) ([]entity.EmployeeProfile, error)
 }
 
 type user struct {
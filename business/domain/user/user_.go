@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/zuhrulumam/go-hris/pkg"
 	"golang.org/x/crypto/bcrypt"
@@ -15,6 +16,14 @@ import (
 )
 
 func (u *user) Register(ctx context.Context, req entity.RegisterRequest) error {
+	// Self-registered accounts are always employees
+	req.Role = string(entity.RoleEmployee)
+
+	_, err := u.CreateUser(ctx, req)
+	return err
+}
+
+func (u *user) CreateUser(ctx context.Context, req entity.RegisterRequest) (*entity.User, error) {
 	db := pkg.GetTransactionFromCtx(ctx, u.db)
 
 	// Check if username already exists
@@ -23,28 +32,102 @@ func (u *user) Register(ctx context.Context, req entity.RegisterRequest) error {
 		Model(&entity.User{}).
 		Where("username = ?", req.Username).
 		Count(&count).Error; err != nil {
-		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to check existing user")
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check existing user")
 	}
 	if count > 0 {
-		return x.NewWithCode(http.StatusBadRequest, "username already taken")
+		return nil, x.NewWithCode(http.StatusBadRequest, "username already taken")
+	}
+
+	if req.Email != "" {
+		if err := db.WithContext(ctx).
+			Model(&entity.User{}).
+			Where("email = ?", req.Email).
+			Count(&count).Error; err != nil {
+			return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to check existing user")
+		}
+		if count > 0 {
+			return nil, x.NewWithCode(http.StatusBadRequest, "email already taken")
+		}
 	}
 
 	// Hash password
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
-		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to hash password")
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to hash password")
+	}
+
+	role := entity.UserRole(req.Role)
+	if role == "" {
+		role = entity.RoleEmployee
 	}
 
 	user := entity.User{
 		Username: req.Username,
 		Password: string(hashedPassword),
 		FullName: req.FullName,
-		Role:     entity.RoleEmployee,
+		Email:    req.Email,
+		Role:     role,
 		Salary:   req.Salary,
+		IsActive: true,
+
+		MustChangePassword: req.MustChangePassword,
 	}
 
 	if err := db.WithContext(ctx).Create(&user).Error; err != nil {
-		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create user")
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to create user")
+	}
+
+	user.Password = ""
+
+	return &user, nil
+}
+
+func (u *user) UpdateUser(ctx context.Context, data entity.UpdateUser) error {
+	db := pkg.GetTransactionFromCtx(ctx, u.db)
+
+	updates := map[string]interface{}{}
+	if data.FullName != nil {
+		updates["full_name"] = *data.FullName
+	}
+	if data.Email != nil {
+		updates["email"] = *data.Email
+	}
+	if data.Role != nil {
+		updates["role"] = *data.Role
+	}
+	if data.Salary != nil {
+		updates["salary"] = *data.Salary
+	}
+	if data.IsActive != nil {
+		updates["is_active"] = *data.IsActive
+	}
+	if data.MustChangePassword != nil {
+		updates["must_change_password"] = *data.MustChangePassword
+	}
+	if data.DepartmentID != nil {
+		updates["department_id"] = nullableID(*data.DepartmentID)
+	}
+	if data.PositionID != nil {
+		updates["position_id"] = nullableID(*data.PositionID)
+	}
+	if data.ManagerID != nil {
+		updates["manager_id"] = nullableID(*data.ManagerID)
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	res := db.WithContext(ctx).
+		Model(&entity.User{}).
+		Where("id = ?", data.ID).
+		Updates(updates)
+	if res.Error != nil {
+		return x.WrapWithCode(res.Error, http.StatusInternalServerError, "failed to update user")
+	}
+
+	if res.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "employee not found")
 	}
 
 	return nil
@@ -71,6 +154,11 @@ func (u *user) Login(ctx context.Context, req entity.LoginRequest) (*entity.User
 		return nil, x.NewWithCode(http.StatusUnauthorized, "invalid username or password")
 	}
 
+	// Checked after the password so the account state is only revealed to its owner
+	if !user.IsActive {
+		return nil, x.NewWithCode(http.StatusForbidden, "account is deactivated")
+	}
+
 	// Remove password before returning (optional)
 	user.Password = ""
 
@@ -87,6 +175,10 @@ func (r *user) GetUsers(ctx context.Context, filter entity.GetUserFilter) ([]ent
 		query = query.Where("id = ?", filter.ID)
 	}
 
+	if filter.Username != "" {
+		query = query.Where("username = ?", filter.Username)
+	}
+
 	if filter.Role != "" {
 		query = query.Where("role = ?", filter.Role)
 	}
@@ -95,9 +187,283 @@ func (r *user) GetUsers(ctx context.Context, filter entity.GetUserFilter) ([]ent
 		query = query.Where("email = ?", filter.Email)
 	}
 
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+
+	if filter.ManagerID > 0 {
+		query = query.Where("manager_id = ?", filter.ManagerID)
+	}
+
 	if err := query.Find(&users).Error; err != nil {
 		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to get users")
 	}
 
 	return users, nil
 }
+
+func (r *user) ListUsers(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error) {
+	db := pkg.GetTransactionFromCtx(ctx, r.db).WithContext(ctx)
+	query := db.Model(&entity.User{})
+
+	if filter.Search != "" {
+		like := "%" + filter.Search + "%"
+		query = query.Where("username ILIKE ? OR full_name ILIKE ? OR email ILIKE ?", like, like, like)
+	}
+
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+
+	if filter.IsActive != nil {
+		query = query.Where("is_active = ?", *filter.IsActive)
+	}
+
+	if len(filter.UserIDs) > 0 {
+		query = query.Where("id IN ?", filter.UserIDs)
+	}
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
+		return nil, 0, 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to count users")
+	}
+
+	limit := 10
+	page := 1
+
+	if filter.Limit > 0 {
+		limit = filter.Limit
+	}
+
+	if filter.Page > 0 {
+		page = filter.Page
+	}
+
+	var users []entity.User
+	if err := query.Order("id ASC").Limit(limit).Offset((page - 1) * limit).Find(&users).Error; err != nil {
+		return nil, 0, 0, x.WrapWithCode(err, http.StatusInternalServerError, "failed to get users")
+	}
+
+	for i := range users {
+		users[i].Password = ""
+	}
+
+	totalPage := int((totalCount + int64(limit) - 1) / int64(limit))
+
+	return users, totalCount, totalPage, nil
+}
+
+func (r *user) SetPassword(ctx context.Context, userID uint, password string, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to hash password")
+	}
+
+	res := db.WithContext(ctx).
+		Model(&entity.User{}).
+		Where("id = ?", userID).
+		Updates(map[string]interface{}{
+			"password":             string(hashedPassword),
+			"must_change_password": false,
+			"password_changed_at":  at,
+		})
+	if res.Error != nil {
+		return x.WrapWithCode(res.Error, http.StatusInternalServerError, "failed to update password")
+	}
+
+	if res.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "employee not found")
+	}
+
+	return nil
+}
+
+func (r *user) CheckPassword(ctx context.Context, userID uint, password string) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	var users []entity.User
+	err := db.WithContext(ctx).
+		Where("id = ?", userID).
+		Limit(1).
+		Find(&users).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to query user")
+	}
+
+	if len(users) < 1 {
+		return x.NewWithCode(http.StatusNotFound, "employee not found")
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(users[0].Password), []byte(password)); err != nil {
+		return x.NewWithCode(http.StatusBadRequest, "current password is incorrect")
+	}
+
+	return nil
+}
+
+func (r *user) CreatePasswordResetToken(ctx context.Context, data entity.PasswordResetToken) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create password reset token")
+	}
+	return nil
+}
+
+func (r *user) GetPasswordResetToken(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	var result []entity.PasswordResetToken
+	err := db.WithContext(ctx).
+		Where("token_hash = ?", tokenHash).
+		Limit(1).
+		Find(&result).Error
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to fetch password reset token")
+	}
+
+	if len(result) < 1 {
+		return nil, nil
+	}
+
+	return &result[0], nil
+}
+
+func (r *user) UsePasswordResetToken(ctx context.Context, id uint, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	tx := db.WithContext(ctx).
+		Model(&entity.PasswordResetToken{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", at)
+
+	if tx.Error != nil {
+		return x.WrapWithCode(tx.Error, http.StatusInternalServerError, "failed to use password reset token")
+	}
+
+	if tx.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "password reset link was already used")
+	}
+
+	return nil
+}
+
+func (r *user) InvalidatePasswordResetTokens(ctx context.Context, userID uint, at time.Time) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	err := db.WithContext(ctx).
+		Model(&entity.PasswordResetToken{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", at).Error
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to invalidate password reset tokens")
+	}
+
+	return nil
+}
+
+func (r *user) CreateEmployeeProfile(ctx context.Context, data entity.EmployeeProfile) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	if err := db.WithContext(ctx).Create(&data).Error; err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create employee profile")
+	}
+
+	return nil
+}
+
+func (r *user) UpdateEmployeeProfile(ctx context.Context, data entity.UpdateEmployeeProfile) error {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	updates := map[string]interface{}{}
+	if data.EmployeeNumber != nil {
+		updates["employee_number"] = *data.EmployeeNumber
+	}
+	if data.Phone != nil {
+		updates["phone"] = *data.Phone
+	}
+	if data.HireDate != nil {
+		updates["hire_date"] = *data.HireDate
+	}
+	if data.EmploymentType != nil {
+		updates["employment_type"] = *data.EmploymentType
+	}
+	if data.JobTitle != nil {
+		updates["job_title"] = *data.JobTitle
+	}
+	if data.NIK != nil {
+		updates["nik"] = *data.NIK
+	}
+	if data.NPWP != nil {
+		updates["npwp"] = *data.NPWP
+	}
+	if data.PTKPStatus != nil {
+		updates["ptkp_status"] = *data.PTKPStatus
+	}
+	if data.BankName != nil {
+		updates["bank_name"] = *data.BankName
+	}
+	if data.BankAccountNumber != nil {
+		updates["bank_account_number"] = *data.BankAccountNumber
+	}
+	if data.BankAccountName != nil {
+		updates["bank_account_name"] = *data.BankAccountName
+	}
+	if data.EmergencyContactName != nil {
+		updates["emergency_contact_name"] = *data.EmergencyContactName
+	}
+	if data.EmergencyContactPhone != nil {
+		updates["emergency_contact_phone"] = *data.EmergencyContactPhone
+	}
+	if data.EmergencyContactRelation != nil {
+		updates["emergency_contact_relation"] = *data.EmergencyContactRelation
+	}
+
+	if len(updates) == 0 {
+		return nil
+	}
+
+	res := db.WithContext(ctx).
+		Model(&entity.EmployeeProfile{}).
+		Where("user_id = ?", data.UserID).
+		Updates(updates)
+	if res.Error != nil {
+		return x.WrapWithCode(res.Error, http.StatusInternalServerError, "failed to update employee profile")
+	}
+
+	if res.RowsAffected == 0 {
+		return x.NewWithCode(http.StatusNotFound, "employee profile not found")
+	}
+
+	return nil
+}
+
+func (r *user) GetEmployeeProfiles(ctx context.Context, filter entity.GetEmployeeProfileFilter) ([]entity.EmployeeProfile, error) {
+	db := pkg.GetTransactionFromCtx(ctx, r.db)
+
+	var profiles []entity.EmployeeProfile
+	query := db.WithContext(ctx).Model(&entity.EmployeeProfile{})
+
+	if filter.UserID > 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+
+	if filter.EmployeeNumber != "" {
+		query = query.Where("employee_number = ?", filter.EmployeeNumber)
+	}
+
+	if err := query.Find(&profiles).Error; err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to get employee profiles")
+	}
+
+	return profiles, nil
+}
+
+// nullableID stores a zero reference as NULL.
+func nullableID(id uint) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
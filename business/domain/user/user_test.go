@@ -38,7 +38,7 @@ func TestRegister(t *testing.T) {
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 				mock.ExpectQuery(`INSERT INTO "users"`).
-					WithArgs(input.Username, sqlmock.AnyArg(), input.FullName, "employee", input.Salary, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(input.Username, sqlmock.AnyArg(), input.FullName, input.Email, "employee", input.Salary, true, nil, nil, nil, false, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
 
 			},
@@ -104,7 +104,7 @@ func TestRegister(t *testing.T) {
 					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
 
 				mock.ExpectQuery(`INSERT INTO "users"`).
-					WithArgs(input.Username, sqlmock.AnyArg(), input.FullName, "employee", input.Salary, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WithArgs(input.Username, sqlmock.AnyArg(), input.FullName, input.Email, "employee", input.Salary, true, nil, nil, nil, false, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
 					WillReturnError(errors.New("insert failed"))
 			},
 			expectError: true,
@@ -163,11 +163,28 @@ func TestLogin(t *testing.T) {
 				mock.ExpectQuery(`SELECT.*FROM "users"`).
 					WithArgs(input.Username, 1).
 					WillReturnRows(sqlmock.NewRows([]string{
-						"id", "username", "password", "full_name", "role", "salary", "created_at", "updated_at",
-					}).AddRow(1, input.Username, hashedPassword, "John Doe", "employee", 5000000, time.Now(), time.Now()))
+						"id", "username", "password", "full_name", "role", "salary", "is_active", "created_at", "updated_at",
+					}).AddRow(1, input.Username, hashedPassword, "John Doe", "employee", 5000000, true, time.Now(), time.Now()))
 			},
 			expectError: false,
 		},
+		{
+			name: "Deactivated account",
+			input: entity.LoginRequest{
+				Username: "johndoe",
+				Password: "securepass",
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.LoginRequest, hashedPassword string) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT.*FROM "users"`).
+					WithArgs(input.Username, 1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "username", "password", "full_name", "role", "salary", "is_active", "created_at", "updated_at",
+					}).AddRow(1, input.Username, hashedPassword, "John Doe", "employee", 5000000, false, time.Now(), time.Now()))
+			},
+			expectError: true,
+			errorText:   "account is deactivated",
+		},
 		{
 			name: "User not found",
 			input: entity.LoginRequest{
@@ -194,8 +211,8 @@ func TestLogin(t *testing.T) {
 				mock.ExpectQuery(`SELECT.*FROM "users"`).
 					WithArgs(input.Username, 1).
 					WillReturnRows(sqlmock.NewRows([]string{
-						"id", "username", "password", "full_name", "role", "salary", "created_at", "updated_at",
-					}).AddRow(1, input.Username, hashedPassword, "John Doe", "employee", 5000000, time.Now(), time.Now()))
+						"id", "username", "password", "full_name", "role", "salary", "is_active", "created_at", "updated_at",
+					}).AddRow(1, input.Username, hashedPassword, "John Doe", "employee", 5000000, true, time.Now(), time.Now()))
 			},
 			expectError: true,
 			errorText:   "invalid username or password",
@@ -371,3 +388,499 @@ func TestGetUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.RegisterRequest
+		mockSetup   func(mock sqlmock.Sqlmock, input entity.RegisterRequest)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name: "Creates admin with email",
+			input: entity.RegisterRequest{
+				Username: "jane",
+				Password: "securepass",
+				FullName: "Jane Doe",
+				Email:    "jane@example.com",
+				Role:     "admin",
+				Salary:   9000000,
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.RegisterRequest) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count.*FROM "users" WHERE username = \$1`).
+					WithArgs(input.Username).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`SELECT count.*FROM "users" WHERE email = \$1`).
+					WithArgs(input.Email).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`INSERT INTO "users"`).
+					WithArgs(input.Username, sqlmock.AnyArg(), input.FullName, input.Email, "admin", input.Salary, true, nil, nil, nil, false, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+			},
+		},
+		{
+			name: "Email already taken",
+			input: entity.RegisterRequest{
+				Username: "jane",
+				Password: "securepass",
+				Email:    "taken@example.com",
+			},
+			mockSetup: func(mock sqlmock.Sqlmock, input entity.RegisterRequest) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count.*FROM "users" WHERE username = \$1`).
+					WithArgs(input.Username).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				mock.ExpectQuery(`SELECT count.*FROM "users" WHERE email = \$1`).
+					WithArgs(input.Email).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			},
+			expectError: true,
+			errorText:   "email already taken",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock, tt.input)
+
+			u := user.InitUserDomain(user.Option{
+				DB: db,
+			})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			created, err := u.CreateUser(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(7), created.ID)
+				assert.Empty(t, created.Password)
+				assert.True(t, created.IsActive)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	salary := 6000000.0
+	inactive := false
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateUser
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "Updates given fields",
+			input: entity.UpdateUser{ID: 3, Salary: &salary, IsActive: &inactive},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "users" SET "is_active"=\$1,"salary"=\$2,"updated_at"=\$3 WHERE id = \$4`).
+					WithArgs(false, salary, sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:  "Not found",
+			input: entity.UpdateUser{ID: 3, Salary: &salary},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "users"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "employee not found",
+		},
+		{
+			name:  "Nothing to update",
+			input: entity.UpdateUser{ID: 3},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			u := user.InitUserDomain(user.Option{
+				DB: db,
+			})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := u.UpdateUser(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	active := true
+
+	tests := []struct {
+		name          string
+		filter        entity.ListUserFilter
+		mockSetup     func(mock sqlmock.Sqlmock)
+		expectError   bool
+		errorText     string
+		expectLen     int
+		expectTotal   int64
+		expectedPages int
+	}{
+		{
+			name:   "Search active employees",
+			filter: entity.ListUserFilter{Search: "doe", Role: "employee", IsActive: &active, Page: 2, Limit: 1},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "users" WHERE \(username ILIKE \$1 OR full_name ILIKE \$2 OR email ILIKE \$3\) AND role = \$4 AND is_active = \$5`).
+					WithArgs("%doe%", "%doe%", "%doe%", "employee", true).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+				mock.ExpectQuery(`SELECT \* FROM "users" WHERE .* ORDER BY id ASC LIMIT \$6 OFFSET \$7`).
+					WithArgs("%doe%", "%doe%", "%doe%", "employee", true, 1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "username", "password", "full_name", "role", "salary", "is_active",
+					}).AddRow(2, "jdoe", "hashedpass", "Jane Doe", "employee", 5000000, true))
+			},
+			expectLen:     1,
+			expectTotal:   3,
+			expectedPages: 3,
+		},
+		{
+			name:   "Scoped to a manager's reports",
+			filter: entity.ListUserFilter{UserIDs: []uint{4, 7}},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "users" WHERE id IN \(\$1,\$2\)`).
+					WithArgs(4, 7).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+				mock.ExpectQuery(`SELECT \* FROM "users" WHERE id IN \(\$1,\$2\) ORDER BY id ASC LIMIT \$3`).
+					WithArgs(4, 7, 10).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "username"}).
+						AddRow(4, "manager").AddRow(7, "report"))
+			},
+			expectLen:     2,
+			expectTotal:   2,
+			expectedPages: 1,
+		},
+		{
+			name:   "Count error",
+			filter: entity.ListUserFilter{},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "users"`).
+					WillReturnError(errors.New("db failure"))
+			},
+			expectError: true,
+			errorText:   "failed to count users",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			r := user.InitUserDomain(user.Option{
+				DB: db,
+			})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			users, total, pages, err := r.ListUsers(ctx, tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, users, tt.expectLen)
+				assert.Empty(t, users[0].Password)
+				assert.Equal(t, tt.expectTotal, total)
+				assert.Equal(t, tt.expectedPages, pages)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateEmployeeProfile(t *testing.T) {
+	phone := "+6281234567890"
+	ptkp := "K/1"
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateEmployeeProfile
+		mockSetup   func(mock sqlmock.Sqlmock)
+		expectError bool
+		errorText   string
+	}{
+		{
+			name:  "Updates given fields",
+			input: entity.UpdateEmployeeProfile{UserID: 3, Phone: &phone, PTKPStatus: &ptkp},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "employee_profiles" SET "phone"=\$1,"ptkp_status"=\$2,"updated_at"=\$3 WHERE user_id = \$4`).
+					WithArgs(phone, ptkp, sqlmock.AnyArg(), 3).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			name:  "Not found",
+			input: entity.UpdateEmployeeProfile{UserID: 3, Phone: &phone},
+			mockSetup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "employee_profiles"`).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			expectError: true,
+			errorText:   "employee profile not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			tt.mockSetup(mock)
+
+			u := user.InitUserDomain(user.Option{
+				DB: db,
+			})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := u.UpdateEmployeeProfile(ctx, tt.input)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetEmployeeProfiles(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "employee_profiles" WHERE user_id = \$1 AND employee_number = \$2`).
+		WithArgs(3, "EMP0003").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "employee_number", "nik"}).
+			AddRow(1, 3, "EMP0003", "3171234567890001"))
+
+	u := user.InitUserDomain(user.Option{
+		DB: db,
+	})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	profiles, err := u.GetEmployeeProfiles(ctx, entity.GetEmployeeProfileFilter{UserID: 3, EmployeeNumber: "EMP0003"})
+
+	assert.NoError(t, err)
+	assert.Len(t, profiles, 1)
+	assert.Equal(t, "3171234567890001", profiles[0].NIK)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetPassword(t *testing.T) {
+	at := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		rows        int64
+		expectError bool
+		errorText   string
+	}{
+		{name: "Stores hash and clears must change", rows: 1},
+		{name: "Not found", rows: 0, expectError: true, errorText: "employee not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "users" SET "must_change_password"=\$1,"password"=\$2,"password_changed_at"=\$3,"updated_at"=\$4 WHERE id = \$5`).
+				WithArgs(false, sqlmock.AnyArg(), at, sqlmock.AnyArg(), 3).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			u := user.InitUserDomain(user.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := u.SetPassword(ctx, 3, "N3wSecret", at)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestCheckPassword(t *testing.T) {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("Secret123"), bcrypt.MinCost)
+
+	tests := []struct {
+		name        string
+		password    string
+		found       bool
+		expectError bool
+		errorText   string
+	}{
+		{name: "Matches", password: "Secret123", found: true},
+		{name: "Wrong password", password: "guess", found: true, expectError: true, errorText: "current password is incorrect"},
+		{name: "Not found", password: "Secret123", expectError: true, errorText: "employee not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			rows := sqlmock.NewRows([]string{"id", "password"})
+			if tt.found {
+				rows.AddRow(3, string(hashed))
+			}
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT \* FROM "users" WHERE id = \$1 LIMIT \$2`).
+				WithArgs(3, 1).
+				WillReturnRows(rows)
+
+			u := user.InitUserDomain(user.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := u.CheckPassword(ctx, 3, tt.password)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGetPasswordResetToken(t *testing.T) {
+	db, mock, cleanup := pkg.SetupMockDB(t)
+	defer cleanup()
+
+	expires := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT \* FROM "password_reset_tokens" WHERE token_hash = \$1 LIMIT \$2`).
+		WithArgs("abc", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_hash", "expires_at"}).
+			AddRow(4, 3, "abc", expires))
+	mock.ExpectQuery(`SELECT \* FROM "password_reset_tokens" WHERE token_hash = \$1 LIMIT \$2`).
+		WithArgs("missing", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	u := user.InitUserDomain(user.Option{DB: db})
+
+	tx := db.Begin()
+	ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+	token, err := u.GetPasswordResetToken(ctx, "abc")
+	assert.NoError(t, err)
+	assert.Equal(t, &entity.PasswordResetToken{ID: 4, UserID: 3, TokenHash: "abc", ExpiresAt: expires}, token)
+
+	token, err = u.GetPasswordResetToken(ctx, "missing")
+	assert.NoError(t, err)
+	assert.Nil(t, token)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUsePasswordResetToken(t *testing.T) {
+	at := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		rows        int64
+		expectError bool
+		errorText   string
+	}{
+		{name: "Marks token used", rows: 1},
+		{name: "Already used", rows: 0, expectError: true, errorText: "password reset link was already used"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, cleanup := pkg.SetupMockDB(t)
+			defer cleanup()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(`UPDATE "password_reset_tokens" SET "used_at"=\$1 WHERE id = \$2 AND used_at IS NULL`).
+				WithArgs(at, 4).
+				WillReturnResult(sqlmock.NewResult(0, tt.rows))
+
+			u := user.InitUserDomain(user.Option{DB: db})
+
+			tx := db.Begin()
+			ctx := context.WithValue(context.Background(), pkg.TxCtxValue, tx)
+
+			err := u.UsePasswordResetToken(ctx, 4, at)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package entity
+
+// Permission names an action a role may take. Admins hold every permission.
+type Permission string
+
+const (
+	PermEmployeeRead    Permission = "employee:read" // own reporting line unless employee:read_all is held too
+	PermEmployeeReadAll Permission = "employee:read_all"
+	PermEmployeeManage  Permission = "employee:manage"
+	PermOrgManage       Permission = "organization:manage"
+	PermRoleManage      Permission = "role:manage"
+	PermSecurityRead    Permission = "security:read" // authentication audit trail
+
+	PermAttendanceReadAll  Permission = "attendance:read_all"
+	PermAttendanceReadTeam Permission = "attendance:read_team"
+	PermAttendanceReview   Permission = "attendance:review"
+	PermPeriodManage       Permission = "attendance_period:manage"
+	PermScheduleManage     Permission = "schedule:manage" // shifts, rosters, holidays, locations and devices
+
+	PermTravelReview Permission = "travel:review"
+
+	PermPayrollRun      Permission = "payroll:run"
+	PermPayrollReadAll  Permission = "payroll:read_all"
+	PermPayrollReadTeam Permission = "payroll:read_team"
+)
+
+// AllPermissions lists every permission a role can be granted.
+var AllPermissions = []Permission{
+	PermEmployeeRead,
+	PermEmployeeReadAll,
+	PermEmployeeManage,
+	PermOrgManage,
+	PermRoleManage,
+	PermSecurityRead,
+	PermAttendanceReadAll,
+	PermAttendanceReadTeam,
+	PermAttendanceReview,
+	PermPeriodManage,
+	PermScheduleManage,
+	PermTravelReview,
+	PermPayrollRun,
+	PermPayrollReadAll,
+	PermPayrollReadTeam,
+}
+
+// DefaultRolePermissions is what the seeder stores for each role. Admins
+// are not listed since they are granted everything.
+var DefaultRolePermissions = map[UserRole][]Permission{
+	RoleEmployee: {},
+	RoleManager: {
+		PermEmployeeRead,
+		PermAttendanceReadTeam,
+		PermAttendanceReview,
+		PermTravelReview,
+	},
+	RoleHR: {
+		PermEmployeeRead,
+		PermEmployeeReadAll,
+		PermEmployeeManage,
+		PermOrgManage,
+		PermAttendanceReadAll,
+		PermAttendanceReview,
+		PermPeriodManage,
+		PermScheduleManage,
+		PermTravelReview,
+	},
+	RolePayrollAdmin: {
+		PermEmployeeRead,
+		PermEmployeeReadAll,
+		PermAttendanceReadAll,
+		PermPeriodManage,
+		PermPayrollRun,
+		PermPayrollReadAll,
+	},
+	RoleAuditor: {
+		PermEmployeeRead,
+		PermEmployeeReadAll,
+		PermSecurityRead,
+		PermAttendanceReadAll,
+		PermPayrollReadAll,
+	},
+}
+
+type Role struct {
+	ID          uint
+	Name        UserRole
+	Description string
+}
+
+type RolePermission struct {
+	ID         uint
+	Role       UserRole
+	Permission Permission
+}
+
+// RoleAccess is a role with the permissions it grants.
+type RoleAccess struct {
+	Role        Role
+	Permissions []Permission
+}
+
+type SetRolePermissionsRequest struct {
+	Role        UserRole
+	Permissions []Permission
+}
+
+// AccessScope is whose data a user may see. All means everyone; otherwise
+// UserIDs holds the user and, for managers, everyone reporting to them.
+type AccessScope struct {
+	All     bool
+	UserIDs []uint
+}
+
+// Allows reports whether the scope covers userID.
+func (s AccessScope) Allows(userID uint) bool {
+	if s.All {
+		return true
+	}
+
+	for _, id := range s.UserIDs {
+		if id == userID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -2,13 +2,41 @@ package entity
 
 import "time"
 
+// WorkMode is where an attendance day was worked from.
+type WorkMode string
+
+const (
+	WorkModeOffice       WorkMode = "office"
+	WorkModeWFH          WorkMode = "wfh"
+	WorkModeClientSite   WorkMode = "client_site"
+	WorkModeBusinessTrip WorkMode = "business_trip"
+)
+
 type Attendance struct {
 	ID                 uint
 	UserID             uint
-	Date               time.Time
+	Date               time.Time // shift date, which differs from the check-in date for night shifts
 	AttendancePeriodID uint
+	ShiftID            *uint
+	WorkMode           WorkMode
 	CheckedInAt        *time.Time
 	CheckedOutAt       *time.Time
+	WorkedMinutes      int // filled on check-out, excludes unpaid break time
+	BreakMinutes       int // break time recorded between check-in and check-out
+	LateMinutes        int
+	EarlyLeaveMinutes  int
+	CheckInLatitude    *float64
+	CheckInLongitude   *float64
+	CheckInAccuracy    *float64
+	CheckInLocationID  *uint // office the check-in matched, nil when none did
+	CheckInKioskID     *uint // set when checked in by scanning a kiosk QR code
+	CheckOutLatitude   *float64
+	CheckOutLongitude  *float64
+	CheckOutAccuracy   *float64
+	CheckOutLocationID *uint
+	CheckOutKioskID    *uint
+	OutsideGeofence    bool // set when a punch fell outside every office in flag mode
+	AutoClosed         bool // check-out was filled in at the shift end by the scheduler
 	CreatedAt          time.Time
 	UpdatedAt          *time.Time
 	Version            uint
@@ -18,6 +46,17 @@ type CreateAttendance struct {
 	UserID             uint
 	AttendancePeriodID uint
 	CheckInAt          time.Time
+	CheckOutAt         *time.Time // optional, set when a correction creates a full day
+	Date               time.Time  // optional, defaults to the check-in date
+	ShiftID            *uint
+	WorkMode           WorkMode // optional, defaults to office
+	WorkedMinutes      int
+	LateMinutes        int
+	EarlyLeaveMinutes  int
+	CheckInPoint       *GeoPoint
+	CheckInLocationID  *uint
+	CheckInKioskID     *uint
+	OutsideGeofence    bool
 }
 
 type UpdateAttendance struct {
@@ -26,6 +65,15 @@ type UpdateAttendance struct {
 	AttendancePeriodID uint
 	CheckOutAt         *time.Time
 	CheckInAt          *time.Time
+	WorkedMinutes      *int
+	BreakMinutes       *int
+	LateMinutes        *int
+	EarlyLeaveMinutes  *int
+	CheckOutPoint      *GeoPoint
+	CheckOutLocationID *uint
+	CheckOutKioskID    *uint
+	OutsideGeofence    *bool
+	AutoClosed         *bool
 	Version            uint
 }
 
@@ -56,28 +104,173 @@ type Overtime struct {
 }
 
 type CheckIn struct {
-	UserID uint
-	Date   time.Time // Date of check-in
+	UserID     uint
+	Date       time.Time // Date of check-in
+	WorkMode   WorkMode  // optional, defaults to office
+	Location   *GeoPoint // optional, required when geofencing is enforced
+	KioskToken string    // optional, a scanned kiosk QR token stands in for Location
 }
 
 type CheckOut struct {
-	UserID uint
-	Date   time.Time // Date of check-out
+	UserID     uint
+	Date       time.Time // Date of check-out
+	Location   *GeoPoint
+	KioskToken string
 }
 
 type GetAttendance struct {
 	UserID             uint
 	AttendancePeriodID uint
 	Date               time.Time
+	StartDate          *time.Time // optional, inclusive
+	EndDate            *time.Time // optional, inclusive
+	Open               bool       // only attendances without a check-out
+	WorkMode           WorkMode   // optional
+	CheckedInBefore    *time.Time // optional, exclusive
+}
+
+// WorkMetrics is what an attendance record is measured against its schedule.
+type WorkMetrics struct {
+	WorkedMinutes     int
+	BreakMinutes      int
+	LateMinutes       int
+	EarlyLeaveMinutes int
+}
+
+// AttendanceBreak is a break taken between check-in and check-out. A day
+// can have several, at most one of them without an end.
+type AttendanceBreak struct {
+	ID           uint
+	AttendanceID uint
+	UserID       uint
+	StartedAt    time.Time
+	EndedAt      *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type StartBreak struct {
+	UserID uint
+	Date   time.Time // when the break started
+}
+
+type EndBreak struct {
+	UserID uint
+	Date   time.Time // when the break ended
+}
+
+type UpdateAttendanceBreak struct {
+	ID      uint
+	EndedAt time.Time
+}
+
+type GetAttendanceBreakFilter struct {
+	AttendanceID uint
+	UserID       uint
+	Open         bool // only the break still in progress
+}
+
+type GetAttendanceReportRequest struct {
+	UserID    uint
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type AttendanceReport struct {
+	UserID                 uint
+	StartDate              time.Time
+	EndDate                time.Time
+	Attendances            []Attendance
+	AttendedDays           int
+	TotalWorkedMinutes     int
+	LateDays               int
+	TotalLateMinutes       int
+	EarlyLeaveDays         int
+	TotalEarlyLeaveMinutes int
+	ByWorkMode             []WorkModeSummary
+}
+
+type AttendanceDayStatus string
+
+const (
+	AttendanceDayPresent AttendanceDayStatus = "present"
+	AttendanceDayAbsent  AttendanceDayStatus = "absent"
+	AttendanceDayWeekend AttendanceDayStatus = "weekend"
+	AttendanceDayHoliday AttendanceDayStatus = "holiday"
+	// AttendanceDayLeave is reserved for days on approved leave. Leave is not
+	// tracked yet, so such days are reported as absent for now.
+	AttendanceDayLeave AttendanceDayStatus = "leave"
+)
+
+type GetAttendanceHistoryRequest struct {
+	UserID             uint
+	AttendancePeriodID uint       // either a period or a date range
+	StartDate          *time.Time // inclusive
+	EndDate            *time.Time // inclusive
+	Page               int
+	Limit              int
+}
+
+// AttendanceDay is one calendar day of an employee's attendance history.
+type AttendanceDay struct {
+	Date          time.Time
+	Status        AttendanceDayStatus
+	Attendance    *Attendance // nil when nobody checked in that day
+	OvertimeHours float64
+	Weekend       bool   // a rest day, from the roster or Saturday and Sunday
+	Holiday       bool   // a company holiday
+	HolidayName   string // set when Holiday is
+}
+
+type AttendanceHistory struct {
+	Days       []AttendanceDay // newest first
+	TotalData  int64
+	TotalPages int
+}
+
+// WorkModeSummary totals the attendance days worked in one mode.
+type WorkModeSummary struct {
+	UserID        uint
+	WorkMode      WorkMode
+	Days          int
+	WorkedMinutes int
+}
+
+type GetWorkModeSummaryFilter struct {
+	AttendancePeriodID uint
+	UserID             uint // optional
+}
+
+// An attendance period moves open -> locked -> payroll_processing -> closed,
+// and a closed period can be reopened to fix attendance and run payroll again.
+const (
+	AttendancePeriodOpen              = "open"
+	AttendancePeriodLocked            = "locked"
+	AttendancePeriodPayrollProcessing = "payroll_processing"
+	AttendancePeriodClosed            = "closed"
+	AttendancePeriodReopened          = "reopened"
+)
+
+// attendancePeriodTransitions lists the statuses each status can move to.
+var attendancePeriodTransitions = map[string][]string{
+	AttendancePeriodOpen:              {AttendancePeriodLocked},
+	AttendancePeriodLocked:            {AttendancePeriodOpen, AttendancePeriodPayrollProcessing},
+	AttendancePeriodPayrollProcessing: {AttendancePeriodLocked, AttendancePeriodClosed},
+	AttendancePeriodClosed:            {AttendancePeriodReopened},
+	AttendancePeriodReopened:          {AttendancePeriodLocked},
 }
 
 type GetAttendancePeriodFilter struct {
 	ID           string
 	Status       string
+	Statuses     []string // optional, any of these
 	UserID       string
 	StartDate    *time.Time
 	EndDate      *time.Time
 	ContainsDate *time.Time
+	OverlapStart *time.Time // with OverlapEnd, periods sharing a day with the range
+	OverlapEnd   *time.Time
+	ExcludeID    uint
 }
 
 type AttendancePeriod struct {
@@ -85,19 +278,132 @@ type AttendancePeriod struct {
 	StartDate time.Time
 	EndDate   time.Time
 	Status    string
+	ClosedAt  *time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+// CanMoveTo reports whether the period's status may change to status.
+func (p AttendancePeriod) CanMoveTo(status string) bool {
+	for _, next := range attendancePeriodTransitions[p.Status] {
+		if next == status {
+			return true
+		}
+	}
+	return false
+}
+
+// AcceptsAttendance reports whether attendance in the period can still change.
+func (p AttendancePeriod) AcceptsAttendance() bool {
+	return p.Status == AttendancePeriodOpen || p.Status == AttendancePeriodReopened
+}
+
 type UpdateAttendancePeriod struct {
-	ID        uint
-	Status    *string
-	StartDate *time.Time
-	EndDate   *time.Time
-	ClosedAt  *time.Time
+	ID             uint
+	Status         *string
+	StartDate      *time.Time
+	EndDate        *time.Time
+	ClosedAt       *time.Time
+	ExpectedStatus string // optional, only update while the period still has this status
 }
 
 type CreateAttendancePeriodRequest struct {
 	StartDate time.Time `json:"start_date"`
 	EndDate   time.Time `json:"end_date"`
 }
+
+type UpdateAttendancePeriodRequest struct {
+	ID        uint
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type TransitionAttendancePeriodRequest struct {
+	ID        uint
+	Status    string
+	ChangedBy *uint // nil when the system moved it
+	Note      string
+}
+
+// AttendancePeriodTransition records one status change of a period.
+type AttendancePeriodTransition struct {
+	ID                 uint
+	AttendancePeriodID uint
+	FromStatus         string
+	ToStatus           string
+	ChangedBy          *uint
+	Note               string
+	CreatedAt          time.Time
+}
+
+type GetAttendancePeriodTransitionFilter struct {
+	AttendancePeriodID uint
+}
+
+type AttendanceCorrectionType string
+
+const (
+	CorrectionMissingCheckIn  AttendanceCorrectionType = "missing_check_in"
+	CorrectionMissingCheckOut AttendanceCorrectionType = "missing_check_out"
+	CorrectionWrongTime       AttendanceCorrectionType = "wrong_time"
+)
+
+const (
+	CorrectionStatusPending  = "pending"
+	CorrectionStatusApproved = "approved"
+	CorrectionStatusRejected = "rejected"
+)
+
+type AttendanceCorrection struct {
+	ID                  uint
+	UserID              uint
+	AttendanceID        *uint // nil until approval when the day has no attendance yet
+	Date                time.Time
+	Type                AttendanceCorrectionType
+	RequestedCheckInAt  *time.Time
+	RequestedCheckOutAt *time.Time
+	OriginalCheckInAt   *time.Time // values before approval, kept for audit
+	OriginalCheckOutAt  *time.Time
+	Reason              string
+	Status              string // pending, approved, rejected
+	ReviewedBy          *uint
+	ReviewedAt          *time.Time
+	ReviewNote          string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type SubmitAttendanceCorrection struct {
+	UserID     uint
+	Date       time.Time
+	Type       AttendanceCorrectionType
+	CheckInAt  *time.Time
+	CheckOutAt *time.Time
+	Reason     string
+}
+
+type ReviewAttendanceCorrection struct {
+	ID         uint
+	ReviewerID uint
+	Approve    bool
+	Note       string
+}
+
+type GetAttendanceCorrectionFilter struct {
+	ID      uint
+	UserID  uint
+	UserIDs []uint // optional, limits results to these users
+	Status  string
+	Date    time.Time // optional
+}
+
+type UpdateAttendanceCorrection struct {
+	ID                 uint
+	Status             string
+	AttendanceID       *uint
+	OriginalCheckInAt  *time.Time
+	OriginalCheckOutAt *time.Time
+	ReviewedBy         uint
+	ReviewedAt         time.Time
+	ReviewNote         string
+}
@@ -0,0 +1,87 @@
+package entity
+
+import "time"
+
+// MobileDevice is an employee's phone registered to sign offline attendance
+// events.
+type MobileDevice struct {
+	ID        uint
+	UserID    uint
+	Name      string
+	Secret    string // HMAC key for signing sync events, only shown on registration
+	Active    bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type RegisterDeviceRequest struct {
+	UserID uint
+	Name   string
+}
+
+type UpdateDevice struct {
+	ID     uint
+	UserID uint // the device must belong to this user
+	Active *bool
+}
+
+type GetDeviceFilter struct {
+	ID     uint
+	UserID uint
+	Active *bool
+}
+
+type SyncEventType string
+
+const (
+	SyncCheckIn  SyncEventType = "check_in"
+	SyncCheckOut SyncEventType = "check_out"
+)
+
+type SyncEventStatus string
+
+const (
+	SyncApplied   SyncEventStatus = "applied"
+	SyncRejected  SyncEventStatus = "rejected"
+	SyncDuplicate SyncEventStatus = "duplicate" // already applied by an earlier sync
+)
+
+// OfflineEvent is a check-in or check-out recorded by the mobile app while
+// it had no connection.
+type OfflineEvent struct {
+	ClientID   string // generated by the app, unique per device
+	Type       SyncEventType
+	RecordedAt time.Time // device clock
+	Location   *GeoPoint
+	Signature  string
+}
+
+type SyncAttendanceRequest struct {
+	UserID     uint
+	DeviceID   uint
+	DeviceTime time.Time // device clock when the batch was sent
+	Events     []OfflineEvent
+}
+
+// SyncEvent records an applied offline event so it is not applied twice.
+// Rejected events are not kept, the app may fix and resend them.
+type SyncEvent struct {
+	ID         uint
+	UserID     uint
+	DeviceID   uint
+	ClientID   string
+	Type       SyncEventType
+	RecordedAt time.Time
+	CreatedAt  time.Time
+}
+
+type GetSyncEventFilter struct {
+	DeviceID uint
+	ClientID string
+}
+
+type SyncEventResult struct {
+	ClientID string
+	Status   SyncEventStatus
+	Message  string // why the event was rejected
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Holiday is a company-wide day off.
+type Holiday struct {
+	ID        uint
+	Date      time.Time
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type CreateHolidayRequest struct {
+	Date time.Time
+	Name string
+}
+
+type GetHolidayFilter struct {
+	StartDate *time.Time // optional, inclusive
+	EndDate   *time.Time // optional, inclusive
+}
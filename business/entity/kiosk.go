@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+type Kiosk struct {
+	ID               uint
+	Name             string
+	OfficeLocationID uint
+	Secret           string // HMAC key for the kiosk's QR tokens, only shown on registration
+	Active           bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type RegisterKioskRequest struct {
+	Name             string
+	OfficeLocationID uint
+}
+
+type UpdateKiosk struct {
+	ID     uint
+	Name   *string
+	Active *bool
+}
+
+type GetKioskFilter struct {
+	ID     uint
+	Active *bool
+}
+
+type IssueKioskTokenRequest struct {
+	KioskID uint
+	Secret  string
+}
+
+type KioskToken struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// KioskScan records a QR token redeemed by an employee, so the same token
+// cannot be replayed by that employee.
+type KioskScan struct {
+	ID        uint
+	KioskID   uint
+	UserID    uint
+	Nonce     string
+	ScannedAt time.Time
+}
+
+type GetKioskScanFilter struct {
+	KioskID uint
+	UserID  uint
+	Nonce   string
+}
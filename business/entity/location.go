@@ -0,0 +1,50 @@
+package entity
+
+import "time"
+
+type GeofenceMode string
+
+const (
+	GeofenceOff     GeofenceMode = "off"     // coordinates are stored but not checked
+	GeofenceFlag    GeofenceMode = "flag"    // punches outside every office are accepted and flagged
+	GeofenceEnforce GeofenceMode = "enforce" // punches outside every office are rejected
+)
+
+type OfficeLocation struct {
+	ID           uint
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Active       bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type CreateOfficeLocationRequest struct {
+	Name         string
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+}
+
+type UpdateOfficeLocation struct {
+	ID           uint
+	Name         *string
+	Latitude     *float64
+	Longitude    *float64
+	RadiusMeters *float64
+	Active       *bool
+}
+
+type GetOfficeLocationFilter struct {
+	ID     uint
+	Active *bool
+}
+
+// GeoPoint is a position reported by the employee's device.
+type GeoPoint struct {
+	Latitude       float64
+	Longitude      float64
+	AccuracyMeters float64
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotifyAttendanceAutoClosed NotificationType = "attendance_auto_closed"
+)
+
+// Notification is an in-app message for an employee.
+type Notification struct {
+	ID        uint
+	UserID    uint
+	Type      NotificationType
+	Message   string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+type GetNotificationFilter struct {
+	UserID uint
+	Unread bool
+}
+
+type MarkNotificationRead struct {
+	ID     uint
+	UserID uint // the notification must belong to this user
+}
@@ -0,0 +1,59 @@
+package entity
+
+import "time"
+
+// MaxReportingDepth bounds walks up the reporting line so bad data can
+// never loop forever.
+const MaxReportingDepth = 20
+
+type Department struct {
+	ID        uint
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type UpdateDepartment struct {
+	ID   uint
+	Name *string
+}
+
+type GetDepartmentFilter struct {
+	ID   uint
+	Name string
+}
+
+type Position struct {
+	ID           uint
+	Name         string
+	DepartmentID uint
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type UpdatePosition struct {
+	ID           uint
+	Name         *string
+	DepartmentID *uint
+}
+
+type GetPositionFilter struct {
+	ID           uint
+	Name         string
+	DepartmentID uint
+}
+
+// AssignEmployeeRequest places an employee in the organisation. Nil fields
+// are left unchanged and zero clears them.
+type AssignEmployeeRequest struct {
+	UserID       uint
+	DepartmentID *uint
+	PositionID   *uint
+	ManagerID    *uint
+}
+
+// OrgChartNode is an active employee with the people reporting to them.
+type OrgChartNode struct {
+	User    User
+	Reports []OrgChartNode
+}
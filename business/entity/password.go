@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PasswordPolicy is what a new password has to contain. The zero value
+// accepts anything.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// Validate lists every rule the password breaks so it can be fixed in one
+// go.
+func (p PasswordPolicy) Validate(password string) error {
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	var missing []string
+	if n := len([]rune(password)); n < p.MinLength {
+		missing = append(missing, fmt.Sprintf("at least %d characters", p.MinLength))
+	}
+	if p.RequireUpper && !upper {
+		missing = append(missing, "an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		missing = append(missing, "a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		missing = append(missing, "a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		missing = append(missing, "a symbol")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("password must contain %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// PasswordResetPolicy sets how long a reset link works and where it
+// points. The token is appended to URL as a query parameter. At most
+// RequestLimit links are asked for per username or email address, and
+// IPRequestLimit per IP address, within RequestWindow; zero is no limit.
+type PasswordResetPolicy struct {
+	TokenTTL       time.Duration
+	URL            string
+	RequestLimit   int
+	IPRequestLimit int
+	RequestWindow  time.Duration
+}
+
+// PasswordResetToken is a single-use password reset link. Only the hash of
+// the token is stored.
+type PasswordResetToken struct {
+	ID        uint
+	UserID    uint
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type ChangePasswordRequest struct {
+	UserID          uint
+	SessionID       uint // stays logged in, the user's other sessions end
+	CurrentPassword string
+	NewPassword     string
+}
+
+type ForgotPasswordRequest struct {
+	Login     string // username or email address
+	IPAddress string
+}
+
+type ResetPasswordRequest struct {
+	Token       string
+	NewPassword string
+}
+
+// Email is a plain text message to one recipient.
+type Email struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// SMTPConfig is the mail server emails are sent through. Without a Host
+// they are only logged.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
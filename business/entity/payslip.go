@@ -2,6 +2,11 @@ package entity
 
 import "time"
 
+const (
+	PayslipStatusIssued = "issued"
+	PayslipStatusVoid   = "void" // replaced by a later payroll run
+)
+
 type Payslip struct {
 	ID                 uint
 	UserID             uint
@@ -13,18 +18,88 @@ type Payslip struct {
 	OvertimeHours      float64
 	OvertimePay        float64
 	ReimbursementTotal float64
+	LateMinutes        int
+	EarlyLeaveMinutes  int
+	LatenessDeduction  float64
 	TotalPay           float64
+	Status             string
 	CreatedAt          time.Time
 }
 
+// LatenessPolicy controls how late arrival and early departure reduce pay.
+// Minutes on a day at or under GraceMinutes are not charged; above it the
+// whole amount is charged at the employee's per-minute rate, which is the
+// daily rate spread over DailyMinutes.
+type LatenessPolicy struct {
+	Enabled           bool
+	GraceMinutes      int
+	IncludeEarlyLeave bool
+	DailyMinutes      int
+}
+
+type PayrollFrequency string
+
+const (
+	PayrollMonthly     PayrollFrequency = "monthly"
+	PayrollSemiMonthly PayrollFrequency = "semi_monthly"
+	PayrollBiweekly    PayrollFrequency = "biweekly"
+)
+
+// PayrollCalendar decides the dates of generated attendance periods. An
+// empty Frequency turns generation off.
+type PayrollCalendar struct {
+	Frequency    PayrollFrequency
+	CutoffDay    int       // monthly only, the last day of each period (1-28), zero for month end
+	Anchor       time.Time // biweekly only, a day some period starts on
+	PeriodsAhead int       // upcoming periods to create besides the current one
+}
+
+// PeriodContaining returns the first and last day of the calendar period t
+// falls in, both at midnight in t's location.
+func (c PayrollCalendar) PeriodContaining(t time.Time) (time.Time, time.Time) {
+	loc := t.Location()
+	year, month, day := t.Date()
+
+	switch c.Frequency {
+	case PayrollSemiMonthly:
+		if day <= 15 {
+			return time.Date(year, month, 1, 0, 0, 0, 0, loc), time.Date(year, month, 15, 0, 0, 0, 0, loc)
+		}
+		return time.Date(year, month, 16, 0, 0, 0, 0, loc), time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+
+	case PayrollBiweekly:
+		// Whole days between the anchor and t, counted on the calendar so DST cannot skew them
+		ay, am, ad := c.Anchor.Date()
+		days := int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+		offset := days % 14
+		if offset < 0 {
+			offset += 14
+		}
+		start := time.Date(year, month, day-offset, 0, 0, 0, 0, loc)
+		return start, start.AddDate(0, 0, 13)
+
+	default:
+		if c.CutoffDay < 1 || c.CutoffDay > 28 {
+			return time.Date(year, month, 1, 0, 0, 0, 0, loc), time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+		}
+		if day <= c.CutoffDay {
+			return time.Date(year, month-1, c.CutoffDay+1, 0, 0, 0, 0, loc), time.Date(year, month, c.CutoffDay, 0, 0, 0, 0, loc)
+		}
+		return time.Date(year, month, c.CutoffDay+1, 0, 0, 0, 0, loc), time.Date(year, month+1, c.CutoffDay, 0, 0, 0, 0, loc)
+	}
+}
+
 type CreatePayrollData struct {
 	AttendancePeriodID uint
 }
 
 type GetPayslipRequest struct {
 	UserID             *uint
+	UserIDs            []uint // optional, limits results to these users
 	AttendancePeriodID *uint
 	Status             *string
+	SortBy             string // attendance_period_id, total_pay or created_at
+	SortDesc           bool
 	Limit              int
 	Page               int
 }
@@ -68,3 +143,45 @@ type CreatePayslipForUserData struct {
 	PeriodID uint
 	JobID    uint
 }
+
+type GetPayrollVarianceRequest struct {
+	PreviousPeriodID uint
+	CurrentPeriodID  uint
+	ThresholdPercent float64 // flag components whose change exceeds this percentage
+}
+
+type PayslipComponentItem struct {
+	UserID             uint
+	Username           string
+	AttendancePeriodID uint
+	BaseSalary         float64
+	AttendanceAmount   float64
+	OvertimePay        float64
+	ReimbursementTotal float64
+	LatenessDeduction  float64
+	TotalPay           float64
+}
+
+type PayrollVarianceComponent struct {
+	Component     string   `json:"component"`
+	Previous      float64  `json:"previous"`
+	Current       float64  `json:"current"`
+	Delta         float64  `json:"delta"`
+	DeltaPercent  *float64 `json:"delta_percent"` // nil when previous is zero
+	OverThreshold bool     `json:"over_threshold"`
+}
+
+type PayrollVarianceItem struct {
+	UserID        uint                       `json:"user_id"`
+	Username      string                     `json:"username"`
+	Components    []PayrollVarianceComponent `json:"components"`
+	OverThreshold bool                       `json:"over_threshold"`
+}
+
+type GetPayrollVarianceResponse struct {
+	PreviousPeriodID uint
+	CurrentPeriodID  uint
+	ThresholdPercent float64
+	Items            []PayrollVarianceItem
+	FlaggedCount     int
+}
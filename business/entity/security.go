@@ -0,0 +1,75 @@
+package entity
+
+import "time"
+
+// LoginThrottlePolicy limits password guessing. Failed logins are counted
+// per username and per IP address for Window. From DelayAfter failures on,
+// each attempt on the username waits twice as long as the last, up to
+// MaxDelay. Reaching LockAfter, or IPLockAfter for an address, locks it
+// out for LockDuration.
+type LoginThrottlePolicy struct {
+	Window       time.Duration
+	DelayAfter   int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	LockAfter    int
+	IPLockAfter  int // higher than LockAfter since offices share an address
+	LockDuration time.Duration
+}
+
+// Delay is how long to hold back a login attempt after failures.
+func (p LoginThrottlePolicy) Delay(failures int) time.Duration {
+	if p.DelayAfter <= 0 || failures < p.DelayAfter {
+		return 0
+	}
+
+	delay := p.BaseDelay
+	for i := p.DelayAfter; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+
+	return delay
+}
+
+type SecurityEventType string
+
+const (
+	SecurityEventLoginFailed     SecurityEventType = "login_failed"
+	SecurityEventLoginBlocked    SecurityEventType = "login_blocked" // attempt while locked out
+	SecurityEventTwoFactorFailed SecurityEventType = "two_factor_failed"
+	SecurityEventAccountLocked   SecurityEventType = "account_locked"
+	SecurityEventIPLocked        SecurityEventType = "ip_locked"
+	SecurityEventAccountUnlocked SecurityEventType = "account_unlocked"
+	SecurityEventIPUnlocked      SecurityEventType = "ip_unlocked"
+	SecurityEventSSOFailed       SecurityEventType = "sso_failed" // provider account matches no user
+)
+
+// SecurityEvent is an entry in the authentication audit trail. UserID is
+// only known once the username matched an account; ActorID is the admin
+// behind manual actions such as unlocking.
+type SecurityEvent struct {
+	ID        uint
+	Type      SecurityEventType
+	UserID    *uint
+	Username  string
+	IPAddress string
+	UserAgent string
+	ActorID   *uint
+	Detail    string
+	CreatedAt time.Time
+}
+
+type GetSecurityEventFilter struct {
+	Type      SecurityEventType
+	UserID    uint
+	Username  string
+	IPAddress string
+	From      *time.Time
+	To        *time.Time
+	Page      int
+	Limit     int
+}
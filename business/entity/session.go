@@ -0,0 +1,95 @@
+package entity
+
+import "time"
+
+// TokenPolicy sets how long access and refresh tokens live. Access tokens
+// are short-lived; the refresh token bounds the whole session.
+type TokenPolicy struct {
+	AccessTTL  time.Duration
+	RefreshTTL time.Duration
+}
+
+// Session is one login on one device. Only hashes of refresh tokens are
+// stored; the previous hash is kept to spot a rotated token being reused.
+type Session struct {
+	ID                uint
+	UserID            uint
+	RefreshTokenHash  string
+	PreviousTokenHash string
+	UserAgent         string
+	IPAddress         string
+	ExpiresAt         time.Time
+	LastUsedAt        time.Time
+	RevokedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+type GetSessionFilter struct {
+	ID                uint
+	UserID            uint
+	RefreshTokenHash  string
+	PreviousTokenHash string
+	ActiveOnly        bool // not revoked and not expired
+}
+
+type UpdateSession struct {
+	ID uint
+	// CurrentTokenHash, when set, only updates the session while this is
+	// still its refresh token, so two refreshes cannot both rotate it.
+	CurrentTokenHash  *string
+	RefreshTokenHash  *string
+	PreviousTokenHash *string
+	LastUsedAt        *time.Time
+	RevokedAt         *time.Time
+}
+
+type RefreshSessionRequest struct {
+	RefreshToken string
+	UserAgent    string
+	IPAddress    string
+}
+
+// AuthTokens is what a login or refresh hands back to the client.
+type AuthTokens struct {
+	AccessToken          string
+	AccessTokenExpiresAt time.Time
+	RefreshToken         string
+	SessionID            uint
+
+	// MustChangePassword means the access token only works for changing
+	// the password.
+	MustChangePassword bool
+
+	// RecoveryCodes is only set when the login also completed two-factor
+	// enrolment; it is the one time they are shown.
+	RecoveryCodes []string
+}
+
+// KeyRotationPolicy controls the keys access tokens are signed with. A new
+// key is created every Interval and published PublishAhead before it starts
+// signing, so every instance can verify its tokens by then; older keys are
+// retired once the newest has been signing for RetireAfter, by when their
+// tokens have expired.
+type KeyRotationPolicy struct {
+	Algorithm    string // RS256 or EdDSA
+	Interval     time.Duration
+	PublishAhead time.Duration
+	RetireAfter  time.Duration
+}
+
+// SigningKey is an access token signing key pair stored as PEM. Only the
+// public half is ever published.
+type SigningKey struct {
+	ID          uint
+	KeyID       string // kid
+	Algorithm   string
+	PrivateKey  string
+	PublicKey   string
+	CreatedAt   time.Time
+	ActivatesAt time.Time // signs tokens from then on, published before
+	RetiredAt   *time.Time
+}
+
+type GetSigningKeyFilter struct {
+	ActiveOnly bool // not retired
+}
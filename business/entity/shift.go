@@ -0,0 +1,96 @@
+package entity
+
+import "time"
+
+const ShiftTimeLayout = "15:04"
+
+type Shift struct {
+	ID              uint
+	Name            string
+	StartTime       string // "HH:MM" local time
+	EndTime         string // "HH:MM", earlier than StartTime when crossing midnight
+	BreakMinutes    int    // scheduled unpaid break, deducted even when not punched
+	CrossesMidnight bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Window returns the scheduled start and end of the shift when rostered on date.
+func (s Shift) Window(date time.Time) (time.Time, time.Time, error) {
+	start, err := time.Parse(ShiftTimeLayout, s.StartTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	end, err := time.Parse(ShiftTimeLayout, s.EndTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	startAt := day.Add(time.Duration(start.Hour())*time.Hour + time.Duration(start.Minute())*time.Minute)
+	endAt := day.Add(time.Duration(end.Hour())*time.Hour + time.Duration(end.Minute())*time.Minute)
+
+	if s.CrossesMidnight {
+		endAt = endAt.AddDate(0, 0, 1)
+	}
+
+	return startAt, endAt, nil
+}
+
+type CreateShiftRequest struct {
+	Name         string
+	StartTime    string
+	EndTime      string
+	BreakMinutes int
+}
+
+type UpdateShift struct {
+	ID              uint
+	Name            *string
+	StartTime       *string
+	EndTime         *string
+	BreakMinutes    *int
+	CrossesMidnight *bool
+}
+
+type GetShiftFilter struct {
+	ID  uint
+	IDs []uint
+}
+
+type ShiftRoster struct {
+	ID        uint
+	UserID    uint
+	ShiftID   uint
+	Shift     Shift
+	Date      time.Time // the day the shift starts on
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type GetShiftRosterFilter struct {
+	UserID    uint
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type DeleteShiftRosterFilter struct {
+	UserID    uint
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+type AssignWeeklyRosterRequest struct {
+	UserID    uint
+	WeekStart time.Time // must be a Monday
+	ShiftIDs  [7]*uint  // Monday to Sunday, nil is a day off
+}
+
+// ShiftAssignment is a roster entry resolved against a point in time.
+type ShiftAssignment struct {
+	Date    time.Time
+	Shift   Shift
+	StartAt time.Time
+	EndAt   time.Time
+}
@@ -0,0 +1,75 @@
+package entity
+
+import "time"
+
+// OIDCConfig is the OpenID Connect identity provider employees can sign
+// in with instead of a password. Single sign-on is off without an
+// IssuerURL.
+type OIDCConfig struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string // our callback, as registered with the provider
+	Scopes       []string
+
+	// RequireVerifiedEmail only matches provider accounts to users by
+	// email when the provider says the address is verified
+	RequireVerifiedEmail bool
+
+	StateTTL time.Duration // how long the provider's sign-in page may take
+}
+
+func (c OIDCConfig) Enabled() bool {
+	return c.IssuerURL != ""
+}
+
+// SSOState is a single sign-on login waiting for the provider to send the
+// browser back. The browser holds the state; only its hash is stored, with
+// the PKCE verifier and the nonce the ID token has to carry.
+type SSOState struct {
+	ID           uint
+	StateHash    string
+	BindingHash  string // hash of the cookie set on the browser that started it
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
+
+// OIDCClaims are the claims of a verified ID token.
+type OIDCClaims struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Nonce         string
+}
+
+// UserIdentity links an account at the identity provider to a user. It
+// is created on the first single sign-on, by matching the email address.
+type UserIdentity struct {
+	ID        uint
+	UserID    uint
+	Issuer    string
+	Subject   string
+	Email     string // as given by the provider when linked
+	CreatedAt time.Time
+}
+
+// SSOStart is where to send the browser to sign in, and the binding to
+// keep in a cookie so only that browser can finish the sign-in.
+type SSOStart struct {
+	AuthURL   string
+	Binding   string
+	ExpiresAt time.Time
+}
+
+type SSOCallbackRequest struct {
+	Code      string
+	State     string
+	Binding   string // from the cookie set by StartSSO
+	UserAgent string
+	IPAddress string
+}
@@ -0,0 +1,97 @@
+package entity
+
+import "time"
+
+type TimeClockPunchStatus string
+
+const (
+	PunchApplied  TimeClockPunchStatus = "applied"  // folded into attendances
+	PunchUnmapped TimeClockPunchStatus = "unmapped" // employee code has no user
+	PunchUnpaired TimeClockPunchStatus = "unpaired" // could not be matched to a check-in or check-out
+)
+
+// TimeClock is a biometric or badge device that pushes raw punch logs.
+type TimeClock struct {
+	ID               uint
+	Name             string
+	OfficeLocationID *uint
+	Secret           string // shared secret the device authenticates with, only shown on registration
+	Active           bool
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type RegisterTimeClockRequest struct {
+	Name             string
+	OfficeLocationID *uint
+}
+
+type GetTimeClockFilter struct {
+	ID     uint
+	Active *bool
+}
+
+// TimeClockEmployee maps the code an employee is enrolled under on the
+// devices to their user. Codes are shared by all devices.
+type TimeClockEmployee struct {
+	ID           uint
+	EmployeeCode string
+	UserID       uint
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type MapTimeClockEmployeeRequest struct {
+	EmployeeCode string
+	UserID       uint
+}
+
+type GetTimeClockEmployeeFilter struct {
+	EmployeeCode string
+}
+
+type UpdateTimeClockEmployee struct {
+	ID     uint
+	UserID uint
+}
+
+type RawPunch struct {
+	EmployeeCode string
+	PunchedAt    time.Time
+}
+
+type IngestPunchesRequest struct {
+	TimeClockID uint
+	Secret      string
+	Punches     []RawPunch
+}
+
+type PunchIngestResult struct {
+	Received   int
+	Applied    int
+	Duplicates int
+	Unmapped   int
+	Unpaired   int
+}
+
+// TimeClockPunch is a raw punch as received, kept for deduplication and
+// reconciliation.
+type TimeClockPunch struct {
+	ID           uint
+	TimeClockID  uint
+	EmployeeCode string
+	UserID       *uint
+	PunchedAt    time.Time
+	Status       TimeClockPunchStatus
+	Note         string
+	CreatedAt    time.Time
+}
+
+type GetTimeClockPunchFilter struct {
+	TimeClockID  uint
+	EmployeeCode string
+	PunchedAt    *time.Time
+	Statuses     []TimeClockPunchStatus
+	StartDate    *time.Time
+	EndDate      *time.Time // exclusive
+}
@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+const (
+	TravelStatusPending  = "pending"
+	TravelStatusApproved = "approved"
+	TravelStatusRejected = "rejected"
+)
+
+type TravelRequest struct {
+	ID          uint
+	UserID      uint
+	Destination string
+	Purpose     string
+	StartDate   time.Time
+	EndDate     time.Time // inclusive
+	Status      string    // pending, approved, rejected
+	ReviewedBy  *uint
+	ReviewedAt  *time.Time
+	ReviewNote  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type SubmitTravelRequest struct {
+	UserID      uint
+	Destination string
+	Purpose     string
+	StartDate   time.Time
+	EndDate     time.Time
+}
+
+type ReviewTravelRequest struct {
+	ID         uint
+	ReviewerID uint
+	Approve    bool
+	Note       string
+}
+
+type UpdateTravelRequest struct {
+	ID         uint
+	Status     string
+	ReviewedBy uint
+	ReviewedAt time.Time
+	ReviewNote string
+}
+
+type GetTravelRequestFilter struct {
+	ID           uint
+	UserID       uint
+	UserIDs      []uint // optional, limits results to these users
+	Status       string
+	ContainsDate *time.Time // optional, requests whose trip covers this day
+}
@@ -0,0 +1,111 @@
+package entity
+
+import "time"
+
+// TwoFactorPolicy decides who has to use a second factor and how long a
+// password login may wait for it.
+type TwoFactorPolicy struct {
+	Issuer        string // name shown in authenticator apps
+	RequiredRoles []UserRole
+	// RequiredPermissions makes every role granting one of them use a
+	// second factor, so the requirement follows role edits.
+	RequiredPermissions []Permission
+	ChallengeTTL        time.Duration
+}
+
+// Requires reports whether a role granting the given permissions has to use
+// a second factor.
+func (p TwoFactorPolicy) Requires(role UserRole, granted []Permission) bool {
+	for _, r := range p.RequiredRoles {
+		if r == role {
+			return true
+		}
+	}
+
+	for _, g := range granted {
+		for _, r := range p.RequiredPermissions {
+			if g == r {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// TwoFactor is a user's TOTP enrolment. It stays pending, and is not asked
+// for at login, until the first code from the authenticator is verified.
+type TwoFactor struct {
+	ID           uint
+	UserID       uint
+	Secret       string
+	LastUsedStep int64 // codes from this step or earlier are rejected
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// RecoveryCode is a single-use code for when the authenticator is lost.
+// Only its hash is stored.
+type RecoveryCode struct {
+	ID        uint
+	UserID    uint
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type GetRecoveryCodeFilter struct {
+	UserID     uint
+	CodeHash   string
+	UnusedOnly bool
+}
+
+// LoginChallenge is a password login waiting for its second factor. The
+// client holds the token; only its hash is stored.
+type LoginChallenge struct {
+	ID          uint
+	UserID      uint
+	TokenHash   string
+	UserAgent   string
+	IPAddress   string
+	Attempts    int
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type UpdateLoginChallenge struct {
+	ID          uint
+	Attempts    *int
+	CompletedAt *time.Time
+}
+
+// LoginResult holds tokens when the password was enough, or a challenge
+// when a second factor has to be verified first.
+type LoginResult struct {
+	Tokens    *AuthTokens
+	Challenge *TwoFactorChallenge
+}
+
+type TwoFactorChallenge struct {
+	Token              string
+	ExpiresAt          time.Time
+	EnrollmentRequired bool // the user must set up an authenticator first
+}
+
+type VerifyLoginRequest struct {
+	ChallengeToken string
+	Code           string // TOTP or recovery code
+}
+
+type TwoFactorEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+type TwoFactorStatus struct {
+	Enabled           bool
+	Pending           bool
+	Required          bool
+	RecoveryCodesLeft int
+}
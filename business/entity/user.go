@@ -5,17 +5,33 @@ import "time"
 type UserRole string
 
 const (
-	RoleAdmin    UserRole = "admin"
-	RoleEmployee UserRole = "employee"
+	RoleAdmin        UserRole = "admin"
+	RoleEmployee     UserRole = "employee"
+	RoleManager      UserRole = "manager"
+	RoleHR           UserRole = "hr"
+	RolePayrollAdmin UserRole = "payroll_admin"
+	RoleAuditor      UserRole = "auditor"
 )
 
 type User struct {
-	ID        uint
-	Username  string
-	Password  string
-	FullName  string
-	Role      UserRole
-	Salary    float64
+	ID       uint
+	Username string
+	Password string
+	FullName string
+	Email    string
+	Role     UserRole
+	Salary   float64
+	IsActive bool
+
+	DepartmentID *uint
+	PositionID   *uint
+	ManagerID    *uint // who the user reports to
+
+	// MustChangePassword is set on accounts created with a password someone
+	// else chose; the user has to pick their own before doing anything else.
+	MustChangePassword bool
+	PasswordChangedAt  *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -24,17 +40,128 @@ type RegisterRequest struct {
 	Username string
 	Password string
 	FullName string
+	Email    string
 	Role     string // "admin" or "employee"
 	Salary   float64
+
+	MustChangePassword bool
 }
 
 type LoginRequest struct {
-	Username string
-	Password string
+	Username  string
+	Password  string
+	UserAgent string
+	IPAddress string
 }
 
 type GetUserFilter struct {
-	ID    uint
-	Role  string
-	Email string
+	ID        uint
+	Username  string
+	Role      string
+	Email     string
+	IsActive  *bool
+	ManagerID uint
+}
+
+// ListUserFilter searches employees for the admin directory. Search matches
+// username, full name or email.
+type ListUserFilter struct {
+	Search   string
+	Role     string
+	IsActive *bool
+	UserIDs  []uint // optional, limits results to these users
+	Page     int
+	Limit    int
+}
+
+// UpdateUser changes the non-nil fields of a user.
+type UpdateUser struct {
+	ID       uint
+	FullName *string
+	Email    *string
+	Role     *UserRole
+	Salary   *float64
+	IsActive *bool
+
+	MustChangePassword *bool
+
+	// Zero clears the assignment
+	DepartmentID *uint
+	PositionID   *uint
+	ManagerID    *uint
+}
+
+type UpdateEmployeeRequest struct {
+	ID       uint
+	ActorID  uint // who is making the change
+	FullName *string
+	Email    *string
+	Role     *UserRole
+	Salary   *float64
+}
+
+type EmploymentType string
+
+const (
+	EmploymentPermanent EmploymentType = "permanent"
+	EmploymentContract  EmploymentType = "contract"
+	EmploymentIntern    EmploymentType = "intern"
+)
+
+// EmployeeProfile is the HR master data kept next to a user account. PTKP
+// status is the Indonesian non-taxable income bracket (TK/0 to K/I/3) used
+// for PPh 21.
+type EmployeeProfile struct {
+	ID                       uint
+	UserID                   uint
+	EmployeeNumber           string
+	Phone                    string
+	HireDate                 *time.Time
+	EmploymentType           EmploymentType
+	JobTitle                 string
+	NIK                      string
+	NPWP                     string
+	PTKPStatus               string
+	BankName                 string
+	BankAccountNumber        string
+	BankAccountName          string
+	EmergencyContactName     string
+	EmergencyContactPhone    string
+	EmergencyContactRelation string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+type GetEmployeeProfileFilter struct {
+	UserID         uint
+	EmployeeNumber string
+}
+
+// UpdateEmployeeProfile changes the non-nil fields of a user's profile.
+type UpdateEmployeeProfile struct {
+	UserID                   uint
+	EmployeeNumber           *string
+	Phone                    *string
+	HireDate                 *time.Time
+	EmploymentType           *EmploymentType
+	JobTitle                 *string
+	NIK                      *string
+	NPWP                     *string
+	PTKPStatus               *string
+	BankName                 *string
+	BankAccountNumber        *string
+	BankAccountName          *string
+	EmergencyContactName     *string
+	EmergencyContactPhone    *string
+	EmergencyContactRelation *string
+}
+
+// UpdateOwnProfileRequest is the part of the profile employees may change
+// themselves. Tax, bank and employment data stay with HR.
+type UpdateOwnProfileRequest struct {
+	UserID                   uint
+	Phone                    *string
+	EmergencyContactName     *string
+	EmergencyContactPhone    *string
+	EmergencyContactRelation *string
 }
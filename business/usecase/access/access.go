@@ -0,0 +1,54 @@
+package access
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	accessDom "github.com/zuhrulumam/go-hris/business/domain/access"
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+// permissionCacheTTL is how long role permissions are reused before being
+// read again, so edits made by another API instance apply within a minute.
+const permissionCacheTTL = time.Minute
+
+type UsecaseItf interface {
+	HasPermission(ctx context.Context, role string, permission string) (bool, error)
+	Scope(ctx context.Context, userID uint, role string, all, team entity.Permission) (entity.AccessScope, error)
+	// CanManageRole reports whether a user with role may change the account
+	// of a user with target, so nobody takes over an account that holds
+	// permissions they lack.
+	CanManageRole(ctx context.Context, role string, target entity.UserRole) (bool, error)
+
+	GetRoles(ctx context.Context) ([]entity.RoleAccess, error)
+	SetRolePermissions(ctx context.Context, req entity.SetRolePermissionsRequest) error
+}
+
+type Option struct {
+	AccessDom      accessDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+type access struct {
+	AccessDom      accessDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+
+	mu          sync.Mutex
+	permissions map[entity.UserRole]map[entity.Permission]bool
+	loadedAt    time.Time
+}
+
+func InitAccessUsecase(opt Option) UsecaseItf {
+	a := &access{
+		AccessDom:      opt.AccessDom,
+		UserDom:        opt.UserDom,
+		TransactionDom: opt.TransactionDom,
+	}
+
+	return a
+}
@@ -0,0 +1,221 @@
+package access
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// HasPermission reports whether role grants permission. Admins hold every
+// permission.
+func (a *access) HasPermission(ctx context.Context, role string, permission string) (bool, error) {
+	if entity.UserRole(role) == entity.RoleAdmin {
+		return true, nil
+	}
+
+	permissions, err := a.rolePermissions(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return permissions[entity.UserRole(role)][entity.Permission(permission)], nil
+}
+
+// Scope works out whose data a user may see: everyone with the all
+// permission, themselves and everyone below them in the reporting line
+// with the team permission, and otherwise only themselves.
+func (a *access) Scope(ctx context.Context, userID uint, role string, all, team entity.Permission) (entity.AccessScope, error) {
+	canSeeAll, err := a.HasPermission(ctx, role, string(all))
+	if err != nil {
+		return entity.AccessScope{}, err
+	}
+
+	if canSeeAll {
+		return entity.AccessScope{All: true}, nil
+	}
+
+	scope := entity.AccessScope{UserIDs: []uint{userID}}
+
+	canSeeTeam, err := a.HasPermission(ctx, role, string(team))
+	if err != nil {
+		return entity.AccessScope{}, err
+	}
+
+	if !canSeeTeam {
+		return scope, nil
+	}
+
+	seen := map[uint]bool{userID: true}
+	level := []uint{userID}
+	for depth := 0; len(level) > 0 && depth < entity.MaxReportingDepth; depth++ {
+		var next []uint
+		for _, managerID := range level {
+			reports, err := a.UserDom.GetUsers(ctx, entity.GetUserFilter{ManagerID: managerID})
+			if err != nil {
+				return entity.AccessScope{}, err
+			}
+
+			for _, r := range reports {
+				if seen[r.ID] {
+					continue
+				}
+				seen[r.ID] = true
+				scope.UserIDs = append(scope.UserIDs, r.ID)
+				next = append(next, r.ID)
+			}
+		}
+		level = next
+	}
+
+	return scope, nil
+}
+
+// CanManageRole lets admins change any account and nobody else change an
+// admin's. Otherwise role:manage holders may change any account, and other
+// users only those whose role grants nothing they lack.
+func (a *access) CanManageRole(ctx context.Context, role string, target entity.UserRole) (bool, error) {
+	if entity.UserRole(role) == entity.RoleAdmin {
+		return true, nil
+	}
+
+	if target == entity.RoleAdmin {
+		return false, nil
+	}
+
+	permissions, err := a.rolePermissions(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	held := permissions[entity.UserRole(role)]
+	if held[entity.PermRoleManage] {
+		return true, nil
+	}
+
+	for p := range permissions[target] {
+		if !held[p] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (a *access) GetRoles(ctx context.Context) ([]entity.RoleAccess, error) {
+	roles, err := a.AccessDom.GetRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	granted, err := a.AccessDom.GetRolePermissions(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	byRole := map[entity.UserRole][]entity.Permission{}
+	for _, g := range granted {
+		byRole[g.Role] = append(byRole[g.Role], g.Permission)
+	}
+
+	result := make([]entity.RoleAccess, 0, len(roles))
+	for _, r := range roles {
+		permissions := byRole[r.Name]
+		if r.Name == entity.RoleAdmin {
+			permissions = entity.AllPermissions
+		}
+
+		result = append(result, entity.RoleAccess{
+			Role:        r,
+			Permissions: permissions,
+		})
+	}
+
+	return result, nil
+}
+
+func (a *access) SetRolePermissions(ctx context.Context, req entity.SetRolePermissionsRequest) error {
+	if req.Role == entity.RoleAdmin {
+		return x.NewWithCode(http.StatusBadRequest, "admin always holds every permission")
+	}
+
+	known := make(map[entity.Permission]bool, len(entity.AllPermissions))
+	for _, p := range entity.AllPermissions {
+		known[p] = true
+	}
+
+	unique := make([]entity.Permission, 0, len(req.Permissions))
+	seen := map[entity.Permission]bool{}
+	for _, p := range req.Permissions {
+		if !known[p] {
+			return x.NewWithCode(http.StatusBadRequest, fmt.Sprintf("unknown permission %s", p))
+		}
+		if !seen[p] {
+			seen[p] = true
+			unique = append(unique, p)
+		}
+	}
+
+	err := a.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		roles, err := a.AccessDom.GetRoles(newCtx)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		for _, r := range roles {
+			if r.Name == req.Role {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return x.NewWithCode(http.StatusNotFound, "role not found")
+		}
+
+		return a.AccessDom.ReplaceRolePermissions(newCtx, req.Role, unique)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Drop the cache so this instance applies the change right away
+	a.mu.Lock()
+	a.permissions = nil
+	a.mu.Unlock()
+
+	return nil
+}
+
+// rolePermissions returns every role's permissions, reading them from the
+// database at most once per permissionCacheTTL.
+func (a *access) rolePermissions(ctx context.Context) (map[entity.UserRole]map[entity.Permission]bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.permissions != nil && time.Since(a.loadedAt) < permissionCacheTTL {
+		return a.permissions, nil
+	}
+
+	granted, err := a.AccessDom.GetRolePermissions(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := map[entity.UserRole]map[entity.Permission]bool{}
+	for _, g := range granted {
+		if permissions[g.Role] == nil {
+			permissions[g.Role] = map[entity.Permission]bool{}
+		}
+		permissions[g.Role][g.Permission] = true
+	}
+
+	a.permissions = permissions
+	a.loadedAt = time.Now()
+
+	return permissions, nil
+}
@@ -0,0 +1,236 @@
+package access_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/access"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/domain/access"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHasPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccessDom := mockAccess.NewMockDomainItf(ctrl)
+
+	// Permissions are read once and then served from the cache
+	mockAccessDom.EXPECT().GetRolePermissions(gomock.Any(), entity.UserRole("")).
+		Return([]entity.RolePermission{
+			{Role: entity.RoleManager, Permission: entity.PermEmployeeRead},
+			{Role: entity.RoleAuditor, Permission: entity.PermPayrollReadAll},
+		}, nil).Times(1)
+
+	usecase := uc.InitAccessUsecase(uc.Option{AccessDom: mockAccessDom})
+
+	tests := []struct {
+		role       string
+		permission entity.Permission
+		expected   bool
+	}{
+		{role: "admin", permission: entity.PermRoleManage, expected: true},
+		{role: "manager", permission: entity.PermEmployeeRead, expected: true},
+		{role: "manager", permission: entity.PermPayrollReadAll, expected: false},
+		{role: "auditor", permission: entity.PermPayrollReadAll, expected: true},
+		{role: "employee", permission: entity.PermEmployeeRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		allowed, err := usecase.HasPermission(context.Background(), tt.role, string(tt.permission))
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, allowed, "%s %s", tt.role, tt.permission)
+	}
+}
+
+func TestCanManageRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccessDom := mockAccess.NewMockDomainItf(ctrl)
+
+	mockAccessDom.EXPECT().GetRolePermissions(gomock.Any(), entity.UserRole("")).
+		Return([]entity.RolePermission{
+			{Role: entity.RoleEmployee, Permission: entity.PermEmployeeRead},
+			{Role: entity.RoleHR, Permission: entity.PermEmployeeRead},
+			{Role: entity.RoleHR, Permission: entity.PermEmployeeManage},
+			{Role: entity.RolePayrollAdmin, Permission: entity.PermEmployeeRead},
+			{Role: entity.RolePayrollAdmin, Permission: entity.PermPayrollRun},
+			{Role: entity.RoleAuditor, Permission: entity.PermRoleManage},
+		}, nil).Times(1)
+
+	usecase := uc.InitAccessUsecase(uc.Option{AccessDom: mockAccessDom})
+
+	tests := []struct {
+		role     entity.UserRole
+		target   entity.UserRole
+		expected bool
+	}{
+		{role: entity.RoleAdmin, target: entity.RoleAdmin, expected: true},
+		{role: entity.RoleHR, target: entity.RoleAdmin, expected: false},
+		{role: entity.RoleAuditor, target: entity.RoleAdmin, expected: false},
+		{role: entity.RoleHR, target: entity.RoleEmployee, expected: true},
+		{role: entity.RoleHR, target: entity.RoleManager, expected: true},
+		{role: entity.RoleHR, target: entity.RolePayrollAdmin, expected: false},
+		{role: entity.RoleAuditor, target: entity.RolePayrollAdmin, expected: true},
+	}
+
+	for _, tt := range tests {
+		allowed, err := usecase.CanManageRole(context.Background(), string(tt.role), tt.target)
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expected, allowed, "%s on %s", tt.role, tt.target)
+	}
+}
+
+func TestScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		setupMocks func(a *mockAccess.MockDomainItf, u *mockUser.MockDomainItf)
+		expected   entity.AccessScope
+	}{
+		{
+			name: "everyone",
+			role: "hr",
+			setupMocks: func(a *mockAccess.MockDomainItf, u *mockUser.MockDomainItf) {
+				a.EXPECT().GetRolePermissions(gomock.Any(), gomock.Any()).
+					Return([]entity.RolePermission{{Role: entity.RoleHR, Permission: entity.PermAttendanceReadAll}}, nil)
+			},
+			expected: entity.AccessScope{All: true},
+		},
+		{
+			name: "self and reporting line",
+			role: "manager",
+			setupMocks: func(a *mockAccess.MockDomainItf, u *mockUser.MockDomainItf) {
+				a.EXPECT().GetRolePermissions(gomock.Any(), gomock.Any()).
+					Return([]entity.RolePermission{{Role: entity.RoleManager, Permission: entity.PermAttendanceReadTeam}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ManagerID: 1}).
+					Return([]entity.User{{ID: 2}, {ID: 3}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ManagerID: 2}).
+					Return([]entity.User{{ID: 4}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ManagerID: 3}).
+					Return(nil, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ManagerID: 4}).
+					Return(nil, nil)
+			},
+			expected: entity.AccessScope{UserIDs: []uint{1, 2, 3, 4}},
+		},
+		{
+			name: "self only",
+			role: "employee",
+			setupMocks: func(a *mockAccess.MockDomainItf, u *mockUser.MockDomainItf) {
+				a.EXPECT().GetRolePermissions(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expected: entity.AccessScope{UserIDs: []uint{1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAccessDom := mockAccess.NewMockDomainItf(ctrl)
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockAccessDom, mockUserDom)
+
+			usecase := uc.InitAccessUsecase(uc.Option{
+				AccessDom: mockAccessDom,
+				UserDom:   mockUserDom,
+			})
+
+			scope, err := usecase.Scope(context.Background(), 1, tt.role, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, scope)
+		})
+	}
+}
+
+func TestSetRolePermissions(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.SetRolePermissionsRequest
+		setupMocks  func(a *mockAccess.MockDomainItf, tx *mockTx.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success drops duplicates",
+			input: entity.SetRolePermissionsRequest{
+				Role:        entity.RoleManager,
+				Permissions: []entity.Permission{entity.PermEmployeeRead, entity.PermTravelReview, entity.PermEmployeeRead},
+			},
+			setupMocks: func(a *mockAccess.MockDomainItf, tx *mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				a.EXPECT().GetRoles(gomock.Any()).Return([]entity.Role{{Name: entity.RoleManager}}, nil)
+				a.EXPECT().ReplaceRolePermissions(gomock.Any(), entity.RoleManager,
+					[]entity.Permission{entity.PermEmployeeRead, entity.PermTravelReview}).Return(nil)
+			},
+		},
+		{
+			name:        "admin is not editable",
+			input:       entity.SetRolePermissionsRequest{Role: entity.RoleAdmin},
+			setupMocks:  func(a *mockAccess.MockDomainItf, tx *mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "admin always holds every permission",
+		},
+		{
+			name: "unknown permission",
+			input: entity.SetRolePermissionsRequest{
+				Role:        entity.RoleManager,
+				Permissions: []entity.Permission{"payroll:delete"},
+			},
+			setupMocks:  func(a *mockAccess.MockDomainItf, tx *mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "unknown permission payroll:delete",
+		},
+		{
+			name:  "role not found",
+			input: entity.SetRolePermissionsRequest{Role: "intern"},
+			setupMocks: func(a *mockAccess.MockDomainItf, tx *mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				a.EXPECT().GetRoles(gomock.Any()).Return([]entity.Role{{Name: entity.RoleManager}}, nil)
+			},
+			expectErr:   true,
+			errorString: "role not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAccessDom := mockAccess.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockAccessDom, mockTxDom)
+
+			usecase := uc.InitAccessUsecase(uc.Option{
+				AccessDom:      mockAccessDom,
+				TransactionDom: mockTxDom,
+			})
+
+			err := usecase.SetRolePermissions(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
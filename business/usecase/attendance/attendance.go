@@ -2,34 +2,96 @@ package attendance
 
 import (
 	"context"
+	"time"
 
 	attendanceDom "github.com/zuhrulumam/go-hris/business/domain/attendance"
+	deviceDom "github.com/zuhrulumam/go-hris/business/domain/device"
+	holidayDom "github.com/zuhrulumam/go-hris/business/domain/holiday"
+	kioskDom "github.com/zuhrulumam/go-hris/business/domain/kiosk"
+	locationDom "github.com/zuhrulumam/go-hris/business/domain/location"
+	notificationDom "github.com/zuhrulumam/go-hris/business/domain/notification"
+	shiftDom "github.com/zuhrulumam/go-hris/business/domain/shift"
+	timeClockDom "github.com/zuhrulumam/go-hris/business/domain/timeclock"
 	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	travelDom "github.com/zuhrulumam/go-hris/business/domain/travel"
 	"github.com/zuhrulumam/go-hris/business/entity"
 )
 
 type UsecaseItf interface {
 	CheckIn(ctx context.Context, data entity.CheckIn) error
 	CheckOut(ctx context.Context, data entity.CheckOut) error
+	StartBreak(ctx context.Context, data entity.StartBreak) error
+	EndBreak(ctx context.Context, data entity.EndBreak) error
 	CreateOvertime(ctx context.Context, data entity.CreateOvertimeData) error
 	GetOvertime(ctx context.Context, filter entity.GetOvertimeFilter) ([]entity.Overtime, error)
 	CreateAttendancePeriod(ctx context.Context, req entity.CreateAttendancePeriodRequest) error
+	GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error)
+	UpdateAttendancePeriod(ctx context.Context, req entity.UpdateAttendancePeriodRequest) error
+	TransitionAttendancePeriod(ctx context.Context, req entity.TransitionAttendancePeriodRequest) error
+	GetAttendancePeriodTransitions(ctx context.Context, periodID uint) ([]entity.AttendancePeriodTransition, error)
+	GenerateAttendancePeriods(ctx context.Context) (int, error)
+
+	SubmitCorrection(ctx context.Context, data entity.SubmitAttendanceCorrection) error
+	ReviewCorrection(ctx context.Context, data entity.ReviewAttendanceCorrection) error
+	GetCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error)
+
+	GetAttendanceReport(ctx context.Context, req entity.GetAttendanceReportRequest) (*entity.AttendanceReport, error)
+	GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error)
+	GetAttendanceHistory(ctx context.Context, req entity.GetAttendanceHistoryRequest) (*entity.AttendanceHistory, error)
+
+	IngestPunches(ctx context.Context, req entity.IngestPunchesRequest) (*entity.PunchIngestResult, error)
+	SyncOfflineEvents(ctx context.Context, req entity.SyncAttendanceRequest) ([]entity.SyncEventResult, error)
+
+	AutoCloseAttendances(ctx context.Context, cutoff time.Duration) (int, error)
 }
 
 type Option struct {
-	AttendanceDom  attendanceDom.DomainItf
-	TransactionDom transactionDom.DomainItf
+	AttendanceDom   attendanceDom.DomainItf
+	TransactionDom  transactionDom.DomainItf
+	ShiftDom        shiftDom.DomainItf
+	LocationDom     locationDom.DomainItf
+	KioskDom        kioskDom.DomainItf
+	TimeClockDom    timeClockDom.DomainItf
+	DeviceDom       deviceDom.DomainItf
+	NotificationDom notificationDom.DomainItf
+	TravelDom       travelDom.DomainItf
+	HolidayDom      holidayDom.DomainItf
+	GeofenceMode    entity.GeofenceMode // empty behaves as off
+	WFHQuota        int                 // work-from-home days allowed per month, zero for no limit
+	PayrollCalendar entity.PayrollCalendar
 }
 
 type attendance struct {
-	AttendanceDom  attendanceDom.DomainItf
-	TransactionDom transactionDom.DomainItf
+	AttendanceDom   attendanceDom.DomainItf
+	TransactionDom  transactionDom.DomainItf
+	ShiftDom        shiftDom.DomainItf
+	LocationDom     locationDom.DomainItf
+	KioskDom        kioskDom.DomainItf
+	TimeClockDom    timeClockDom.DomainItf
+	DeviceDom       deviceDom.DomainItf
+	NotificationDom notificationDom.DomainItf
+	TravelDom       travelDom.DomainItf
+	HolidayDom      holidayDom.DomainItf
+	GeofenceMode    entity.GeofenceMode
+	WFHQuota        int
+	PayrollCalendar entity.PayrollCalendar
 }
 
 func InitAttendanceUsecase(opt Option) UsecaseItf {
 	p := &attendance{
-		AttendanceDom:  opt.AttendanceDom,
-		TransactionDom: opt.TransactionDom,
+		AttendanceDom:   opt.AttendanceDom,
+		TransactionDom:  opt.TransactionDom,
+		ShiftDom:        opt.ShiftDom,
+		LocationDom:     opt.LocationDom,
+		KioskDom:        opt.KioskDom,
+		TimeClockDom:    opt.TimeClockDom,
+		DeviceDom:       opt.DeviceDom,
+		NotificationDom: opt.NotificationDom,
+		TravelDom:       opt.TravelDom,
+		HolidayDom:      opt.HolidayDom,
+		GeofenceMode:    opt.GeofenceMode,
+		WFHQuota:        opt.WFHQuota,
+		PayrollCalendar: opt.PayrollCalendar,
 	}
 
 	return p
@@ -2,8 +2,11 @@ package attendance
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/zuhrulumam/go-hris/business/entity"
@@ -12,62 +15,609 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	// How early before a rostered shift an employee may check in
+	shiftEarlyCheckIn = 2 * time.Hour
+	// How long after a rostered shift ends an employee may still check out
+	shiftLateCheckOut = 4 * time.Hour
+	// Device fixes less accurate than this cannot place the employee at an office
+	maxGeoAccuracyMeters = 100
+	// How far the app's clock may drift from the server's when syncing
+	maxClockSkew = 5 * time.Minute
+	// How long an offline event may wait before it can no longer be synced
+	maxOfflineAge = 7 * 24 * time.Hour
+	// Longest range an attendance history or report covers in one request
+	maxHistoryDays = 366
+)
+
+// defaultShift is the schedule used for days without a roster entry
+var defaultShift = entity.Shift{
+	Name:         "Default",
+	StartTime:    "09:00",
+	EndTime:      "17:00",
+	BreakMinutes: 60,
+}
+
 func (p *attendance) CheckIn(ctx context.Context, data entity.CheckIn) error {
-	if data.Date.Weekday() == time.Saturday || data.Date.Weekday() == time.Sunday {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		origin, err := p.verifyWorkModePunch(newCtx, data.UserID, data.Date, data.WorkMode, data.Location, data.KioskToken)
+		if err != nil {
+			return err
+		}
+
+		if err := p.checkWorkModeAllowed(newCtx, data.UserID, data.Date, origin.workMode); err != nil {
+			return err
+		}
+
+		return p.recordCheckIn(newCtx, data.UserID, data.Date, data.Location, origin)
+	})
+
+}
+
+func (p *attendance) CheckOut(ctx context.Context, data entity.CheckOut) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		open, schedule, err := p.attendanceToClose(newCtx, data.UserID, data.Date)
+		if err != nil {
+			return err
+		}
+
+		if open == nil {
+			return x.NewWithCode(http.StatusNotFound, "attendance not found")
+		}
+
+		if err := p.checkPeriodAcceptsAttendance(newCtx, open.AttendancePeriodID); err != nil {
+			return err
+		}
+
+		// The check-out is verified the same way as the day's check-in
+		origin, err := p.verifyWorkModePunch(newCtx, data.UserID, data.Date, open.WorkMode, data.Location, data.KioskToken)
+		if err != nil {
+			return err
+		}
+
+		return p.recordCheckOut(newCtx, *open, schedule, data.Date, data.Location, origin)
+	})
+}
+
+// recordCheckIn opens an attendance for the shift starting around at.
+func (p *attendance) recordCheckIn(ctx context.Context, userID uint, at time.Time, point *entity.GeoPoint, origin punchOrigin) error {
+	assignments, err := p.resolveShifts(ctx, userID, at)
+	if err != nil {
+		return err
+	}
+
+	create := entity.CreateAttendance{
+		UserID:            userID,
+		CheckInAt:         at,
+		CheckInPoint:      point,
+		CheckInLocationID: origin.locationID,
+		CheckInKioskID:    origin.kioskID,
+		OutsideGeofence:   origin.outside,
+		WorkMode:          origin.workMode,
+	}
+
+	// Without a roster, fall back to the default weekday day shift
+	periodDate := at
+	if len(assignments) > 0 {
+		// The latest matching shift is the one being started
+		assignment := assignments[len(assignments)-1]
+		create.Date = assignment.Date
+		create.ShiftID = pkg.UintPtr(assignment.Shift.ID)
+		periodDate = assignment.Date
+	} else if at.Weekday() == time.Saturday || at.Weekday() == time.Sunday {
 		return x.NewWithCode(http.StatusBadRequest, "cannot check in on weekends")
 	}
 
-	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+	periods, err := p.AttendanceDom.GetAttendancePeriods(ctx, entity.GetAttendancePeriodFilter{
+		ContainsDate: &periodDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Open and reopened periods take attendance, locked ones do not
+	for _, period := range periods {
+		if period.AcceptsAttendance() {
+			create.AttendancePeriodID = period.ID
+			break
+		}
+	}
 
-		attPeriod, err := p.AttendanceDom.GetAttendancePeriods(newCtx, entity.GetAttendancePeriodFilter{
-			ContainsDate: &data.Date,
-			Status:       "open",
+	if create.AttendancePeriodID == 0 {
+		return x.NewWithCode(http.StatusBadRequest, "no open attendance period for today")
+	}
+
+	// Create attendance record
+	return p.AttendanceDom.CreateAttendance(ctx, create)
+}
+
+// attendanceToClose finds the attendance a check-out at t applies to: the
+// earliest rostered shift that is still open, e.g. last night's shift,
+// otherwise the attendance for t's day. It returns nil when there is none.
+// The shift is only set when it came from the roster.
+func (p *attendance) attendanceToClose(ctx context.Context, userID uint, t time.Time) (*entity.Attendance, *entity.Shift, error) {
+	assignments, err := p.resolveShifts(ctx, userID, t)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, assignment := range assignments {
+		att, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+			UserID: userID,
+			Date:   assignment.Date,
 		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(att) > 0 && att[0].CheckedOutAt == nil {
+			return &att[0], &assignment.Shift, nil
+		}
+	}
+
+	att, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+		UserID: userID,
+		Date:   t,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(att) < 1 {
+		return nil, nil, nil
+	}
+
+	return &att[0], nil, nil
+}
+
+// recordCheckOut closes open at the given time and measures it against its
+// shift, looking the shift up when schedule is nil.
+func (p *attendance) recordCheckOut(ctx context.Context, open entity.Attendance, schedule *entity.Shift, at time.Time, point *entity.GeoPoint, origin punchOrigin) error {
+	if schedule == nil {
+		shift, err := p.scheduleFor(ctx, open)
+		if err != nil {
+			return err
+		}
+		schedule = &shift
+	}
+
+	update := entity.UpdateAttendance{
+		AttendanceID:       open.ID,
+		CheckOutAt:         pkg.TimePtr(at),
+		CheckOutPoint:      point,
+		CheckOutLocationID: origin.locationID,
+		CheckOutKioskID:    origin.kioskID,
+		Version:            open.Version,
+	}
+
+	// Only raise the flag, a clean check-out must not clear a flagged check-in
+	if origin.outside {
+		update.OutsideGeofence = pkg.BoolPtr(true)
+	}
+
+	if open.CheckedInAt != nil {
+		breaks, err := p.endBreaks(ctx, open.ID, at)
+		if err != nil {
+			return err
+		}
+
+		metrics, err := measureAttendance(*schedule, open.Date, *open.CheckedInAt, at, breaks)
 		if err != nil {
 			return err
 		}
+		setWorkMetrics(&update, metrics)
+	}
+
+	// Update with check-out time
+	return p.AttendanceDom.UpdateAttendance(ctx, update)
+}
+
+// punchOrigin is where a check-in or check-out was verified to come from.
+type punchOrigin struct {
+	locationID *uint
+	kioskID    *uint
+	outside    bool
+	workMode   entity.WorkMode // empty for punches that do not carry one
+}
+
+// verifyWorkModePunch verifies a punch made in the given work mode. Office
+// punches go through the kiosk or geofence checks, the other modes keep the
+// device location as reported.
+func (p *attendance) verifyWorkModePunch(ctx context.Context, userID uint, at time.Time, mode entity.WorkMode, point *entity.GeoPoint, kioskToken string) (punchOrigin, error) {
+	switch mode {
+	case "", entity.WorkModeOffice:
+		origin, err := p.verifyPunch(ctx, userID, at, point, kioskToken)
+		origin.workMode = entity.WorkModeOffice
+		return origin, err
+	case entity.WorkModeWFH, entity.WorkModeClientSite, entity.WorkModeBusinessTrip:
+	default:
+		return punchOrigin{}, x.NewWithCode(http.StatusBadRequest, "invalid work mode")
+	}
+
+	if kioskToken != "" {
+		return punchOrigin{}, x.NewWithCode(http.StatusBadRequest, "kiosk check-in is only for office attendance")
+	}
+
+	// The visit is verified later against the reported location
+	if mode == entity.WorkModeClientSite && point == nil {
+		return punchOrigin{}, x.NewWithCode(http.StatusBadRequest, "location is required for client site attendance")
+	}
+
+	return punchOrigin{workMode: mode}, nil
+}
+
+// checkWorkModeAllowed applies the rules for starting a day in mode: the
+// monthly work-from-home quota, and an approved travel request covering the
+// day for a business trip.
+func (p *attendance) checkWorkModeAllowed(ctx context.Context, userID uint, at time.Time, mode entity.WorkMode) error {
+	switch mode {
+	case entity.WorkModeWFH:
+		if p.WFHQuota == 0 {
+			return nil
+		}
+
+		monthStart := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, at.Location())
+		monthEnd := monthStart.AddDate(0, 1, -1)
 
-		// Create attendance record
-		err = p.AttendanceDom.CreateAttendance(ctx, entity.CreateAttendance{
-			UserID:             data.UserID,
-			AttendancePeriodID: attPeriod[0].ID,
-			CheckInAt:          data.Date,
+		used, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+			UserID:    userID,
+			StartDate: &monthStart,
+			EndDate:   &monthEnd,
+			WorkMode:  entity.WorkModeWFH,
 		})
+		if err != nil {
+			return err
+		}
 
+		if len(used) >= p.WFHQuota {
+			return x.NewWithCode(http.StatusBadRequest, fmt.Sprintf("work-from-home quota of %d days this month is used up", p.WFHQuota))
+		}
+	case entity.WorkModeBusinessTrip:
+		trips, err := p.TravelDom.GetTravelRequests(ctx, entity.GetTravelRequestFilter{
+			UserID:       userID,
+			Status:       entity.TravelStatusApproved,
+			ContainsDate: &at,
+		})
 		if err != nil {
 			return err
 		}
 
-		return nil
+		if len(trips) < 1 {
+			return x.NewWithCode(http.StatusBadRequest, "business trip attendance requires an approved travel request for this day")
+		}
+	}
+
+	return nil
+}
+
+// verifyPunch establishes where a punch was made. A kiosk QR token places
+// the employee at the kiosk's office; otherwise the device location is
+// checked against the geofences.
+func (p *attendance) verifyPunch(ctx context.Context, userID uint, at time.Time, point *entity.GeoPoint, kioskToken string) (punchOrigin, error) {
+	if kioskToken == "" {
+		locationID, outside, err := p.checkGeofence(ctx, point)
+		return punchOrigin{locationID: locationID, outside: outside}, err
+	}
+
+	kiosk, err := p.redeemKioskToken(ctx, userID, at, kioskToken)
+	if err != nil {
+		return punchOrigin{}, err
+	}
+
+	return punchOrigin{
+		locationID: pkg.UintPtr(kiosk.OfficeLocationID),
+		kioskID:    pkg.UintPtr(kiosk.ID),
+	}, nil
+}
+
+// redeemKioskToken checks a scanned QR token's signature and freshness and
+// records it against the employee so it cannot be replayed. A token is
+// accepted during its own rotation slot and the one after, which leaves
+// time to scan a code shown just before it rotated.
+func (p *attendance) redeemKioskToken(ctx context.Context, userID uint, at time.Time, token string) (*entity.Kiosk, error) {
+	claims, err := pkg.ParseKioskToken(token)
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusBadRequest, "invalid kiosk token")
+	}
+
+	kiosks, err := p.KioskDom.GetKiosks(ctx, entity.GetKioskFilter{
+		ID:     claims.KioskID,
+		Active: pkg.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(kiosks) < 1 || !claims.Verify(kiosks[0].Secret) {
+		return nil, x.NewWithCode(http.StatusBadRequest, "invalid kiosk token")
+	}
+
+	if window := pkg.KioskWindow(at); claims.Window != window && claims.Window != window-1 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "kiosk token expired")
+	}
+
+	used, err := p.KioskDom.CountKioskScans(ctx, entity.GetKioskScanFilter{
+		KioskID: claims.KioskID,
+		UserID:  userID,
+		Nonce:   claims.Nonce,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if used > 0 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "kiosk token already used")
+	}
+
+	err = p.KioskDom.CreateKioskScan(ctx, entity.KioskScan{
+		KioskID:   claims.KioskID,
+		UserID:    userID,
+		Nonce:     claims.Nonce,
+		ScannedAt: at,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &kiosks[0], nil
+}
+
+// checkGeofence matches a punch against the active office locations. It
+// returns the matched office, or reports the punch as outside when flagging.
+// Nothing is checked when geofencing is off or no office is configured.
+func (p *attendance) checkGeofence(ctx context.Context, point *entity.GeoPoint) (*uint, bool, error) {
+	if p.GeofenceMode != entity.GeofenceFlag && p.GeofenceMode != entity.GeofenceEnforce {
+		return nil, false, nil
+	}
+
+	offices, err := p.LocationDom.GetOfficeLocations(ctx, entity.GetOfficeLocationFilter{
+		Active: pkg.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if len(offices) == 0 {
+		return nil, false, nil
+	}
+
+	if point == nil {
+		if p.GeofenceMode == entity.GeofenceEnforce {
+			return nil, false, x.NewWithCode(http.StatusBadRequest, "location is required")
+		}
+		return nil, true, nil
+	}
+
+	if point.AccuracyMeters <= maxGeoAccuracyMeters {
+		for _, office := range offices {
+			distance := pkg.DistanceMeters(point.Latitude, point.Longitude, office.Latitude, office.Longitude)
+			if distance <= office.RadiusMeters {
+				return pkg.UintPtr(office.ID), false, nil
+			}
+		}
+	}
+
+	if p.GeofenceMode == entity.GeofenceEnforce {
+		return nil, false, x.NewWithCode(http.StatusBadRequest, "location is outside of permitted office locations")
+	}
+
+	return nil, true, nil
+}
+
+// scheduleFor returns the shift an attendance record is measured against.
+func (p *attendance) scheduleFor(ctx context.Context, att entity.Attendance) (entity.Shift, error) {
+	if att.ShiftID == nil {
+		return defaultShift, nil
+	}
+
+	shifts, err := p.ShiftDom.GetShifts(ctx, entity.GetShiftFilter{ID: *att.ShiftID})
+	if err != nil {
+		return entity.Shift{}, err
+	}
+
+	if len(shifts) < 1 {
+		return defaultShift, nil
+	}
+
+	return shifts[0], nil
+}
+
+// measureAttendance derives worked, late and early-leave minutes for a
+// check-in/check-out pair against the shift scheduled on date. The shift's
+// break is unpaid whether or not it was punched, and so is any break time
+// recorded beyond it.
+func measureAttendance(shift entity.Shift, date, checkIn, checkOut time.Time, breaks time.Duration) (entity.WorkMetrics, error) {
+	start, end, err := shift.Window(date)
+	if err != nil {
+		return entity.WorkMetrics{}, x.WrapWithCode(err, http.StatusInternalServerError, "invalid shift definition")
+	}
+
+	unpaid := max(breaks, time.Duration(shift.BreakMinutes)*time.Minute)
+
+	worked := checkOut.Sub(checkIn)
+	worked -= min(unpaid, worked)
+
+	return entity.WorkMetrics{
+		WorkedMinutes:     int(worked.Minutes()),
+		BreakMinutes:      int(breaks.Minutes()),
+		LateMinutes:       int(max(checkIn.Sub(start), 0).Minutes()),
+		EarlyLeaveMinutes: int(max(end.Sub(checkOut), 0).Minutes()),
+	}, nil
+}
+
+func setWorkMetrics(update *entity.UpdateAttendance, metrics entity.WorkMetrics) {
+	update.WorkedMinutes = &metrics.WorkedMinutes
+	update.BreakMinutes = &metrics.BreakMinutes
+	update.LateMinutes = &metrics.LateMinutes
+	update.EarlyLeaveMinutes = &metrics.EarlyLeaveMinutes
+}
+
+// endBreaks ends the break still in progress on an attendance at until and
+// returns the break time taken up to until.
+func (p *attendance) endBreaks(ctx context.Context, attendanceID uint, until time.Time) (time.Duration, error) {
+	breaks, err := p.AttendanceDom.GetAttendanceBreaks(ctx, entity.GetAttendanceBreakFilter{
+		AttendanceID: attendanceID,
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	var total time.Duration
+	for _, b := range breaks {
+		end := until
+		if b.EndedAt != nil && b.EndedAt.Before(until) {
+			end = *b.EndedAt
+		}
+
+		if b.EndedAt == nil {
+			err := p.AttendanceDom.UpdateAttendanceBreak(ctx, entity.UpdateAttendanceBreak{
+				ID:      b.ID,
+				EndedAt: maxTime(end, b.StartedAt),
+			})
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		total += max(end.Sub(b.StartedAt), 0)
+	}
 
+	return total, nil
 }
 
-func (p *attendance) CheckOut(ctx context.Context, data entity.CheckOut) error {
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// resolveShifts returns the rostered shifts from yesterday and today whose
+// window contains t, ordered by shift date. Yesterday is included so that a
+// shift crossing midnight is still found after the calendar day changes.
+func (p *attendance) resolveShifts(ctx context.Context, userID uint, t time.Time) ([]entity.ShiftAssignment, error) {
+	today := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	yesterday := today.AddDate(0, 0, -1)
+
+	rosters, err := p.ShiftDom.GetRosters(ctx, entity.GetShiftRosterFilter{
+		UserID:    userID,
+		StartDate: &yesterday,
+		EndDate:   &today,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []entity.ShiftAssignment
+	for _, r := range rosters {
+		start, end, err := r.Shift.Window(r.Date)
+		if err != nil {
+			return nil, x.WrapWithCode(err, http.StatusInternalServerError, "invalid shift definition")
+		}
+
+		if t.Before(start.Add(-shiftEarlyCheckIn)) || t.After(end.Add(shiftLateCheckOut)) {
+			continue
+		}
+
+		assignments = append(assignments, entity.ShiftAssignment{
+			Date:    r.Date,
+			Shift:   r.Shift,
+			StartAt: start,
+			EndAt:   end,
+		})
+	}
+
+	return assignments, nil
+}
+
+func (p *attendance) StartBreak(ctx context.Context, data entity.StartBreak) error {
 	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
 
-		att, err := p.AttendanceDom.GetAttendance(newCtx, entity.GetAttendance{
-			UserID: data.UserID,
-			Date:   data.Date,
+		att, err := p.attendanceForBreak(newCtx, data.UserID, data.Date)
+		if err != nil {
+			return err
+		}
+
+		if data.Date.Before(*att.CheckedInAt) {
+			return x.NewWithCode(http.StatusBadRequest, "break cannot start before check-in")
+		}
+
+		running, err := p.AttendanceDom.GetAttendanceBreaks(newCtx, entity.GetAttendanceBreakFilter{
+			AttendanceID: att.ID,
+			Open:         true,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(running) > 0 {
+			return x.NewWithCode(http.StatusBadRequest, "a break is already in progress")
+		}
+
+		return p.AttendanceDom.CreateAttendanceBreak(newCtx, entity.AttendanceBreak{
+			AttendanceID: att.ID,
+			UserID:       data.UserID,
+			StartedAt:    data.Date,
 		})
+	})
+}
+
+func (p *attendance) EndBreak(ctx context.Context, data entity.EndBreak) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		att, err := p.attendanceForBreak(newCtx, data.UserID, data.Date)
 		if err != nil {
 			return err
 		}
 
-		// Update with check-out time
-		err = p.AttendanceDom.UpdateAttendance(newCtx, entity.UpdateAttendance{
-			AttendanceID: att[0].ID,
-			CheckOutAt:   pkg.TimePtr(data.Date),
-			Version:      att[0].Version,
+		running, err := p.AttendanceDom.GetAttendanceBreaks(newCtx, entity.GetAttendanceBreakFilter{
+			AttendanceID: att.ID,
+			Open:         true,
 		})
 		if err != nil {
 			return err
 		}
 
-		return nil
+		if len(running) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "no break in progress")
+		}
+
+		if data.Date.Before(running[0].StartedAt) {
+			return x.NewWithCode(http.StatusBadRequest, "break cannot end before it started")
+		}
+
+		return p.AttendanceDom.UpdateAttendanceBreak(newCtx, entity.UpdateAttendanceBreak{
+			ID:      running[0].ID,
+			EndedAt: data.Date,
+		})
 	})
 }
 
+// attendanceForBreak returns the attendance a break punch at t belongs to,
+// which must be checked in and not yet checked out.
+func (p *attendance) attendanceForBreak(ctx context.Context, userID uint, t time.Time) (*entity.Attendance, error) {
+	att, _, err := p.attendanceToClose(ctx, userID, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if att == nil || att.CheckedInAt == nil {
+		return nil, x.NewWithCode(http.StatusBadRequest, "you are not checked in")
+	}
+
+	if att.CheckedOutAt != nil {
+		return nil, x.NewWithCode(http.StatusBadRequest, "attendance already has a check-out")
+	}
+
+	if err := p.checkPeriodAcceptsAttendance(ctx, att.AttendancePeriodID); err != nil {
+		return nil, err
+	}
+
+	return att, nil
+}
+
 func (p *attendance) CreateOvertime(ctx context.Context, data entity.CreateOvertimeData) error {
 	// Check: max 3 hours
 	if data.Hours > 3 {
@@ -116,11 +666,18 @@ func (p *attendance) GetOvertime(ctx context.Context, filter entity.GetOvertimeF
 }
 
 func (p *attendance) CreateAttendancePeriod(ctx context.Context, req entity.CreateAttendancePeriodRequest) error {
+	if req.StartDate.After(req.EndDate) {
+		return x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date")
+	}
+
+	if err := p.checkPeriodOverlap(ctx, 0, req.StartDate, req.EndDate); err != nil {
+		return err
+	}
 
 	data := entity.AttendancePeriod{
 		StartDate: req.StartDate,
 		EndDate:   req.EndDate,
-		Status:    "open",
+		Status:    entity.AttendancePeriodOpen,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -132,3 +689,1110 @@ func (p *attendance) CreateAttendancePeriod(ctx context.Context, req entity.Crea
 
 	return nil
 }
+
+func (p *attendance) GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error) {
+	return p.AttendanceDom.GetAttendancePeriods(ctx, filter)
+}
+
+// UpdateAttendancePeriod moves a period's dates. Only periods still taking
+// attendance can be edited, and no recorded day may fall outside the new dates.
+func (p *attendance) UpdateAttendancePeriod(ctx context.Context, req entity.UpdateAttendancePeriodRequest) error {
+	if req.StartDate.After(req.EndDate) {
+		return x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date")
+	}
+
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		period, err := p.attendancePeriod(newCtx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if !period.AcceptsAttendance() {
+			return x.NewWithCode(http.StatusConflict, fmt.Sprintf("attendance period is %s and can no longer be edited", period.Status))
+		}
+
+		if err := p.checkPeriodOverlap(newCtx, period.ID, req.StartDate, req.EndDate); err != nil {
+			return err
+		}
+
+		attendances, err := p.AttendanceDom.GetAttendance(newCtx, entity.GetAttendance{
+			AttendancePeriodID: period.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, att := range attendances {
+			if att.Date.Before(req.StartDate) || att.Date.After(req.EndDate) {
+				return x.NewWithCode(http.StatusConflict, "attendance period has attendance outside the new dates")
+			}
+		}
+
+		return p.AttendanceDom.UpdateAttendancePeriod(newCtx, entity.UpdateAttendancePeriod{
+			ID:             period.ID,
+			StartDate:      &req.StartDate,
+			EndDate:        &req.EndDate,
+			ExpectedStatus: period.Status,
+		})
+	})
+}
+
+// TransitionAttendancePeriod moves a period to another status when the
+// lifecycle allows it and records the change.
+func (p *attendance) TransitionAttendancePeriod(ctx context.Context, req entity.TransitionAttendancePeriodRequest) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		period, err := p.attendancePeriod(newCtx, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if !period.CanMoveTo(req.Status) {
+			return x.NewWithCode(http.StatusConflict, fmt.Sprintf("attendance period cannot move from %s to %s", period.Status, req.Status))
+		}
+
+		now := time.Now()
+		update := entity.UpdateAttendancePeriod{
+			ID:             period.ID,
+			Status:         &req.Status,
+			ExpectedStatus: period.Status,
+		}
+
+		if req.Status == entity.AttendancePeriodClosed {
+			update.ClosedAt = &now
+		}
+
+		if err := p.AttendanceDom.UpdateAttendancePeriod(newCtx, update); err != nil {
+			return err
+		}
+
+		return p.AttendanceDom.CreateAttendancePeriodTransition(newCtx, entity.AttendancePeriodTransition{
+			AttendancePeriodID: period.ID,
+			FromStatus:         period.Status,
+			ToStatus:           req.Status,
+			ChangedBy:          req.ChangedBy,
+			Note:               req.Note,
+			CreatedAt:          now,
+		})
+	})
+}
+
+func (p *attendance) GetAttendancePeriodTransitions(ctx context.Context, periodID uint) ([]entity.AttendancePeriodTransition, error) {
+	if _, err := p.attendancePeriod(ctx, periodID); err != nil {
+		return nil, err
+	}
+
+	return p.AttendanceDom.GetAttendancePeriodTransitions(ctx, entity.GetAttendancePeriodTransitionFilter{
+		AttendancePeriodID: periodID,
+	})
+}
+
+// GenerateAttendancePeriods creates the payroll calendar's current period
+// and the next PeriodsAhead ones when they do not exist yet. A calendar
+// period sharing any day with an existing period, whatever its status, is
+// skipped so periods created by hand are never overlapped.
+func (p *attendance) GenerateAttendancePeriods(ctx context.Context) (int, error) {
+	if p.PayrollCalendar.Frequency == "" {
+		return 0, nil
+	}
+
+	created := 0
+	start, end := p.PayrollCalendar.PeriodContaining(time.Now())
+	for i := 0; i <= p.PayrollCalendar.PeriodsAhead; i++ {
+		// Periods end on the last second of their last day so punches that day still fall inside
+		last := end.AddDate(0, 0, 1).Add(-time.Second)
+
+		overlapping, err := p.AttendanceDom.GetAttendancePeriods(ctx, entity.GetAttendancePeriodFilter{
+			OverlapStart: &start,
+			OverlapEnd:   &last,
+		})
+		if err != nil {
+			return created, err
+		}
+
+		if len(overlapping) < 1 {
+			err := p.AttendanceDom.CreateAttendancePeriod(ctx, entity.AttendancePeriod{
+				StartDate: start,
+				EndDate:   last,
+				Status:    entity.AttendancePeriodOpen,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+			if err != nil {
+				return created, err
+			}
+			created++
+		}
+
+		start, end = p.PayrollCalendar.PeriodContaining(end.AddDate(0, 0, 1))
+	}
+
+	return created, nil
+}
+
+func (p *attendance) attendancePeriod(ctx context.Context, id uint) (*entity.AttendancePeriod, error) {
+	periods, err := p.AttendanceDom.GetAttendancePeriods(ctx, entity.GetAttendancePeriodFilter{
+		ID: fmt.Sprint(id),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(periods) < 1 {
+		return nil, x.NewWithCode(http.StatusNotFound, "attendance period not found")
+	}
+
+	return &periods[0], nil
+}
+
+// checkPeriodOverlap rejects dates shared with another period, so every day
+// belongs to at most one period.
+func (p *attendance) checkPeriodOverlap(ctx context.Context, excludeID uint, start, end time.Time) error {
+	overlapping, err := p.AttendanceDom.GetAttendancePeriods(ctx, entity.GetAttendancePeriodFilter{
+		OverlapStart: &start,
+		OverlapEnd:   &end,
+		ExcludeID:    excludeID,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(overlapping) > 0 {
+		return x.NewWithCode(http.StatusConflict, fmt.Sprintf("dates overlap attendance period %d (%s to %s)",
+			overlapping[0].ID, overlapping[0].StartDate.Format("2006-01-02"), overlapping[0].EndDate.Format("2006-01-02")))
+	}
+
+	return nil
+}
+
+// checkPeriodAcceptsAttendance rejects changes to attendance in a period
+// that was locked for payroll.
+func (p *attendance) checkPeriodAcceptsAttendance(ctx context.Context, periodID uint) error {
+	period, err := p.attendancePeriod(ctx, periodID)
+	if err != nil {
+		return err
+	}
+
+	if !period.AcceptsAttendance() {
+		return x.NewWithCode(http.StatusConflict, fmt.Sprintf("attendance period is %s, its attendance can no longer change", period.Status))
+	}
+
+	return nil
+}
+
+func (p *attendance) SubmitCorrection(ctx context.Context, data entity.SubmitAttendanceCorrection) error {
+	if data.Reason == "" {
+		return x.NewWithCode(http.StatusBadRequest, "reason is required")
+	}
+
+	date := time.Date(data.Date.Year(), data.Date.Month(), data.Date.Day(), 0, 0, 0, 0, data.Date.Location())
+
+	switch data.Type {
+	case entity.CorrectionMissingCheckIn:
+		if data.CheckInAt == nil {
+			return x.NewWithCode(http.StatusBadRequest, "check-in time is required")
+		}
+	case entity.CorrectionMissingCheckOut:
+		if data.CheckOutAt == nil {
+			return x.NewWithCode(http.StatusBadRequest, "check-out time is required")
+		}
+	case entity.CorrectionWrongTime:
+		if data.CheckInAt == nil && data.CheckOutAt == nil {
+			return x.NewWithCode(http.StatusBadRequest, "check-in or check-out time is required")
+		}
+	default:
+		return x.NewWithCode(http.StatusBadRequest, "invalid correction type")
+	}
+
+	if data.CheckInAt != nil && !sameDay(*data.CheckInAt, date) {
+		return x.NewWithCode(http.StatusBadRequest, "check-in time must be on the corrected date")
+	}
+
+	if data.CheckInAt != nil && data.CheckInAt.After(time.Now()) ||
+		data.CheckOutAt != nil && data.CheckOutAt.After(time.Now()) {
+		return x.NewWithCode(http.StatusBadRequest, "correction cannot be in the future")
+	}
+
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		pending, err := p.AttendanceDom.GetAttendanceCorrections(newCtx, entity.GetAttendanceCorrectionFilter{
+			UserID: data.UserID,
+			Date:   date,
+			Status: entity.CorrectionStatusPending,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(pending) > 0 {
+			return x.NewWithCode(http.StatusBadRequest, "a correction for this date is already pending")
+		}
+
+		att, err := p.AttendanceDom.GetAttendance(newCtx, entity.GetAttendance{
+			UserID: data.UserID,
+			Date:   date,
+		})
+		if err != nil {
+			return err
+		}
+
+		correction := entity.AttendanceCorrection{
+			UserID:              data.UserID,
+			Date:                date,
+			Type:                data.Type,
+			RequestedCheckInAt:  data.CheckInAt,
+			RequestedCheckOutAt: data.CheckOutAt,
+			Reason:              data.Reason,
+			Status:              entity.CorrectionStatusPending,
+			CreatedAt:           time.Now(),
+			UpdatedAt:           time.Now(),
+		}
+
+		if data.Type == entity.CorrectionMissingCheckIn {
+			if len(att) > 0 {
+				return x.NewWithCode(http.StatusBadRequest, "attendance already exists for this date")
+			}
+		} else {
+			if len(att) < 1 {
+				return x.NewWithCode(http.StatusBadRequest, "no attendance found for this date")
+			}
+
+			if data.Type == entity.CorrectionMissingCheckOut && att[0].CheckedOutAt != nil {
+				return x.NewWithCode(http.StatusBadRequest, "attendance already has a check-out")
+			}
+
+			correction.AttendanceID = pkg.UintPtr(att[0].ID)
+		}
+
+		return p.AttendanceDom.CreateAttendanceCorrection(newCtx, correction)
+	})
+}
+
+func (p *attendance) ReviewCorrection(ctx context.Context, data entity.ReviewAttendanceCorrection) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		corrections, err := p.AttendanceDom.GetAttendanceCorrections(newCtx, entity.GetAttendanceCorrectionFilter{
+			ID: data.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(corrections) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "attendance correction not found")
+		}
+
+		correction := corrections[0]
+		if correction.Status != entity.CorrectionStatusPending {
+			return x.NewWithCode(http.StatusBadRequest, "attendance correction was already reviewed")
+		}
+
+		update := entity.UpdateAttendanceCorrection{
+			ID:         correction.ID,
+			Status:     entity.CorrectionStatusRejected,
+			ReviewedBy: data.ReviewerID,
+			ReviewedAt: time.Now(),
+			ReviewNote: data.Note,
+		}
+
+		if !data.Approve {
+			return p.AttendanceDom.UpdateAttendanceCorrection(newCtx, update)
+		}
+
+		update.Status = entity.CorrectionStatusApproved
+
+		att, err := p.AttendanceDom.GetAttendance(newCtx, entity.GetAttendance{
+			UserID: correction.UserID,
+			Date:   correction.Date,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(att) < 1 {
+			if correction.Type != entity.CorrectionMissingCheckIn {
+				return x.NewWithCode(http.StatusBadRequest, "attendance for this correction no longer exists")
+			}
+
+			if err := p.createCorrectedAttendance(newCtx, correction); err != nil {
+				return err
+			}
+
+			return p.AttendanceDom.UpdateAttendanceCorrection(newCtx, update)
+		}
+
+		current := att[0]
+		if correction.Type == entity.CorrectionMissingCheckIn {
+			return x.NewWithCode(http.StatusBadRequest, "attendance already exists for this date")
+		}
+
+		if err := p.checkPeriodAcceptsAttendance(newCtx, current.AttendancePeriodID); err != nil {
+			return err
+		}
+
+		checkIn, checkOut := current.CheckedInAt, current.CheckedOutAt
+		if correction.RequestedCheckInAt != nil {
+			checkIn = correction.RequestedCheckInAt
+		}
+		if correction.RequestedCheckOutAt != nil {
+			checkOut = correction.RequestedCheckOutAt
+		}
+
+		if checkIn != nil && checkOut != nil && !checkOut.After(*checkIn) {
+			return x.NewWithCode(http.StatusBadRequest, "check-out must be after check-in")
+		}
+
+		attUpdate := entity.UpdateAttendance{
+			AttendanceID: current.ID,
+			CheckInAt:    correction.RequestedCheckInAt,
+			CheckOutAt:   correction.RequestedCheckOutAt,
+			Version:      current.Version,
+		}
+
+		if checkIn != nil && checkOut != nil {
+			schedule, err := p.scheduleFor(newCtx, current)
+			if err != nil {
+				return err
+			}
+
+			breaks, err := p.endBreaks(newCtx, current.ID, *checkOut)
+			if err != nil {
+				return err
+			}
+
+			metrics, err := measureAttendance(schedule, current.Date, *checkIn, *checkOut, breaks)
+			if err != nil {
+				return err
+			}
+			setWorkMetrics(&attUpdate, metrics)
+		}
+
+		// Goes through the same optimistic path as a regular check-out
+		err = p.AttendanceDom.UpdateAttendance(newCtx, attUpdate)
+		if err != nil {
+			return err
+		}
+
+		update.AttendanceID = pkg.UintPtr(current.ID)
+		update.OriginalCheckInAt = current.CheckedInAt
+		update.OriginalCheckOutAt = current.CheckedOutAt
+
+		return p.AttendanceDom.UpdateAttendanceCorrection(newCtx, update)
+	})
+}
+
+func (p *attendance) GetCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error) {
+	return p.AttendanceDom.GetAttendanceCorrections(ctx, filter)
+}
+
+func (p *attendance) createCorrectedAttendance(ctx context.Context, correction entity.AttendanceCorrection) error {
+	if correction.RequestedCheckOutAt != nil && !correction.RequestedCheckOutAt.After(*correction.RequestedCheckInAt) {
+		return x.NewWithCode(http.StatusBadRequest, "check-out must be after check-in")
+	}
+
+	attPeriod, err := p.AttendanceDom.GetAttendancePeriods(ctx, entity.GetAttendancePeriodFilter{
+		ContainsDate: correction.RequestedCheckInAt,
+		Statuses:     []string{entity.AttendancePeriodOpen, entity.AttendancePeriodReopened},
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(attPeriod) < 1 {
+		return x.NewWithCode(http.StatusBadRequest, "no open attendance period for this date")
+	}
+
+	create := entity.CreateAttendance{
+		UserID:             correction.UserID,
+		AttendancePeriodID: attPeriod[0].ID,
+		CheckInAt:          *correction.RequestedCheckInAt,
+		CheckOutAt:         correction.RequestedCheckOutAt,
+	}
+
+	if correction.RequestedCheckOutAt == nil {
+		return p.AttendanceDom.CreateAttendance(ctx, create)
+	}
+
+	// Measure the full day against the shift it would have been checked in to
+	schedule, date := defaultShift, *correction.RequestedCheckInAt
+	assignments, err := p.resolveShifts(ctx, correction.UserID, *correction.RequestedCheckInAt)
+	if err != nil {
+		return err
+	}
+
+	if len(assignments) > 0 {
+		assignment := assignments[len(assignments)-1]
+		schedule, date = assignment.Shift, assignment.Date
+		create.Date = assignment.Date
+		create.ShiftID = pkg.UintPtr(assignment.Shift.ID)
+	}
+
+	// A day created from a correction has no recorded breaks, assume the full allowance was taken
+	breaks := time.Duration(schedule.BreakMinutes) * time.Minute
+	metrics, err := measureAttendance(schedule, date, *correction.RequestedCheckInAt, *correction.RequestedCheckOutAt, breaks)
+	if err != nil {
+		return err
+	}
+
+	create.WorkedMinutes = metrics.WorkedMinutes
+	create.LateMinutes = metrics.LateMinutes
+	create.EarlyLeaveMinutes = metrics.EarlyLeaveMinutes
+
+	return p.AttendanceDom.CreateAttendance(ctx, create)
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+func (p *attendance) GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error) {
+	if filter.AttendancePeriodID == 0 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "attendance period is required")
+	}
+
+	return p.AttendanceDom.GetWorkModeSummary(ctx, filter)
+}
+
+func (p *attendance) GetAttendanceReport(ctx context.Context, req entity.GetAttendanceReportRequest) (*entity.AttendanceReport, error) {
+	if req.StartDate.After(req.EndDate) {
+		return nil, x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date")
+	}
+
+	if req.EndDate.Sub(req.StartDate) >= maxHistoryDays*24*time.Hour {
+		return nil, x.NewWithCode(http.StatusBadRequest, fmt.Sprintf("date range cannot be longer than %d days", maxHistoryDays))
+	}
+
+	attendances, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+		UserID:    req.UserID,
+		StartDate: &req.StartDate,
+		EndDate:   &req.EndDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &entity.AttendanceReport{
+		UserID:       req.UserID,
+		StartDate:    req.StartDate,
+		EndDate:      req.EndDate,
+		Attendances:  attendances,
+		AttendedDays: len(attendances),
+	}
+
+	// Index into report.ByWorkMode, which keeps the order modes first appear in
+	byMode := map[entity.WorkMode]int{}
+	for _, att := range attendances {
+		mode := att.WorkMode
+		if mode == "" {
+			mode = entity.WorkModeOffice
+		}
+
+		i, ok := byMode[mode]
+		if !ok {
+			i = len(report.ByWorkMode)
+			byMode[mode] = i
+			report.ByWorkMode = append(report.ByWorkMode, entity.WorkModeSummary{UserID: req.UserID, WorkMode: mode})
+		}
+		report.ByWorkMode[i].Days++
+		report.ByWorkMode[i].WorkedMinutes += att.WorkedMinutes
+
+		report.TotalWorkedMinutes += att.WorkedMinutes
+		report.TotalLateMinutes += att.LateMinutes
+		report.TotalEarlyLeaveMinutes += att.EarlyLeaveMinutes
+
+		if att.LateMinutes > 0 {
+			report.LateDays++
+		}
+		if att.EarlyLeaveMinutes > 0 {
+			report.EarlyLeaveDays++
+		}
+	}
+
+	return report, nil
+}
+
+// GetAttendanceHistory lists an employee's days in a period or date range,
+// newest first. Every day up to today is listed, with or without a check-in,
+// so absences show up next to rest days and holidays.
+func (p *attendance) GetAttendanceHistory(ctx context.Context, req entity.GetAttendanceHistoryRequest) (*entity.AttendanceHistory, error) {
+	start, end, err := p.historyRange(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.AttendanceHistory{Days: []entity.AttendanceDay{}}
+
+	today := time.Now().In(end.Location())
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, end.Location())
+	if end.After(today) {
+		end = today
+	}
+	if start.After(end) {
+		return result, nil
+	}
+
+	attendances, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+		UserID:    req.UserID,
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overtimes, err := p.AttendanceDom.GetOvertime(ctx, entity.GetOvertimeFilter{
+		UserID:    req.UserID,
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := p.HolidayDom.GetHolidays(ctx, entity.GetHolidayFilter{
+		StartDate: &start,
+		EndDate:   &end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Rosters are assigned a week at a time, so fetch whole weeks
+	rosterStart := weekStart(start)
+	rosterEnd := weekStart(end).AddDate(0, 0, 6)
+	rosters, err := p.ShiftDom.GetRosters(ctx, entity.GetShiftRosterFilter{
+		UserID:    req.UserID,
+		StartDate: &rosterStart,
+		EndDate:   &rosterEnd,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attByDate := map[string]entity.Attendance{}
+	for _, att := range attendances {
+		if _, ok := attByDate[att.Date.Format("2006-01-02")]; !ok {
+			attByDate[att.Date.Format("2006-01-02")] = att
+		}
+	}
+
+	overtimeByDate := map[string]float64{}
+	for _, ot := range overtimes {
+		overtimeByDate[ot.Date.Format("2006-01-02")] += ot.Hours
+	}
+
+	holidayByDate := map[string]string{}
+	for _, h := range holidays {
+		holidayByDate[h.Date.Format("2006-01-02")] = h.Name
+	}
+
+	rostered := map[string]bool{}
+	rosteredWeeks := map[string]bool{}
+	for _, r := range rosters {
+		rostered[r.Date.Format("2006-01-02")] = true
+		rosteredWeeks[weekStart(r.Date).Format("2006-01-02")] = true
+	}
+
+	var days []entity.AttendanceDay
+	for d := end; !d.Before(start); d = d.AddDate(0, 0, -1) {
+		key := d.Format("2006-01-02")
+
+		day := entity.AttendanceDay{
+			Date:          d,
+			OvertimeHours: overtimeByDate[key],
+		}
+
+		// A rostered week says which days are worked; otherwise it is Monday to Friday
+		if rosteredWeeks[weekStart(d).Format("2006-01-02")] {
+			day.Weekend = !rostered[key]
+		} else {
+			day.Weekend = d.Weekday() == time.Saturday || d.Weekday() == time.Sunday
+		}
+
+		if name, ok := holidayByDate[key]; ok {
+			day.Holiday = true
+			day.HolidayName = name
+		}
+
+		att, ok := attByDate[key]
+		switch {
+		case ok:
+			day.Attendance = &att
+			day.Status = entity.AttendanceDayPresent
+		case day.Holiday:
+			day.Status = entity.AttendanceDayHoliday
+		case day.Weekend:
+			day.Status = entity.AttendanceDayWeekend
+		default:
+			day.Status = entity.AttendanceDayAbsent
+		}
+
+		days = append(days, day)
+	}
+
+	limit := 10
+	page := 1
+	if req.Limit > 0 {
+		limit = req.Limit
+	}
+	if req.Page > 0 {
+		page = req.Page
+	}
+
+	result.TotalData = int64(len(days))
+	result.TotalPages = (len(days) + limit - 1) / limit
+
+	offset := (page - 1) * limit
+	if offset < len(days) {
+		result.Days = days[offset:min(offset+limit, len(days))]
+	}
+
+	return result, nil
+}
+
+// historyRange resolves the days an attendance history request covers.
+func (p *attendance) historyRange(ctx context.Context, req entity.GetAttendanceHistoryRequest) (time.Time, time.Time, error) {
+	if req.AttendancePeriodID > 0 {
+		if req.StartDate != nil || req.EndDate != nil {
+			return time.Time{}, time.Time{}, x.NewWithCode(http.StatusBadRequest, "provide either an attendance period or a date range, not both")
+		}
+
+		period, err := p.attendancePeriod(ctx, req.AttendancePeriodID)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		return period.StartDate, period.EndDate, nil
+	}
+
+	if req.StartDate == nil || req.EndDate == nil {
+		return time.Time{}, time.Time{}, x.NewWithCode(http.StatusBadRequest, "provide an attendance period or a start and end date")
+	}
+
+	if req.StartDate.After(*req.EndDate) {
+		return time.Time{}, time.Time{}, x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date")
+	}
+
+	if req.EndDate.Sub(*req.StartDate) >= maxHistoryDays*24*time.Hour {
+		return time.Time{}, time.Time{}, x.NewWithCode(http.StatusBadRequest, fmt.Sprintf("date range cannot be longer than %d days", maxHistoryDays))
+	}
+
+	return *req.StartDate, *req.EndDate, nil
+}
+
+// weekStart returns the Monday of the week t falls in.
+func weekStart(t time.Time) time.Time {
+	d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+}
+
+// IngestPunches folds a batch of raw time clock punches into attendances.
+// Punches are applied in time order: a punch opens an attendance when the
+// employee has none to close and closes it otherwise. Punches already
+// received are skipped, and those that cannot be mapped or paired are kept
+// for reconciliation instead of failing the batch.
+func (p *attendance) IngestPunches(ctx context.Context, req entity.IngestPunchesRequest) (*entity.PunchIngestResult, error) {
+	clocks, err := p.TimeClockDom.GetTimeClocks(ctx, entity.GetTimeClockFilter{
+		ID:     req.TimeClockID,
+		Active: pkg.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Same answer for unknown devices and wrong secrets
+	if len(clocks) < 1 || subtle.ConstantTimeCompare([]byte(clocks[0].Secret), []byte(req.Secret)) != 1 {
+		return nil, x.NewWithCode(http.StatusUnauthorized, "invalid time clock credentials")
+	}
+
+	punches := make([]entity.RawPunch, len(req.Punches))
+	copy(punches, req.Punches)
+	sort.SliceStable(punches, func(i, j int) bool {
+		return punches[i].PunchedAt.Before(punches[j].PunchedAt)
+	})
+
+	result := &entity.PunchIngestResult{Received: len(punches)}
+	for _, punch := range punches {
+		// Each punch commits on its own so a bad one cannot undo the rest
+		err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+			stored, err := p.ingestPunch(newCtx, clocks[0], punch)
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case stored == nil:
+				result.Duplicates++
+			case stored.Status == entity.PunchUnmapped:
+				result.Unmapped++
+			case stored.Status == entity.PunchUnpaired:
+				result.Unpaired++
+			default:
+				result.Applied++
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// ingestPunch stores a single punch and applies it, returning nil when the
+// punch was already received.
+func (p *attendance) ingestPunch(ctx context.Context, clock entity.TimeClock, punch entity.RawPunch) (*entity.TimeClockPunch, error) {
+	seen, err := p.TimeClockDom.CountTimeClockPunches(ctx, entity.GetTimeClockPunchFilter{
+		TimeClockID:  clock.ID,
+		EmployeeCode: punch.EmployeeCode,
+		PunchedAt:    &punch.PunchedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if seen > 0 {
+		return nil, nil
+	}
+
+	stored := entity.TimeClockPunch{
+		TimeClockID:  clock.ID,
+		EmployeeCode: punch.EmployeeCode,
+		PunchedAt:    punch.PunchedAt,
+		Status:       entity.PunchUnmapped,
+		CreatedAt:    time.Now(),
+	}
+
+	employees, err := p.TimeClockDom.GetTimeClockEmployees(ctx, entity.GetTimeClockEmployeeFilter{
+		EmployeeCode: punch.EmployeeCode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(employees) > 0 {
+		stored.UserID = pkg.UintPtr(employees[0].UserID)
+		stored.Status, stored.Note, err = p.foldPunch(ctx, employees[0].UserID, punch.PunchedAt, punchOrigin{
+			locationID: clock.OfficeLocationID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.TimeClockDom.CreateTimeClockPunch(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// foldPunch applies a mapped punch as a check-in or check-out. Punches that
+// do not fit the employee's attendance are reported as unpaired with a note
+// for whoever reconciles them.
+func (p *attendance) foldPunch(ctx context.Context, userID uint, at time.Time, origin punchOrigin) (entity.TimeClockPunchStatus, string, error) {
+	open, schedule, err := p.attendanceToClose(ctx, userID, at)
+	if err != nil {
+		return "", "", err
+	}
+
+	if open == nil {
+		err := p.recordCheckIn(ctx, userID, at, nil, origin)
+		if x.ErrCode(err) == http.StatusBadRequest {
+			return entity.PunchUnpaired, "no shift or open attendance period to check in to", nil
+		}
+		if err != nil {
+			return "", "", err
+		}
+		return entity.PunchApplied, "", nil
+	}
+
+	if open.CheckedOutAt != nil {
+		return entity.PunchUnpaired, "attendance is already checked out", nil
+	}
+
+	if open.CheckedInAt != nil && !at.After(*open.CheckedInAt) {
+		return entity.PunchUnpaired, "punch is not after the check-in", nil
+	}
+
+	err = p.checkPeriodAcceptsAttendance(ctx, open.AttendancePeriodID)
+	if x.ErrCode(err) == http.StatusConflict {
+		return entity.PunchUnpaired, x.Summary(err), nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	err = p.recordCheckOut(ctx, *open, schedule, at, nil, origin)
+	if x.ErrCode(err) == http.StatusBadRequest {
+		return entity.PunchUnpaired, "punch cannot close the open attendance", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	return entity.PunchApplied, "", nil
+}
+
+// SyncOfflineEvents applies check-ins and check-outs the mobile app recorded
+// while offline. Events are applied in the order they were recorded, each in
+// its own transaction, and each gets its own result so a rejected event does
+// not hold back the rest. Events already applied are reported as duplicates.
+func (p *attendance) SyncOfflineEvents(ctx context.Context, req entity.SyncAttendanceRequest) ([]entity.SyncEventResult, error) {
+	devices, err := p.DeviceDom.GetDevices(ctx, entity.GetDeviceFilter{
+		ID:     req.DeviceID,
+		UserID: req.UserID,
+		Active: pkg.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(devices) < 1 {
+		return nil, x.NewWithCode(http.StatusUnauthorized, "unknown or revoked device")
+	}
+
+	// Event times come from the device clock, so it has to be trustworthy
+	if skew := time.Since(req.DeviceTime); skew > maxClockSkew || skew < -maxClockSkew {
+		return nil, x.NewWithCode(http.StatusBadRequest, "device clock is out of sync with the server")
+	}
+
+	events := make([]entity.OfflineEvent, len(req.Events))
+	copy(events, req.Events)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].RecordedAt.Before(events[j].RecordedAt)
+	})
+
+	results := make([]entity.SyncEventResult, 0, len(events))
+	for _, event := range events {
+		result, err := p.syncOfflineEvent(ctx, req, devices[0], event)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// syncOfflineEvent applies a single event. Only errors that resending cannot
+// fix are returned, the rest become a rejected result.
+func (p *attendance) syncOfflineEvent(ctx context.Context, req entity.SyncAttendanceRequest, device entity.MobileDevice, event entity.OfflineEvent) (entity.SyncEventResult, error) {
+	result := entity.SyncEventResult{ClientID: event.ClientID}
+
+	duplicate := false
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		synced, err := p.DeviceDom.GetSyncEvents(newCtx, entity.GetSyncEventFilter{
+			DeviceID: device.ID,
+			ClientID: event.ClientID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(synced) > 0 {
+			duplicate = true
+			return nil
+		}
+
+		// Stored first so a concurrent sync of the same event conflicts
+		// here, before either applies it
+		err = p.DeviceDom.CreateSyncEvent(newCtx, entity.SyncEvent{
+			UserID:     req.UserID,
+			DeviceID:   device.ID,
+			ClientID:   event.ClientID,
+			Type:       event.Type,
+			RecordedAt: event.RecordedAt,
+			CreatedAt:  time.Now(),
+		})
+		if x.ErrCode(err) == http.StatusConflict {
+			duplicate = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		return p.applyOfflineEvent(newCtx, req, device, event)
+	})
+
+	switch code := x.ErrCode(err); {
+	case err == nil && duplicate:
+		result.Status = entity.SyncDuplicate
+	case err == nil:
+		result.Status = entity.SyncApplied
+	case code == http.StatusBadRequest || code == http.StatusNotFound:
+		result.Status = entity.SyncRejected
+		result.Message = x.Summary(err)
+	default:
+		return result, err
+	}
+
+	return result, nil
+}
+
+func (p *attendance) applyOfflineEvent(ctx context.Context, req entity.SyncAttendanceRequest, device entity.MobileDevice, event entity.OfflineEvent) error {
+	var lat, lng *float64
+	if event.Location != nil {
+		lat, lng = &event.Location.Latitude, &event.Location.Longitude
+	}
+
+	payload := pkg.SyncEventPayload(event.ClientID, string(event.Type), event.RecordedAt, lat, lng)
+	if !pkg.VerifySyncEvent(payload, event.Signature, device.Secret) {
+		return x.NewWithCode(http.StatusBadRequest, "invalid event signature")
+	}
+
+	if event.RecordedAt.After(req.DeviceTime) {
+		return x.NewWithCode(http.StatusBadRequest, "event is recorded after the batch was sent")
+	}
+
+	if req.DeviceTime.Sub(event.RecordedAt) > maxOfflineAge {
+		return x.NewWithCode(http.StatusBadRequest, "event is too old to sync")
+	}
+
+	locationID, outside, err := p.checkGeofence(ctx, event.Location)
+	if err != nil {
+		return err
+	}
+	origin := punchOrigin{locationID: locationID, outside: outside}
+
+	switch event.Type {
+	case entity.SyncCheckIn:
+		return p.recordCheckIn(ctx, req.UserID, event.RecordedAt, event.Location, origin)
+	case entity.SyncCheckOut:
+		open, schedule, err := p.attendanceToClose(ctx, req.UserID, event.RecordedAt)
+		if err != nil {
+			return err
+		}
+
+		if open == nil {
+			return x.NewWithCode(http.StatusNotFound, "attendance not found")
+		}
+
+		// Rejected rather than failing the batch, resending will not help
+		err = p.checkPeriodAcceptsAttendance(ctx, open.AttendancePeriodID)
+		if x.ErrCode(err) == http.StatusConflict {
+			return x.NewWithCode(http.StatusBadRequest, x.Summary(err))
+		}
+		if err != nil {
+			return err
+		}
+
+		return p.recordCheckOut(ctx, *open, schedule, event.RecordedAt, event.Location, origin)
+	default:
+		return x.NewWithCode(http.StatusBadRequest, "unknown event type")
+	}
+}
+
+// AutoCloseAttendances checks out attendances that are still open cutoff
+// after their shift ended. The check-out is set to the shift end, the row is
+// marked auto-closed and the employee is asked to file a correction if that
+// is not when they left. It returns how many attendances were closed.
+func (p *attendance) AutoCloseAttendances(ctx context.Context, cutoff time.Duration) (int, error) {
+	now := time.Now()
+
+	// Only an attendance checked in more than cutoff ago can be due
+	open, err := p.AttendanceDom.GetAttendance(ctx, entity.GetAttendance{
+		Open:            true,
+		CheckedInBefore: pkg.TimePtr(now.Add(-cutoff)),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	closed := 0
+	for _, att := range open {
+		if att.CheckedInAt == nil {
+			continue
+		}
+
+		done, err := p.autoClose(ctx, att, now, cutoff)
+		if x.ErrCode(err) == http.StatusConflict {
+			// Checked out in the meantime
+			continue
+		}
+		if err != nil {
+			return closed, err
+		}
+
+		if done {
+			closed++
+		}
+	}
+
+	return closed, nil
+}
+
+func (p *attendance) autoClose(ctx context.Context, att entity.Attendance, now time.Time, cutoff time.Duration) (bool, error) {
+	done := false
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		shift, err := p.scheduleFor(newCtx, att)
+		if err != nil {
+			return err
+		}
+
+		_, end, err := shift.Window(att.Date)
+		if err != nil {
+			return x.WrapWithCode(err, http.StatusInternalServerError, "invalid shift definition")
+		}
+
+		if now.Before(end.Add(cutoff)) {
+			return nil
+		}
+
+		// Left open for a correction once the period is reopened
+		period, err := p.attendancePeriod(newCtx, att.AttendancePeriodID)
+		if err != nil {
+			return err
+		}
+
+		if !period.AcceptsAttendance() {
+			return nil
+		}
+
+		// Checked in after the shift ended, close without any worked time
+		closeAt := end
+		if att.CheckedInAt.After(end) {
+			closeAt = *att.CheckedInAt
+		}
+
+		breaks, err := p.endBreaks(newCtx, att.ID, closeAt)
+		if err != nil {
+			return err
+		}
+
+		metrics, err := measureAttendance(shift, att.Date, *att.CheckedInAt, closeAt, breaks)
+		if err != nil {
+			return err
+		}
+
+		update := entity.UpdateAttendance{
+			AttendanceID: att.ID,
+			CheckOutAt:   pkg.TimePtr(closeAt),
+			AutoClosed:   pkg.BoolPtr(true),
+			Version:      att.Version,
+		}
+		setWorkMetrics(&update, metrics)
+
+		if err := p.AttendanceDom.UpdateAttendance(newCtx, update); err != nil {
+			return err
+		}
+
+		done = true
+
+		return p.NotificationDom.CreateNotification(newCtx, entity.Notification{
+			UserID: att.UserID,
+			Type:   entity.NotifyAttendanceAutoClosed,
+			Message: fmt.Sprintf("You did not check out on %s, so your attendance was closed at the shift end (%s). Please submit a correction if you left at a different time.",
+				att.Date.Format("2006-01-02"), closeAt.Format("15:04")),
+			CreatedAt: now,
+		})
+	})
+
+	return done, err
+}
@@ -3,6 +3,7 @@ package attendance_test
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
@@ -10,17 +11,28 @@ import (
 	"github.com/zuhrulumam/go-hris/business/entity"
 	uc "github.com/zuhrulumam/go-hris/business/usecase/attendance"
 	mockAttendance "github.com/zuhrulumam/go-hris/mocks/domain/attendance"
+	mockDevice "github.com/zuhrulumam/go-hris/mocks/domain/device"
+	mockHoliday "github.com/zuhrulumam/go-hris/mocks/domain/holiday"
+	mockKiosk "github.com/zuhrulumam/go-hris/mocks/domain/kiosk"
+	mockLocation "github.com/zuhrulumam/go-hris/mocks/domain/location"
+	mockNotification "github.com/zuhrulumam/go-hris/mocks/domain/notification"
+	mockShift "github.com/zuhrulumam/go-hris/mocks/domain/shift"
+	mockTimeClock "github.com/zuhrulumam/go-hris/mocks/domain/timeclock"
 	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockTravel "github.com/zuhrulumam/go-hris/mocks/domain/travel"
 	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
 	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
 
 func TestCheckIn(t *testing.T) {
+	nightShift := entity.Shift{ID: 3, Name: "Night", StartTime: "22:00", EndTime: "06:00", CrossesMidnight: true}
+
 	tests := []struct {
 		name        string
 		input       entity.CheckIn
-		setupMocks  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf)
+		setupMocks  func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf)
 		expectErr   bool
 		errorString string
 	}{
@@ -30,16 +42,19 @@ func TestCheckIn(t *testing.T) {
 				UserID: 1,
 				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC), // Selasa
 			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
 				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
 						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
-							Return([]entity.AttendancePeriod{{ID: 10}}, nil)
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
 
 						a.EXPECT().CreateAttendance(gomock.Any(), entity.CreateAttendance{
 							UserID:             1,
 							AttendancePeriodID: 10,
 							CheckInAt:          time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+							WorkMode:           entity.WorkModeOffice,
 						}).Return(nil)
 
 						return fn(ctx)
@@ -53,19 +68,113 @@ func TestCheckIn(t *testing.T) {
 				UserID: 1,
 				Date:   time.Date(2025, 6, 8, 9, 0, 0, 0, time.UTC), // Minggu
 			},
-			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {}, // no call
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
 			expectErr:   true,
 			errorString: "cannot check in on weekends",
 		},
+		{
+			name: "rostered weekend check-in",
+			input: entity.CheckIn{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 8, 21, 45, 0, 0, time.UTC), // Minggu
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).
+							Return([]entity.ShiftRoster{{
+								UserID:  1,
+								ShiftID: 3,
+								Shift:   nightShift,
+								Date:    time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC),
+							}}, nil)
+
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
+
+						a.EXPECT().CreateAttendance(gomock.Any(), entity.CreateAttendance{
+							UserID:             1,
+							AttendancePeriodID: 10,
+							Date:               time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC),
+							ShiftID:            pkg.UintPtr(3),
+							CheckInAt:          time.Date(2025, 6, 8, 21, 45, 0, 0, time.UTC),
+							WorkMode:           entity.WorkModeOffice,
+						}).Return(nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "no open attendance period",
+			input: entity.CheckIn{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "no open attendance period",
+		},
+		{
+			name: "period locked for payroll",
+			input: entity.CheckIn{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodLocked}}, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "no open attendance period",
+		},
+		{
+			name: "reopened period accepts check-in",
+			input: entity.CheckIn{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodReopened}}, nil)
+						a.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).Return(nil)
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
 		{
 			name: "failed to get attendance period",
 			input: entity.CheckIn{
 				UserID: 1,
 				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
 			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
 				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
 						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
 							Return(nil, errors.New("get period failed"))
 						return fn(ctx)
@@ -80,11 +189,13 @@ func TestCheckIn(t *testing.T) {
 				UserID: 1,
 				Date:   time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC),
 			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
 				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
 						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
-							Return([]entity.AttendancePeriod{{ID: 11}}, nil)
+							Return([]entity.AttendancePeriod{{ID: 11, Status: entity.AttendancePeriodOpen}}, nil)
 
 						a.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
 							Return(errors.New("insert failed"))
@@ -104,12 +215,14 @@ func TestCheckIn(t *testing.T) {
 
 			mockTx := mockTx.NewMockDomainItf(ctrl)
 			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
 
-			tt.setupMocks(*mockAtt, *mockTx)
+			tt.setupMocks(*mockAtt, *mockShift, *mockTx)
 
 			usecase := uc.InitAttendanceUsecase(uc.Option{
 				AttendanceDom:  mockAtt,
 				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
 			})
 
 			err := usecase.CheckIn(context.Background(), tt.input)
@@ -126,81 +239,77 @@ func TestCheckIn(t *testing.T) {
 	}
 }
 
-func TestCheckOut(t *testing.T) {
+func TestCheckInGeofence(t *testing.T) {
+	// Office at Monas with a 100m radius; the inside point is ~50m away
+	office := entity.OfficeLocation{ID: 4, Name: "HQ", Latitude: -6.175392, Longitude: 106.827153, RadiusMeters: 100, Active: true}
+	inside := &entity.GeoPoint{Latitude: -6.175842, Longitude: 106.827153, AccuracyMeters: 10}
+	outside := &entity.GeoPoint{Latitude: -6.200000, Longitude: 106.816666, AccuracyMeters: 10}
+	checkIn := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name        string
-		input       entity.CheckOut
-		setupMocks  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf)
+		mode        entity.GeofenceMode
+		location    *entity.GeoPoint
+		offices     []entity.OfficeLocation
+		expectSave  bool
 		expectErr   bool
 		errorString string
+		assertSaved func(t *testing.T, data entity.CreateAttendance)
 	}{
 		{
-			name: "success checkout",
-			input: entity.CheckOut{
-				UserID: 1,
-				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{
-							UserID: 1,
-							Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
-						}).Return([]entity.Attendance{{
-							ID:      100,
-							Version: 1,
-						}}, nil)
-
-						a.EXPECT().UpdateAttendance(gomock.Any(), entity.UpdateAttendance{
-							AttendanceID: 100,
-							CheckOutAt:   pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
-							Version:      1,
-						}).Return(nil)
-
-						return fn(ctx)
-					})
+			name:       "inside office is matched",
+			mode:       entity.GeofenceEnforce,
+			location:   inside,
+			offices:    []entity.OfficeLocation{office},
+			expectSave: true,
+			assertSaved: func(t *testing.T, data entity.CreateAttendance) {
+				assert.Equal(t, pkg.UintPtr(4), data.CheckInLocationID)
+				assert.False(t, data.OutsideGeofence)
+				assert.Equal(t, inside, data.CheckInPoint)
 			},
-			expectErr: false,
 		},
 		{
-			name: "get attendance failed",
-			input: entity.CheckOut{
-				UserID: 1,
-				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return(nil, errors.New("get attendance failed"))
-						return fn(ctx)
-					})
+			name:       "outside office is flagged",
+			mode:       entity.GeofenceFlag,
+			location:   outside,
+			offices:    []entity.OfficeLocation{office},
+			expectSave: true,
+			assertSaved: func(t *testing.T, data entity.CreateAttendance) {
+				assert.Nil(t, data.CheckInLocationID)
+				assert.True(t, data.OutsideGeofence)
 			},
+		},
+		{
+			name:        "outside office is rejected",
+			mode:        entity.GeofenceEnforce,
+			location:    outside,
+			offices:     []entity.OfficeLocation{office},
 			expectErr:   true,
-			errorString: "get attendance failed",
+			errorString: "outside of permitted office locations",
 		},
 		{
-			name: "update attendance failed",
-			input: entity.CheckOut{
-				UserID: 1,
-				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return([]entity.Attendance{{
-								ID:      100,
-								Version: 1,
-							}}, nil)
-
-						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
-							Return(errors.New("update failed"))
-						return fn(ctx)
-					})
-			},
+			name:        "inaccurate fix is rejected",
+			mode:        entity.GeofenceEnforce,
+			location:    &entity.GeoPoint{Latitude: inside.Latitude, Longitude: inside.Longitude, AccuracyMeters: 500},
+			offices:     []entity.OfficeLocation{office},
 			expectErr:   true,
-			errorString: "update failed",
+			errorString: "outside of permitted office locations",
+		},
+		{
+			name:        "missing location is rejected",
+			mode:        entity.GeofenceEnforce,
+			offices:     []entity.OfficeLocation{office},
+			expectErr:   true,
+			errorString: "location is required",
+		},
+		{
+			name:       "no office configured skips the check",
+			mode:       entity.GeofenceEnforce,
+			location:   outside,
+			expectSave: true,
+			assertSaved: func(t *testing.T, data entity.CreateAttendance) {
+				assert.False(t, data.OutsideGeofence)
+			},
 		},
 	}
 
@@ -211,21 +320,45 @@ func TestCheckOut(t *testing.T) {
 
 			mockTx := mockTx.NewMockDomainItf(ctrl)
 			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockLocation := mockLocation.NewMockDomainItf(ctrl)
 
-			tt.setupMocks(*mockAtt, *mockTx)
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					mockLocation.EXPECT().GetOfficeLocations(gomock.Any(), entity.GetOfficeLocationFilter{Active: pkg.BoolPtr(true)}).
+						Return(tt.offices, nil)
+
+					if tt.expectSave {
+						mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
+						mockAtt.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, data entity.CreateAttendance) error {
+								tt.assertSaved(t, data)
+								return nil
+							})
+					}
+
+					return fn(ctx)
+				})
 
 			usecase := uc.InitAttendanceUsecase(uc.Option{
 				AttendanceDom:  mockAtt,
 				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+				LocationDom:    mockLocation,
+				GeofenceMode:   tt.mode,
 			})
 
-			err := usecase.CheckOut(context.Background(), tt.input)
+			err := usecase.CheckIn(context.Background(), entity.CheckIn{
+				UserID:   1,
+				Date:     checkIn,
+				Location: tt.location,
+			})
 
 			if tt.expectErr {
 				assert.Error(t, err)
-				if tt.errorString != "" {
-					assert.Contains(t, err.Error(), tt.errorString)
-				}
+				assert.Contains(t, err.Error(), tt.errorString)
 			} else {
 				assert.NoError(t, err)
 			}
@@ -233,117 +366,94 @@ func TestCheckOut(t *testing.T) {
 	}
 }
 
-func TestCreateOvertime(t *testing.T) {
+func TestCheckInWorkMode(t *testing.T) {
+	at := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC) // Selasa
+	monthStart := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
 	tests := []struct {
 		name        string
-		input       entity.CreateOvertimeData
-		setupMocks  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf)
+		input       entity.CheckIn
+		setupMocks  func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf)
+		expectMode  entity.WorkMode
 		expectErr   bool
 		errorString string
 	}{
 		{
-			name: "success create overtime",
-			input: entity.CreateOvertimeData{
-				UserID: 1,
-				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
-				Hours:  2,
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return([]entity.Attendance{{
-								ID:                 1,
-								AttendancePeriodID: 10,
-								CheckedOutAt:       pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
-							}}, nil)
-
-						a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).
-							Return(nil, gorm.ErrRecordNotFound)
-
-						a.EXPECT().CreateOvertime(gomock.Any(), gomock.Any()).
-							Return(nil)
-
-						return fn(ctx)
-					})
+			name:  "work from home within quota",
+			input: entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeWFH},
+			setupMocks: func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{
+					UserID:    1,
+					StartDate: &monthStart,
+					EndDate:   &monthEnd,
+					WorkMode:  entity.WorkModeWFH,
+				}).Return([]entity.Attendance{{ID: 1}}, nil)
 			},
-			expectErr: false,
+			expectMode: entity.WorkModeWFH,
 		},
 		{
-			name: "hours exceed max limit",
-			input: entity.CreateOvertimeData{
-				UserID: 1,
-				Date:   time.Now(),
-				Hours:  4,
+			name:  "work from home quota used up",
+			input: entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeWFH},
+			setupMocks: func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+					Return([]entity.Attendance{{ID: 1}, {ID: 2}}, nil)
 			},
-			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {},
 			expectErr:   true,
-			errorString: "overtime cannot be more than 3 hours per day",
+			errorString: "work-from-home quota of 2 days this month is used up",
 		},
 		{
-			name: "attendance not found",
-			input: entity.CreateOvertimeData{
-				UserID: 2,
-				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
-				Hours:  2,
+			name:  "business trip with approved travel request",
+			input: entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeBusinessTrip},
+			setupMocks: func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), entity.GetTravelRequestFilter{
+					UserID:       1,
+					Status:       entity.TravelStatusApproved,
+					ContainsDate: &at,
+				}).Return([]entity.TravelRequest{{ID: 5}}, nil)
 			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return(nil, errors.New("attendance not found for the date"))
-						return fn(ctx)
-					})
+			expectMode: entity.WorkModeBusinessTrip,
+		},
+		{
+			name:  "business trip without approved travel request",
+			input: entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeBusinessTrip},
+			setupMocks: func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
 			expectErr:   true,
-			errorString: "attendance not found for the date",
+			errorString: "requires an approved travel request",
 		},
 		{
-			name: "not checked out yet",
-			input: entity.CreateOvertimeData{
-				UserID: 3,
-				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
-				Hours:  2,
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return([]entity.Attendance{{
-								ID:           1,
-								CheckedOutAt: nil,
-							}}, nil)
-						return fn(ctx)
-					})
+			name: "client site with location",
+			input: entity.CheckIn{
+				UserID:   1,
+				Date:     at,
+				WorkMode: entity.WorkModeClientSite,
+				Location: &entity.GeoPoint{Latitude: -6.2, Longitude: 106.8, AccuracyMeters: 10},
 			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {},
+			expectMode: entity.WorkModeClientSite,
+		},
+		{
+			name:        "client site without location",
+			input:       entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeClientSite},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {},
 			expectErr:   true,
-			errorString: "must check out before submitting overtime",
+			errorString: "location is required for client site attendance",
 		},
 		{
-			name: "overtime already submitted",
-			input: entity.CreateOvertimeData{
-				UserID: 4,
-				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
-				Hours:  2,
-			},
-			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
-				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
-							Return([]entity.Attendance{{
-								ID:                 1,
-								AttendancePeriodID: 10,
-								CheckedOutAt:       pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
-							}}, nil)
-
-						a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).
-							Return([]entity.Overtime{{ID: 123}}, nil)
-
-						return fn(ctx)
-					})
-			},
+			name:        "kiosk token outside the office",
+			input:       entity.CheckIn{UserID: 1, Date: at, WorkMode: entity.WorkModeWFH, KioskToken: "1.2.3.4"},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {},
 			expectErr:   true,
-			errorString: "overtime already submitted for this date",
+			errorString: "kiosk check-in is only for office attendance",
+		},
+		{
+			name:        "unknown work mode",
+			input:       entity.CheckIn{UserID: 1, Date: at, WorkMode: "beach"},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tr mockTravel.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "invalid work mode",
 		},
 	}
 
@@ -354,15 +464,38 @@ func TestCreateOvertime(t *testing.T) {
 
 			mockTx := mockTx.NewMockDomainItf(ctrl)
 			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockTravel := mockTravel.NewMockDomainItf(ctrl)
 
-			tt.setupMocks(*mockAtt, *mockTx)
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(*mockAtt, *mockTravel)
+
+			if !tt.expectErr {
+				mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+				mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+					Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
+				mockAtt.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.CreateAttendance) error {
+						assert.Equal(t, tt.expectMode, data.WorkMode)
+						assert.Equal(t, tt.input.Location, data.CheckInPoint)
+						assert.Nil(t, data.CheckInLocationID)
+						return nil
+					})
+			}
 
 			usecase := uc.InitAttendanceUsecase(uc.Option{
 				AttendanceDom:  mockAtt,
 				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+				TravelDom:      mockTravel,
+				GeofenceMode:   entity.GeofenceEnforce,
+				WFHQuota:       2,
 			})
 
-			err := usecase.CreateOvertime(context.Background(), tt.input)
+			err := usecase.CheckIn(context.Background(), tt.input)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -376,80 +509,2407 @@ func TestCreateOvertime(t *testing.T) {
 	}
 }
 
-func TestGetOvertime(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestCheckInKiosk(t *testing.T) {
+	kiosk := entity.Kiosk{ID: 3, Name: "Lobby", OfficeLocationID: 4, Secret: "s3cret", Active: true}
+	checkIn := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
 
-	mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+	issue := func(secret string, at time.Time) string {
+		token, _, err := pkg.IssueKioskToken(kiosk.ID, secret, at)
+		assert.NoError(t, err)
+		return token
+	}
 
-	usecase := uc.InitAttendanceUsecase(uc.Option{
-		AttendanceDom: mockAtt,
+	tests := []struct {
+		name        string
+		token       string
+		scanned     int64
+		expectScan  bool
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:       "fresh token places employee at the kiosk office",
+			token:      issue(kiosk.Secret, checkIn),
+			expectScan: true,
+		},
+		{
+			name:       "token from the previous rotation is accepted",
+			token:      issue(kiosk.Secret, checkIn.Add(-pkg.KioskTokenPeriod)),
+			expectScan: true,
+		},
+		{
+			name:        "stale token is rejected",
+			token:       issue(kiosk.Secret, checkIn.Add(-2*pkg.KioskTokenPeriod)),
+			expectErr:   true,
+			errorString: "kiosk token expired",
+		},
+		{
+			name:        "forged token is rejected",
+			token:       issue("guess", checkIn),
+			expectErr:   true,
+			errorString: "invalid kiosk token",
+		},
+		{
+			name:        "replayed token is rejected",
+			token:       issue(kiosk.Secret, checkIn),
+			scanned:     1,
+			expectErr:   true,
+			errorString: "kiosk token already used",
+		},
+		{
+			name:        "malformed token is rejected",
+			token:       "not-a-token",
+			expectErr:   true,
+			errorString: "invalid kiosk token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockKiosk := mockKiosk.NewMockDomainItf(ctrl)
+
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					mockKiosk.EXPECT().GetKiosks(gomock.Any(), entity.GetKioskFilter{ID: kiosk.ID, Active: pkg.BoolPtr(true)}).
+						Return([]entity.Kiosk{kiosk}, nil).AnyTimes()
+					mockKiosk.EXPECT().CountKioskScans(gomock.Any(), gomock.Any()).
+						Return(tt.scanned, nil).AnyTimes()
+
+					if tt.expectScan {
+						mockKiosk.EXPECT().CreateKioskScan(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, data entity.KioskScan) error {
+								assert.Equal(t, uint(1), data.UserID)
+								assert.Equal(t, kiosk.ID, data.KioskID)
+								assert.NotEmpty(t, data.Nonce)
+								return nil
+							})
+						mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
+						mockAtt.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, data entity.CreateAttendance) error {
+								assert.Equal(t, pkg.UintPtr(4), data.CheckInLocationID)
+								assert.Equal(t, pkg.UintPtr(3), data.CheckInKioskID)
+								assert.False(t, data.OutsideGeofence)
+								return nil
+							})
+					}
+
+					return fn(ctx)
+				})
+
+			// Enforced geofencing must not apply to kiosk punches
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+				KioskDom:       mockKiosk,
+				GeofenceMode:   entity.GeofenceEnforce,
+			})
+
+			err := usecase.CheckIn(context.Background(), entity.CheckIn{
+				UserID:     1,
+				Date:       checkIn,
+				KioskToken: tt.token,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// fakeTimeClock stands in for a fingerprint device pushing its punch log.
+type fakeTimeClock struct {
+	clock   entity.TimeClock
+	punches []entity.RawPunch
+}
+
+func (f *fakeTimeClock) punch(code string, at time.Time) *fakeTimeClock {
+	f.punches = append(f.punches, entity.RawPunch{EmployeeCode: code, PunchedAt: at})
+	return f
+}
+
+func (f *fakeTimeClock) push(u uc.UsecaseItf, secret string) (*entity.PunchIngestResult, error) {
+	return u.IngestPunches(context.Background(), entity.IngestPunchesRequest{
+		TimeClockID: f.clock.ID,
+		Secret:      secret,
+		Punches:     f.punches,
+	})
+}
+
+func TestIngestPunches(t *testing.T) {
+	office := uint(4)
+	clock := entity.TimeClock{ID: 2, Name: "Lobby", OfficeLocationID: &office, Secret: "s3cret", Active: true}
+	employees := map[string]uint{"00042": 1}
+	tuesday := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	at := func(hour, min int) time.Time {
+		return tuesday.Add(time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute)
+	}
+
+	tests := []struct {
+		name         string
+		device       func() *fakeTimeClock
+		secret       string
+		received     []entity.TimeClockPunch // punches stored by an earlier push
+		attendances  []entity.Attendance
+		expect       entity.PunchIngestResult
+		expectErr    bool
+		errorString  string
+		assertResult func(t *testing.T, atts []entity.Attendance, stored []entity.TimeClockPunch)
+	}{
+		{
+			name: "day punches become a check-in and check-out",
+			device: func() *fakeTimeClock {
+				// Sent out of order, as devices flush their buffer
+				return (&fakeTimeClock{clock: clock}).punch("00042", at(17, 5)).punch("00042", at(8, 55))
+			},
+			secret: clock.Secret,
+			expect: entity.PunchIngestResult{Received: 2, Applied: 2},
+			assertResult: func(t *testing.T, atts []entity.Attendance, stored []entity.TimeClockPunch) {
+				assert.Len(t, atts, 1)
+				assert.Equal(t, at(8, 55), *atts[0].CheckedInAt)
+				assert.Equal(t, at(17, 5), *atts[0].CheckedOutAt)
+				assert.Equal(t, &office, atts[0].CheckInLocationID)
+				assert.Equal(t, entity.PunchApplied, stored[0].Status)
+			},
+		},
+		{
+			name: "resent punches are skipped",
+			device: func() *fakeTimeClock {
+				return (&fakeTimeClock{clock: clock}).punch("00042", at(8, 55)).punch("00042", at(17, 5))
+			},
+			secret:   clock.Secret,
+			received: []entity.TimeClockPunch{{TimeClockID: clock.ID, EmployeeCode: "00042", PunchedAt: at(8, 55), Status: entity.PunchApplied}},
+			attendances: []entity.Attendance{
+				{ID: 1, UserID: 1, Date: tuesday, CheckedInAt: pkg.TimePtr(at(8, 55)), Version: 1},
+			},
+			expect: entity.PunchIngestResult{Received: 2, Applied: 1, Duplicates: 1},
+			assertResult: func(t *testing.T, atts []entity.Attendance, stored []entity.TimeClockPunch) {
+				assert.Equal(t, at(17, 5), *atts[0].CheckedOutAt)
+				assert.Len(t, stored, 2)
+			},
+		},
+		{
+			name: "unknown employee code is kept for reconciliation",
+			device: func() *fakeTimeClock {
+				return (&fakeTimeClock{clock: clock}).punch("99999", at(8, 55))
+			},
+			secret: clock.Secret,
+			expect: entity.PunchIngestResult{Received: 1, Unmapped: 1},
+			assertResult: func(t *testing.T, atts []entity.Attendance, stored []entity.TimeClockPunch) {
+				assert.Empty(t, atts)
+				assert.Equal(t, entity.PunchUnmapped, stored[0].Status)
+				assert.Nil(t, stored[0].UserID)
+			},
+		},
+		{
+			name: "extra punch after check-out is unpaired",
+			device: func() *fakeTimeClock {
+				return (&fakeTimeClock{clock: clock}).punch("00042", at(8, 55)).punch("00042", at(17, 5)).punch("00042", at(17, 6))
+			},
+			secret: clock.Secret,
+			expect: entity.PunchIngestResult{Received: 3, Applied: 2, Unpaired: 1},
+			assertResult: func(t *testing.T, atts []entity.Attendance, stored []entity.TimeClockPunch) {
+				assert.Equal(t, at(17, 5), *atts[0].CheckedOutAt)
+				assert.Equal(t, entity.PunchUnpaired, stored[2].Status)
+				assert.Equal(t, "attendance is already checked out", stored[2].Note)
+			},
+		},
+		{
+			name: "weekend punch without a roster is unpaired",
+			device: func() *fakeTimeClock {
+				return (&fakeTimeClock{clock: clock}).punch("00042", tuesday.AddDate(0, 0, 4).Add(9*time.Hour))
+			},
+			secret: clock.Secret,
+			expect: entity.PunchIngestResult{Received: 1, Unpaired: 1},
+		},
+		{
+			name: "wrong secret is rejected",
+			device: func() *fakeTimeClock {
+				return (&fakeTimeClock{clock: clock}).punch("00042", at(8, 55))
+			},
+			secret:      "guess",
+			expectErr:   true,
+			errorString: "invalid time clock credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockTimeClock := mockTimeClock.NewMockDomainItf(ctrl)
+
+			// No breaks are recorded on these attendances
+			mockAtt.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			stored := append([]entity.TimeClockPunch{}, tt.received...)
+			atts := append([]entity.Attendance{}, tt.attendances...)
+
+			mockTimeClock.EXPECT().GetTimeClocks(gomock.Any(), entity.GetTimeClockFilter{ID: clock.ID, Active: pkg.BoolPtr(true)}).
+				Return([]entity.TimeClock{clock}, nil)
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+
+			mockTimeClock.EXPECT().CountTimeClockPunches(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetTimeClockPunchFilter) (int64, error) {
+					var count int64
+					for _, p := range stored {
+						if p.EmployeeCode == filter.EmployeeCode && p.PunchedAt.Equal(*filter.PunchedAt) {
+							count++
+						}
+					}
+					return count, nil
+				}).AnyTimes()
+			mockTimeClock.EXPECT().CreateTimeClockPunch(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.TimeClockPunch) error {
+					stored = append(stored, data)
+					return nil
+				}).AnyTimes()
+			mockTimeClock.EXPECT().GetTimeClockEmployees(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetTimeClockEmployeeFilter) ([]entity.TimeClockEmployee, error) {
+					if userID, ok := employees[filter.EmployeeCode]; ok {
+						return []entity.TimeClockEmployee{{EmployeeCode: filter.EmployeeCode, UserID: userID}}, nil
+					}
+					return nil, nil
+				}).AnyTimes()
+
+			mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil).AnyTimes()
+			mockAtt.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetAttendance) ([]entity.Attendance, error) {
+					var result []entity.Attendance
+					for _, a := range atts {
+						if a.UserID == filter.UserID && a.Date.Equal(filter.Date.Truncate(24*time.Hour)) {
+							result = append(result, a)
+						}
+					}
+					return result, nil
+				}).AnyTimes()
+			mockAtt.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.CreateAttendance) error {
+					atts = append(atts, entity.Attendance{
+						ID:                uint(len(atts) + 1),
+						UserID:            data.UserID,
+						Date:              data.CheckInAt.Truncate(24 * time.Hour),
+						CheckedInAt:       pkg.TimePtr(data.CheckInAt),
+						CheckInLocationID: data.CheckInLocationID,
+						Version:           1,
+					})
+					return nil
+				}).AnyTimes()
+			mockAtt.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.UpdateAttendance) error {
+					atts[data.AttendanceID-1].CheckedOutAt = data.CheckOutAt
+					return nil
+				}).AnyTimes()
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+				TimeClockDom:   mockTimeClock,
+			})
+
+			result, err := tt.device().push(usecase, tt.secret)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, *result)
+			if tt.assertResult != nil {
+				tt.assertResult(t, atts, stored)
+			}
+		})
+	}
+}
+
+func TestSyncOfflineEvents(t *testing.T) {
+	device := entity.MobileDevice{ID: 5, UserID: 1, Name: "Pixel", Secret: "s3cret", Active: true}
+
+	// Most recent weekday before today, so the events are in the past and on a workday
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		day = day.AddDate(0, 0, -1)
+	}
+
+	event := func(clientID string, eventType entity.SyncEventType, hour int) entity.OfflineEvent {
+		recordedAt := day.Add(time.Duration(hour) * time.Hour)
+		return entity.OfflineEvent{
+			ClientID:   clientID,
+			Type:       eventType,
+			RecordedAt: recordedAt,
+			Signature:  pkg.SignSyncEvent(pkg.SyncEventPayload(clientID, string(eventType), recordedAt, nil, nil), device.Secret),
+		}
+	}
+
+	forged := event("c", entity.SyncCheckIn, 9)
+	forged.RecordedAt = forged.RecordedAt.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		events      []entity.OfflineEvent
+		deviceTime  time.Time
+		devices     []entity.MobileDevice
+		synced      []string // client IDs applied by an earlier sync
+		racing      []string // client IDs stored by a concurrent sync after the check
+		attendances []entity.Attendance
+		expect      []entity.SyncEventResult
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:       "events are applied in recorded order",
+			events:     []entity.OfflineEvent{event("b", entity.SyncCheckOut, 17), event("a", entity.SyncCheckIn, 9)},
+			deviceTime: time.Now(),
+			devices:    []entity.MobileDevice{device},
+			expect: []entity.SyncEventResult{
+				{ClientID: "a", Status: entity.SyncApplied},
+				{ClientID: "b", Status: entity.SyncApplied},
+			},
+		},
+		{
+			name:       "resent event is reported as duplicate",
+			events:     []entity.OfflineEvent{event("a", entity.SyncCheckIn, 9), event("b", entity.SyncCheckOut, 17)},
+			deviceTime: time.Now(),
+			devices:    []entity.MobileDevice{device},
+			synced:     []string{"a"},
+			attendances: []entity.Attendance{
+				{ID: 1, UserID: 1, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9 * time.Hour)), Version: 1},
+			},
+			expect: []entity.SyncEventResult{
+				{ClientID: "a", Status: entity.SyncDuplicate},
+				{ClientID: "b", Status: entity.SyncApplied},
+			},
+		},
+		{
+			name:       "event stored by a concurrent sync is reported as duplicate",
+			events:     []entity.OfflineEvent{event("a", entity.SyncCheckIn, 9)},
+			deviceTime: time.Now(),
+			devices:    []entity.MobileDevice{device},
+			racing:     []string{"a"},
+			expect: []entity.SyncEventResult{
+				{ClientID: "a", Status: entity.SyncDuplicate},
+			},
+		},
+		{
+			name:       "tampered event is rejected without stopping the batch",
+			events:     []entity.OfflineEvent{forged, event("d", entity.SyncCheckOut, 17)},
+			deviceTime: time.Now(),
+			devices:    []entity.MobileDevice{device},
+			expect: []entity.SyncEventResult{
+				{ClientID: "c", Status: entity.SyncRejected, Message: "invalid event signature"},
+				{ClientID: "d", Status: entity.SyncRejected, Message: "attendance not found"},
+			},
+		},
+		{
+			name:        "skewed device clock is refused",
+			events:      []entity.OfflineEvent{event("a", entity.SyncCheckIn, 9)},
+			deviceTime:  time.Now().Add(-time.Hour),
+			devices:     []entity.MobileDevice{device},
+			expectErr:   true,
+			errorString: "device clock is out of sync",
+		},
+		{
+			name:        "revoked device is refused",
+			events:      []entity.OfflineEvent{event("a", entity.SyncCheckIn, 9)},
+			deviceTime:  time.Now(),
+			expectErr:   true,
+			errorString: "unknown or revoked device",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockDevice := mockDevice.NewMockDomainItf(ctrl)
+
+			// No breaks are recorded on these attendances
+			mockAtt.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+
+			synced := append([]string{}, tt.synced...)
+			atts := append([]entity.Attendance{}, tt.attendances...)
+
+			mockDevice.EXPECT().GetDevices(gomock.Any(), entity.GetDeviceFilter{ID: device.ID, UserID: 1, Active: pkg.BoolPtr(true)}).
+				Return(tt.devices, nil)
+			mockDevice.EXPECT().GetSyncEvents(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetSyncEventFilter) ([]entity.SyncEvent, error) {
+					for _, id := range synced {
+						if id == filter.ClientID {
+							return []entity.SyncEvent{{DeviceID: filter.DeviceID, ClientID: id}}, nil
+						}
+					}
+					return nil, nil
+				}).AnyTimes()
+			mockDevice.EXPECT().CreateSyncEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.SyncEvent) error {
+					for _, id := range tt.racing {
+						if id == data.ClientID {
+							return x.NewWithCode(http.StatusConflict, "sync event was already applied")
+						}
+					}
+					synced = append(synced, data.ClientID)
+					return nil
+				}).AnyTimes()
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+
+			mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil).AnyTimes()
+			mockAtt.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetAttendance) ([]entity.Attendance, error) {
+					var result []entity.Attendance
+					for _, a := range atts {
+						if a.UserID == filter.UserID && a.Date.Equal(filter.Date.Truncate(24*time.Hour)) {
+							result = append(result, a)
+						}
+					}
+					return result, nil
+				}).AnyTimes()
+			mockAtt.EXPECT().CreateAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.CreateAttendance) error {
+					atts = append(atts, entity.Attendance{
+						ID:          uint(len(atts) + 1),
+						UserID:      data.UserID,
+						Date:        data.CheckInAt.Truncate(24 * time.Hour),
+						CheckedInAt: pkg.TimePtr(data.CheckInAt),
+						Version:     1,
+					})
+					return nil
+				}).AnyTimes()
+			mockAtt.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.UpdateAttendance) error {
+					atts[data.AttendanceID-1].CheckedOutAt = data.CheckOutAt
+					return nil
+				}).AnyTimes()
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+				DeviceDom:      mockDevice,
+			})
+
+			results, err := usecase.SyncOfflineEvents(context.Background(), entity.SyncAttendanceRequest{
+				UserID:     1,
+				DeviceID:   device.ID,
+				DeviceTime: tt.deviceTime,
+				Events:     tt.events,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, results)
+		})
+	}
+}
+
+func TestAutoCloseAttendances(t *testing.T) {
+	day := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, -3)
+	shiftEnd := day.Add(17 * time.Hour)
+
+	tests := []struct {
+		name         string
+		cutoff       time.Duration
+		open         []entity.Attendance
+		periodStatus string
+		updateErr    error
+		expectClosed int
+		expectErr    bool
+		assertUpdate func(t *testing.T, data entity.UpdateAttendance)
+	}{
+		{
+			name:         "closed at the shift end",
+			cutoff:       4 * time.Hour,
+			open:         []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9*time.Hour + 10*time.Minute)), Version: 2}},
+			expectClosed: 1,
+			assertUpdate: func(t *testing.T, data entity.UpdateAttendance) {
+				assert.Equal(t, shiftEnd, *data.CheckOutAt)
+				assert.Equal(t, pkg.BoolPtr(true), data.AutoClosed)
+				assert.Equal(t, uint(2), data.Version)
+				assert.Equal(t, 10, *data.LateMinutes)
+				assert.Equal(t, 0, *data.EarlyLeaveMinutes)
+			},
+		},
+		{
+			name:         "check-in after the shift end closes with no worked time",
+			cutoff:       4 * time.Hour,
+			open:         []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(20 * time.Hour)), Version: 1}},
+			expectClosed: 1,
+			assertUpdate: func(t *testing.T, data entity.UpdateAttendance) {
+				assert.Equal(t, day.Add(20*time.Hour), *data.CheckOutAt)
+				assert.Equal(t, 0, *data.WorkedMinutes)
+			},
+		},
+		{
+			name:         "not past the cutoff yet",
+			cutoff:       7 * 24 * time.Hour,
+			open:         []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9 * time.Hour)), Version: 1}},
+			expectClosed: 0,
+		},
+		{
+			name:         "period locked for payroll is left alone",
+			cutoff:       4 * time.Hour,
+			open:         []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9 * time.Hour)), Version: 1}},
+			periodStatus: entity.AttendancePeriodLocked,
+			assertUpdate: func(t *testing.T, data entity.UpdateAttendance) {
+				t.Error("attendance in a locked period was closed")
+			},
+			expectClosed: 0,
+		},
+		{
+			name:         "checked out in the meantime",
+			cutoff:       4 * time.Hour,
+			open:         []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9 * time.Hour)), Version: 1}},
+			updateErr:    x.NewWithCode(http.StatusConflict, "attendance was updated by someone else, please retry"),
+			expectClosed: 0,
+		},
+		{
+			name:      "update failure stops the run",
+			cutoff:    4 * time.Hour,
+			open:      []entity.Attendance{{ID: 1, UserID: 7, Date: day, CheckedInAt: pkg.TimePtr(day.Add(9 * time.Hour)), Version: 1}},
+			updateErr: errors.New("db error"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockNotification := mockNotification.NewMockDomainItf(ctrl)
+
+			// No breaks are recorded on these attendances
+			mockAtt.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			status := tt.periodStatus
+			if status == "" {
+				status = entity.AttendancePeriodOpen
+			}
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: status}}, nil).AnyTimes()
+
+			mockAtt.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter entity.GetAttendance) ([]entity.Attendance, error) {
+					assert.True(t, filter.Open)
+					assert.NotNil(t, filter.CheckedInBefore)
+					return tt.open, nil
+				})
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			mockAtt.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.UpdateAttendance) error {
+					if tt.assertUpdate != nil {
+						tt.assertUpdate(t, data)
+					}
+					return tt.updateErr
+				}).AnyTimes()
+			mockNotification.EXPECT().CreateNotification(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, data entity.Notification) error {
+					assert.Equal(t, uint(7), data.UserID)
+					assert.Equal(t, entity.NotifyAttendanceAutoClosed, data.Type)
+					assert.Contains(t, data.Message, day.Format("2006-01-02"))
+					return nil
+				}).Times(tt.expectClosed)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:   mockAtt,
+				TransactionDom:  mockTx,
+				NotificationDom: mockNotification,
+			})
+
+			closed, err := usecase.AutoCloseAttendances(context.Background(), tt.cutoff)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectClosed, closed)
+			}
+		})
+	}
+}
+
+func TestCheckOut(t *testing.T) {
+	nightShift := entity.Shift{ID: 3, Name: "Night", StartTime: "22:00", EndTime: "06:00", CrossesMidnight: true}
+
+	tests := []struct {
+		name         string
+		input        entity.CheckOut
+		setupMocks   func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf)
+		periodStatus string
+		expectErr    bool
+		errorString  string
+	}{
+		{
+			name: "success checkout",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{
+							UserID: 1,
+							Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+						}).Return([]entity.Attendance{{
+							ID:          100,
+							Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+							CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 9, 10, 0, 0, time.UTC)),
+							Version:     1,
+						}}, nil)
+
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), entity.GetAttendanceBreakFilter{AttendanceID: 100}).
+							Return([]entity.AttendanceBreak{{
+								ID:        1,
+								StartedAt: time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC),
+								EndedAt:   pkg.TimePtr(time.Date(2025, 6, 10, 12, 45, 0, 0, time.UTC)),
+							}}, nil)
+
+						// Default 09:00-17:00 day, the 45 minute break still costs the scheduled 60
+						a.EXPECT().UpdateAttendance(gomock.Any(), entity.UpdateAttendance{
+							AttendanceID:      100,
+							CheckOutAt:        pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
+							WorkedMinutes:     pkg.IntPtr(410),
+							BreakMinutes:      pkg.IntPtr(45),
+							LateMinutes:       pkg.IntPtr(10),
+							EarlyLeaveMinutes: pkg.IntPtr(0),
+							Version:           1,
+						}).Return(nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "checkout of night shift after midnight",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 11, 6, 5, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).
+							Return([]entity.ShiftRoster{{
+								UserID:  1,
+								ShiftID: 3,
+								Shift:   nightShift,
+								Date:    time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+							}}, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{
+							UserID: 1,
+							Date:   time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+						}).Return([]entity.Attendance{{
+							ID:          200,
+							Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+							ShiftID:     pkg.UintPtr(3),
+							CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 21, 50, 0, 0, time.UTC)),
+							Version:     2,
+						}}, nil)
+
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), entity.UpdateAttendance{
+							AttendanceID:      200,
+							CheckOutAt:        pkg.TimePtr(time.Date(2025, 6, 11, 6, 5, 0, 0, time.UTC)),
+							WorkedMinutes:     pkg.IntPtr(495),
+							BreakMinutes:      pkg.IntPtr(0),
+							LateMinutes:       pkg.IntPtr(0),
+							EarlyLeaveMinutes: pkg.IntPtr(0),
+							Version:           2,
+						}).Return(nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "early leave from rostered shift",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 15, 30, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:          300,
+								Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+								ShiftID:     pkg.UintPtr(4),
+								CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 8, 0, 0, 0, time.UTC)),
+								Version:     1,
+							}}, nil)
+
+						s.EXPECT().GetShifts(gomock.Any(), entity.GetShiftFilter{ID: 4}).
+							Return([]entity.Shift{{ID: 4, StartTime: "08:00", EndTime: "16:00", BreakMinutes: 30}}, nil)
+
+						// The whole 50 minute break goes unpaid, not just the scheduled 30
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceBreak{{
+								ID:        1,
+								StartedAt: time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC),
+								EndedAt:   pkg.TimePtr(time.Date(2025, 6, 10, 12, 50, 0, 0, time.UTC)),
+							}}, nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, u entity.UpdateAttendance) error {
+								assert.Equal(t, 400, *u.WorkedMinutes)
+								assert.Equal(t, 50, *u.BreakMinutes)
+								assert.Equal(t, 0, *u.LateMinutes)
+								assert.Equal(t, 30, *u.EarlyLeaveMinutes)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "break in progress is ended at check-out",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:          100,
+								Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+								CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+								Version:     1,
+							}}, nil)
+
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceBreak{{
+								ID:        7,
+								StartedAt: time.Date(2025, 6, 10, 16, 30, 0, 0, time.UTC),
+							}}, nil)
+
+						a.EXPECT().UpdateAttendanceBreak(gomock.Any(), entity.UpdateAttendanceBreak{
+							ID:      7,
+							EndedAt: time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+						}).Return(nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, u entity.UpdateAttendance) error {
+								assert.Equal(t, 420, *u.WorkedMinutes)
+								assert.Equal(t, 30, *u.BreakMinutes)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "scheduled break is unpaid without break punches",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:          100,
+								Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+								CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+								Version:     1,
+							}}, nil)
+
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, u entity.UpdateAttendance) error {
+								assert.Equal(t, 420, *u.WorkedMinutes)
+								assert.Equal(t, 0, *u.BreakMinutes)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "period locked for payroll",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:                 100,
+								AttendancePeriodID: 10,
+								CheckedInAt:        pkg.TimePtr(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+							}}, nil)
+						return fn(ctx)
+					})
+			},
+			periodStatus: entity.AttendancePeriodLocked,
+			expectErr:    true,
+			errorString:  "attendance period is locked",
+		},
+		{
+			name: "attendance not found",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "attendance not found",
+		},
+		{
+			name: "get attendance failed",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return(nil, errors.New("get attendance failed"))
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "get attendance failed",
+		},
+		{
+			name: "update attendance failed",
+			input: entity.CheckOut{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC),
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:      100,
+								Version: 1,
+							}}, nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+							Return(errors.New("update failed"))
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "update failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockAtt, *mockShift, *mockTx)
+			status := tt.periodStatus
+			if status == "" {
+				status = entity.AttendancePeriodOpen
+			}
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: status}}, nil).AnyTimes()
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+			})
+
+			err := usecase.CheckOut(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStartBreak(t *testing.T) {
+	at := time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)
+	checkedIn := entity.Attendance{
+		ID:          100,
+		Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name         string
+		setupMocks   func(a mockAttendance.MockDomainItf)
+		periodStatus string
+		expectErr    bool
+		errorString  string
+	}{
+		{
+			name: "success start break",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+				a.EXPECT().GetAttendanceBreaks(gomock.Any(), entity.GetAttendanceBreakFilter{AttendanceID: 100, Open: true}).
+					Return(nil, nil)
+				a.EXPECT().CreateAttendanceBreak(gomock.Any(), entity.AttendanceBreak{
+					AttendanceID: 100,
+					UserID:       1,
+					StartedAt:    at,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "break already in progress",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+				a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).
+					Return([]entity.AttendanceBreak{{ID: 4, AttendanceID: 100, StartedAt: at.Add(-10 * time.Minute)}}, nil)
+			},
+			expectErr:   true,
+			errorString: "a break is already in progress",
+		},
+		{
+			name: "period locked for payroll",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+			},
+			periodStatus: entity.AttendancePeriodPayrollProcessing,
+			expectErr:    true,
+			errorString:  "attendance period is payroll_processing",
+		},
+		{
+			name: "not checked in",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "you are not checked in",
+		},
+		{
+			name: "already checked out",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				checkedOut := checkedIn
+				checkedOut.CheckedOutAt = pkg.TimePtr(at.Add(-time.Hour))
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedOut}, nil)
+			},
+			expectErr:   true,
+			errorString: "attendance already has a check-out",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+			tt.setupMocks(*mockAtt)
+			status := tt.periodStatus
+			if status == "" {
+				status = entity.AttendancePeriodOpen
+			}
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: status}}, nil).AnyTimes()
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+			})
+
+			err := usecase.StartBreak(context.Background(), entity.StartBreak{UserID: 1, Date: at})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEndBreak(t *testing.T) {
+	at := time.Date(2025, 6, 10, 12, 45, 0, 0, time.UTC)
+	checkedIn := entity.Attendance{
+		ID:          100,
+		Date:        time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		CheckedInAt: pkg.TimePtr(time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)),
+	}
+
+	tests := []struct {
+		name         string
+		setupMocks   func(a mockAttendance.MockDomainItf)
+		periodStatus string
+		expectErr    bool
+		errorString  string
+	}{
+		{
+			name: "success end break",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+				a.EXPECT().GetAttendanceBreaks(gomock.Any(), entity.GetAttendanceBreakFilter{AttendanceID: 100, Open: true}).
+					Return([]entity.AttendanceBreak{{ID: 4, AttendanceID: 100, StartedAt: time.Date(2025, 6, 10, 12, 0, 0, 0, time.UTC)}}, nil)
+				a.EXPECT().UpdateAttendanceBreak(gomock.Any(), entity.UpdateAttendanceBreak{ID: 4, EndedAt: at}).Return(nil)
+			},
+		},
+		{
+			name: "period locked for payroll",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+			},
+			periodStatus: entity.AttendancePeriodPayrollProcessing,
+			expectErr:    true,
+			errorString:  "attendance period is payroll_processing",
+		},
+		{
+			name: "no break in progress",
+			setupMocks: func(a mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return([]entity.Attendance{checkedIn}, nil)
+				a.EXPECT().GetAttendanceBreaks(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "no break in progress",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			mockShift.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+			tt.setupMocks(*mockAtt)
+			status := tt.periodStatus
+			if status == "" {
+				status = entity.AttendancePeriodOpen
+			}
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+				Return([]entity.AttendancePeriod{{ID: 10, Status: status}}, nil).AnyTimes()
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+			})
+
+			err := usecase.EndBreak(context.Background(), entity.EndBreak{UserID: 1, Date: at})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateOvertime(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.CreateOvertimeData
+		setupMocks  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success create overtime",
+			input: entity.CreateOvertimeData{
+				UserID: 1,
+				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
+				Hours:  2,
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:                 1,
+								AttendancePeriodID: 10,
+								CheckedOutAt:       pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
+							}}, nil)
+
+						a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).
+							Return(nil, gorm.ErrRecordNotFound)
+
+						a.EXPECT().CreateOvertime(gomock.Any(), gomock.Any()).
+							Return(nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "hours exceed max limit",
+			input: entity.CreateOvertimeData{
+				UserID: 1,
+				Date:   time.Now(),
+				Hours:  4,
+			},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "overtime cannot be more than 3 hours per day",
+		},
+		{
+			name: "attendance not found",
+			input: entity.CreateOvertimeData{
+				UserID: 2,
+				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
+				Hours:  2,
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return(nil, errors.New("attendance not found for the date"))
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "attendance not found for the date",
+		},
+		{
+			name: "not checked out yet",
+			input: entity.CreateOvertimeData{
+				UserID: 3,
+				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
+				Hours:  2,
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:           1,
+								CheckedOutAt: nil,
+							}}, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "must check out before submitting overtime",
+		},
+		{
+			name: "overtime already submitted",
+			input: entity.CreateOvertimeData{
+				UserID: 4,
+				Date:   time.Date(2025, 6, 10, 18, 0, 0, 0, time.UTC),
+				Hours:  2,
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{
+								ID:                 1,
+								AttendancePeriodID: 10,
+								CheckedOutAt:       pkg.TimePtr(time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)),
+							}}, nil)
+
+						a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).
+							Return([]entity.Overtime{{ID: 123}}, nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "overtime already submitted for this date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockAtt, *mockTx)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+			})
+
+			err := usecase.CreateOvertime(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetOvertime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitAttendanceUsecase(uc.Option{
+		AttendanceDom: mockAtt,
+	})
+
+	filter := entity.GetOvertimeFilter{
+		UserID: 1,
+		Date:   time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success get overtime", func(t *testing.T) {
+		expected := []entity.Overtime{
+			{ID: 1, UserID: 1, Date: filter.Date, Hours: 2},
+		}
+
+		mockAtt.EXPECT().GetOvertime(gomock.Any(), filter).Return(expected, nil)
+
+		result, err := usecase.GetOvertime(context.Background(), filter)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("failed to get overtime", func(t *testing.T) {
+		mockAtt.EXPECT().GetOvertime(gomock.Any(), filter).
+			Return(nil, errors.New("database error"))
+
+		result, err := usecase.GetOvertime(context.Background(), filter)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Contains(t, err.Error(), "database error")
+	})
+}
+
+func TestCreateAttendancePeriod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitAttendanceUsecase(uc.Option{
+		AttendanceDom: mockAtt,
+	})
+
+	req := entity.CreateAttendancePeriodRequest{
+		StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("success create attendance period", func(t *testing.T) {
+		mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{
+			OverlapStart: &req.StartDate,
+			OverlapEnd:   &req.EndDate,
+		}).Return(nil, nil)
+		mockAtt.EXPECT().
+			CreateAttendancePeriod(gomock.Any(), gomock.AssignableToTypeOf(entity.AttendancePeriod{})).
+			DoAndReturn(func(_ context.Context, p entity.AttendancePeriod) error {
+				assert.Equal(t, req.StartDate, p.StartDate)
+				assert.Equal(t, req.EndDate, p.EndDate)
+				assert.Equal(t, "open", p.Status)
+				return nil
+			})
+
+		err := usecase.CreateAttendancePeriod(context.Background(), req)
+		assert.NoError(t, err)
 	})
 
-	filter := entity.GetOvertimeFilter{
-		UserID: 1,
-		Date:   time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+	t.Run("failed create attendance period", func(t *testing.T) {
+		mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockAtt.EXPECT().
+			CreateAttendancePeriod(gomock.Any(), gomock.Any()).
+			Return(errors.New("insert failed"))
+
+		err := usecase.CreateAttendancePeriod(context.Background(), req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to create attendance period")
+	})
+
+	t.Run("overlaps another period", func(t *testing.T) {
+		mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+			Return([]entity.AttendancePeriod{{ID: 3, StartDate: req.StartDate.AddDate(0, 0, -5), EndDate: req.StartDate}}, nil)
+
+		err := usecase.CreateAttendancePeriod(context.Background(), req)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "dates overlap attendance period 3 (2025-05-27 to 2025-06-01)")
+	})
+
+	t.Run("start after end", func(t *testing.T) {
+		err := usecase.CreateAttendancePeriod(context.Background(), entity.CreateAttendancePeriodRequest{
+			StartDate: req.EndDate,
+			EndDate:   req.StartDate,
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "start date cannot be after end date")
+	})
+}
+
+func TestSubmitCorrection(t *testing.T) {
+	date := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	checkIn := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.SubmitAttendanceCorrection
+		setupMocks  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success missing check-out",
+			input: entity.SubmitAttendanceCorrection{
+				UserID:     1,
+				Date:       date,
+				Type:       entity.CorrectionMissingCheckOut,
+				CheckOutAt: &checkOut,
+				Reason:     "forgot to check out",
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return(nil, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{UserID: 1, Date: date}).
+							Return([]entity.Attendance{{ID: 7, CheckedInAt: &checkIn}}, nil)
+
+						a.EXPECT().CreateAttendanceCorrection(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, c entity.AttendanceCorrection) error {
+								assert.Equal(t, uint(7), *c.AttendanceID)
+								assert.Equal(t, entity.CorrectionStatusPending, c.Status)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "missing reason",
+			input: entity.SubmitAttendanceCorrection{
+				UserID: 1,
+				Date:   date,
+				Type:   entity.CorrectionMissingCheckIn,
+			},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "reason is required",
+		},
+		{
+			name: "invalid type",
+			input: entity.SubmitAttendanceCorrection{
+				UserID: 1,
+				Date:   date,
+				Type:   "unknown",
+				Reason: "oops",
+			},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "invalid correction type",
+		},
+		{
+			name: "check-in on another day",
+			input: entity.SubmitAttendanceCorrection{
+				UserID:    1,
+				Date:      date.AddDate(0, 0, 1),
+				Type:      entity.CorrectionMissingCheckIn,
+				CheckInAt: &checkIn,
+				Reason:    "forgot to check in",
+			},
+			setupMocks:  func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "must be on the corrected date",
+		},
+		{
+			name: "pending correction exists",
+			input: entity.SubmitAttendanceCorrection{
+				UserID:    1,
+				Date:      date,
+				Type:      entity.CorrectionMissingCheckIn,
+				CheckInAt: &checkIn,
+				Reason:    "forgot to check in",
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{ID: 3}}, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "already pending",
+		},
+		{
+			name: "missing check-in but attendance exists",
+			input: entity.SubmitAttendanceCorrection{
+				UserID:    1,
+				Date:      date,
+				Type:      entity.CorrectionMissingCheckIn,
+				CheckInAt: &checkIn,
+				Reason:    "forgot to check in",
+			},
+			setupMocks: func(a mockAttendance.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return(nil, nil)
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{ID: 7}}, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "attendance already exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockAtt, *mockTx)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+			})
+
+			err := usecase.SubmitCorrection(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReviewCorrection(t *testing.T) {
+	date := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	checkIn := time.Date(2025, 6, 10, 9, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2025, 6, 10, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.ReviewAttendanceCorrection
+		setupMocks  func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "approve missing check-out updates attendance",
+			input: entity.ReviewAttendanceCorrection{ID: 1, ReviewerID: 99, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), entity.GetAttendanceCorrectionFilter{ID: 1}).
+							Return([]entity.AttendanceCorrection{{
+								ID: 1, UserID: 5, Date: date, Type: entity.CorrectionMissingCheckOut,
+								RequestedCheckOutAt: &checkOut, Status: entity.CorrectionStatusPending,
+							}}, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{UserID: 5, Date: date}).
+							Return([]entity.Attendance{{ID: 7, AttendancePeriodID: 10, Date: date, CheckedInAt: &checkIn, Version: 2}}, nil)
+
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{ID: "10"}).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodReopened}}, nil)
+
+						// The lunch break was never ended, it stops at the corrected check-out
+						a.EXPECT().GetAttendanceBreaks(gomock.Any(), entity.GetAttendanceBreakFilter{AttendanceID: 7}).
+							Return([]entity.AttendanceBreak{{ID: 3, StartedAt: time.Date(2025, 6, 10, 16, 0, 0, 0, time.UTC)}}, nil)
+						a.EXPECT().UpdateAttendanceBreak(gomock.Any(), entity.UpdateAttendanceBreak{ID: 3, EndedAt: checkOut}).
+							Return(nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), entity.UpdateAttendance{
+							AttendanceID:      7,
+							CheckOutAt:        &checkOut,
+							WorkedMinutes:     pkg.IntPtr(420),
+							BreakMinutes:      pkg.IntPtr(60),
+							LateMinutes:       pkg.IntPtr(0),
+							EarlyLeaveMinutes: pkg.IntPtr(0),
+							Version:           2,
+						}).Return(nil)
+
+						a.EXPECT().UpdateAttendanceCorrection(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, u entity.UpdateAttendanceCorrection) error {
+								assert.Equal(t, entity.CorrectionStatusApproved, u.Status)
+								assert.Equal(t, uint(99), u.ReviewedBy)
+								assert.Equal(t, &checkIn, u.OriginalCheckInAt)
+								assert.Nil(t, u.OriginalCheckOutAt)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:  "approve missing check-in creates attendance",
+			input: entity.ReviewAttendanceCorrection{ID: 2, ReviewerID: 99, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{
+								ID: 2, UserID: 5, Date: date, Type: entity.CorrectionMissingCheckIn,
+								RequestedCheckInAt: &checkIn, RequestedCheckOutAt: &checkOut,
+								Status: entity.CorrectionStatusPending,
+							}}, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10}}, nil)
+
+						s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+						a.EXPECT().CreateAttendance(gomock.Any(), entity.CreateAttendance{
+							UserID:             5,
+							AttendancePeriodID: 10,
+							CheckInAt:          checkIn,
+							CheckOutAt:         &checkOut,
+							WorkedMinutes:      420,
+						}).Return(nil)
+
+						a.EXPECT().UpdateAttendanceCorrection(gomock.Any(), gomock.Any()).Return(nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:  "reject does not touch attendance",
+			input: entity.ReviewAttendanceCorrection{ID: 3, ReviewerID: 99, Note: "no evidence"},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{ID: 3, Status: entity.CorrectionStatusPending}}, nil)
+
+						a.EXPECT().UpdateAttendanceCorrection(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(_ context.Context, u entity.UpdateAttendanceCorrection) error {
+								assert.Equal(t, entity.CorrectionStatusRejected, u.Status)
+								assert.Equal(t, "no evidence", u.ReviewNote)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:  "correction not found",
+			input: entity.ReviewAttendanceCorrection{ID: 4, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "attendance correction not found",
+		},
+		{
+			name:  "already reviewed",
+			input: entity.ReviewAttendanceCorrection{ID: 5, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{ID: 5, Status: entity.CorrectionStatusApproved}}, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "already reviewed",
+		},
+		{
+			name:  "period locked for payroll",
+			input: entity.ReviewAttendanceCorrection{ID: 6, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{
+								ID: 6, UserID: 5, Date: date, Type: entity.CorrectionWrongTime,
+								RequestedCheckInAt: &checkIn, Status: entity.CorrectionStatusPending,
+							}}, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{ID: 7, AttendancePeriodID: 10, CheckedInAt: &checkOut, Version: 1}}, nil)
+
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodLocked}}, nil)
+
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "attendance period is locked, its attendance can no longer change",
+		},
+		{
+			name:  "version conflict on attendance update",
+			input: entity.ReviewAttendanceCorrection{ID: 6, Approve: true},
+			setupMocks: func(a mockAttendance.MockDomainItf, s mockShift.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						a.EXPECT().GetAttendanceCorrections(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendanceCorrection{{
+								ID: 6, UserID: 5, Date: date, Type: entity.CorrectionWrongTime,
+								RequestedCheckInAt: &checkIn, Status: entity.CorrectionStatusPending,
+							}}, nil)
+
+						a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+							Return([]entity.Attendance{{ID: 7, AttendancePeriodID: 10, CheckedInAt: &checkOut, Version: 1}}, nil)
+
+						a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+							Return([]entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}}, nil)
+
+						a.EXPECT().UpdateAttendance(gomock.Any(), gomock.Any()).
+							Return(errors.New("attendance was updated by someone else, please retry"))
+
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "updated by someone else",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockAtt, *mockShift, *mockTx)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTx,
+				ShiftDom:       mockShift,
+			})
+
+			err := usecase.ReviewCorrection(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
 	}
+}
+
+func TestGetAttendanceReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitAttendanceUsecase(uc.Option{
+		AttendanceDom: mockAtt,
+	})
 
-	t.Run("success get overtime", func(t *testing.T) {
-		expected := []entity.Overtime{
-			{ID: 1, UserID: 1, Date: filter.Date, Hours: 2},
-		}
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
 
-		mockAtt.EXPECT().GetOvertime(gomock.Any(), filter).Return(expected, nil)
+	t.Run("success aggregates totals", func(t *testing.T) {
+		mockAtt.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{
+			UserID:    1,
+			StartDate: &start,
+			EndDate:   &end,
+		}).Return([]entity.Attendance{
+			{ID: 1, WorkMode: entity.WorkModeOffice, WorkedMinutes: 420, LateMinutes: 15},
+			{ID: 2, WorkMode: entity.WorkModeWFH, WorkedMinutes: 400, EarlyLeaveMinutes: 20},
+			{ID: 3, WorkedMinutes: 480},
+		}, nil)
 
-		result, err := usecase.GetOvertime(context.Background(), filter)
+		report, err := usecase.GetAttendanceReport(context.Background(), entity.GetAttendanceReportRequest{
+			UserID:    1,
+			StartDate: start,
+			EndDate:   end,
+		})
 		assert.NoError(t, err)
-		assert.Equal(t, expected, result)
+		assert.Equal(t, 3, report.AttendedDays)
+		assert.Equal(t, 1300, report.TotalWorkedMinutes)
+		assert.Equal(t, 1, report.LateDays)
+		assert.Equal(t, 15, report.TotalLateMinutes)
+		assert.Equal(t, 1, report.EarlyLeaveDays)
+		assert.Equal(t, 20, report.TotalEarlyLeaveMinutes)
+		assert.Equal(t, []entity.WorkModeSummary{
+			{UserID: 1, WorkMode: entity.WorkModeOffice, Days: 2, WorkedMinutes: 900},
+			{UserID: 1, WorkMode: entity.WorkModeWFH, Days: 1, WorkedMinutes: 400},
+		}, report.ByWorkMode)
 	})
 
-	t.Run("failed to get overtime", func(t *testing.T) {
-		mockAtt.EXPECT().GetOvertime(gomock.Any(), filter).
-			Return(nil, errors.New("database error"))
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := usecase.GetAttendanceReport(context.Background(), entity.GetAttendanceReportRequest{
+			UserID:    1,
+			StartDate: end,
+			EndDate:   start,
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "start date cannot be after end date")
+	})
 
-		result, err := usecase.GetOvertime(context.Background(), filter)
+	t.Run("range too long", func(t *testing.T) {
+		_, err := usecase.GetAttendanceReport(context.Background(), entity.GetAttendanceReportRequest{
+			UserID:    1,
+			StartDate: start,
+			EndDate:   start.AddDate(0, 0, 366),
+		})
 		assert.Error(t, err)
-		assert.Nil(t, result)
-		assert.Contains(t, err.Error(), "database error")
+		assert.Contains(t, err.Error(), "date range cannot be longer than 366 days")
 	})
 }
 
-func TestCreateAttendancePeriod(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
+func TestGetAttendanceHistory(t *testing.T) {
+	// Monday to Sunday
+	start := time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC)
+	checkIn := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
 
-	mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+	statuses := func(days []entity.AttendanceDay) []entity.AttendanceDayStatus {
+		var result []entity.AttendanceDayStatus
+		for _, d := range days {
+			result = append(result, d.Status)
+		}
+		return result
+	}
 
-	usecase := uc.InitAttendanceUsecase(uc.Option{
-		AttendanceDom: mockAtt,
-	})
+	tests := []struct {
+		name           string
+		input          entity.GetAttendanceHistoryRequest
+		setupMocks     func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf)
+		expectErr      bool
+		errorString    string
+		expectStatuses []entity.AttendanceDayStatus
+		expectTotal    int64
+		expectPages    int
+		check          func(t *testing.T, days []entity.AttendanceDay)
+	}{
+		{
+			name:  "weekdays without roster",
+			input: entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &start, EndDate: &end, Limit: 7},
+			setupMocks: func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{UserID: 1, StartDate: &start, EndDate: &end}).
+					Return([]entity.Attendance{{ID: 5, UserID: 1, Date: start, CheckedInAt: &checkIn, WorkedMinutes: 480}}, nil)
+				a.EXPECT().GetOvertime(gomock.Any(), entity.GetOvertimeFilter{UserID: 1, StartDate: &start, EndDate: &end}).
+					Return([]entity.Overtime{{UserID: 1, Date: start, Hours: 1}, {UserID: 1, Date: start, Hours: 0.5}}, nil)
+				h.EXPECT().GetHolidays(gomock.Any(), entity.GetHolidayFilter{StartDate: &start, EndDate: &end}).
+					Return([]entity.Holiday{{ID: 1, Date: start.AddDate(0, 0, 1), Name: "Founders Day"}}, nil)
+				s.EXPECT().GetRosters(gomock.Any(), entity.GetShiftRosterFilter{UserID: 1, StartDate: &start, EndDate: &end}).
+					Return(nil, nil)
+			},
+			expectStatuses: []entity.AttendanceDayStatus{
+				entity.AttendanceDayWeekend, entity.AttendanceDayWeekend,
+				entity.AttendanceDayAbsent, entity.AttendanceDayAbsent, entity.AttendanceDayAbsent,
+				entity.AttendanceDayHoliday, entity.AttendanceDayPresent,
+			},
+			expectTotal: 7,
+			expectPages: 1,
+			check: func(t *testing.T, days []entity.AttendanceDay) {
+				assert.Equal(t, end, days[0].Date)
+				assert.True(t, days[0].Weekend)
+				assert.Equal(t, "Founders Day", days[5].HolidayName)
+				assert.Equal(t, uint(5), days[6].Attendance.ID)
+				assert.Equal(t, 1.5, days[6].OvertimeHours)
+			},
+		},
+		{
+			name:  "rostered week decides the rest days",
+			input: entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &start, EndDate: &end, Page: 1, Limit: 3},
+			setupMocks: func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return(nil, nil)
+				a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).Return(nil, nil)
+				h.EXPECT().GetHolidays(gomock.Any(), gomock.Any()).Return(nil, nil)
+				s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).
+					Return([]entity.ShiftRoster{{UserID: 1, ShiftID: 2, Date: end.AddDate(0, 0, -1)}}, nil)
+			},
+			expectStatuses: []entity.AttendanceDayStatus{
+				entity.AttendanceDayWeekend, entity.AttendanceDayAbsent, entity.AttendanceDayWeekend,
+			},
+			expectTotal: 7,
+			expectPages: 3,
+		},
+		{
+			name:  "last page",
+			input: entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &start, EndDate: &end, Page: 3, Limit: 3},
+			setupMocks: func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).Return(nil, nil)
+				a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).Return(nil, nil)
+				h.EXPECT().GetHolidays(gomock.Any(), gomock.Any()).Return(nil, nil)
+				s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectStatuses: []entity.AttendanceDayStatus{entity.AttendanceDayAbsent},
+			expectTotal:    7,
+			expectPages:    3,
+			check: func(t *testing.T, days []entity.AttendanceDay) {
+				assert.Equal(t, start, days[0].Date)
+			},
+		},
+		{
+			name:  "by attendance period",
+			input: entity.GetAttendanceHistoryRequest{UserID: 1, AttendancePeriodID: 4},
+			setupMocks: func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{ID: "4"}).
+					Return([]entity.AttendancePeriod{{ID: 4, StartDate: start, EndDate: start}}, nil)
+				a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{UserID: 1, StartDate: &start, EndDate: &start}).Return(nil, nil)
+				a.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).Return(nil, nil)
+				h.EXPECT().GetHolidays(gomock.Any(), gomock.Any()).Return(nil, nil)
+				s.EXPECT().GetRosters(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectStatuses: []entity.AttendanceDayStatus{entity.AttendanceDayAbsent},
+			expectTotal:    1,
+			expectPages:    1,
+		},
+		{
+			name:  "attendance period not found",
+			input: entity.GetAttendanceHistoryRequest{UserID: 1, AttendancePeriodID: 4},
+			setupMocks: func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "attendance period not found",
+		},
+		{
+			name:        "period and dates together",
+			input:       entity.GetAttendanceHistoryRequest{UserID: 1, AttendancePeriodID: 4, StartDate: &start},
+			setupMocks:  func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "not both",
+		},
+		{
+			name:        "missing range",
+			input:       entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &start},
+			setupMocks:  func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "provide an attendance period or a start and end date",
+		},
+		{
+			name:        "start after end",
+			input:       entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &end, EndDate: &start},
+			setupMocks:  func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "start date cannot be after end date",
+		},
+		{
+			name:        "range too long",
+			input:       entity.GetAttendanceHistoryRequest{UserID: 1, StartDate: &start, EndDate: pkg.TimePtr(start.AddDate(1, 1, 0))},
+			setupMocks:  func(a *mockAttendance.MockDomainItf, s *mockShift.MockDomainItf, h *mockHoliday.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "date range cannot be longer than 366 days",
+		},
+	}
 
-	req := entity.CreateAttendancePeriodRequest{
-		StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-		EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			mockShiftDom := mockShift.NewMockDomainItf(ctrl)
+			mockHolidayDom := mockHoliday.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(mockAtt, mockShiftDom, mockHolidayDom)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom: mockAtt,
+				ShiftDom:      mockShiftDom,
+				HolidayDom:    mockHolidayDom,
+			})
+
+			history, err := usecase.GetAttendanceHistory(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectStatuses, statuses(history.Days))
+			assert.Equal(t, tt.expectTotal, history.TotalData)
+			assert.Equal(t, tt.expectPages, history.TotalPages)
+			if tt.check != nil {
+				tt.check(t, history.Days)
+			}
+		})
 	}
+}
 
-	t.Run("success create attendance period", func(t *testing.T) {
-		mockAtt.EXPECT().
-			CreateAttendancePeriod(gomock.Any(), gomock.AssignableToTypeOf(entity.AttendancePeriod{})).
-			DoAndReturn(func(_ context.Context, p entity.AttendancePeriod) error {
-				assert.Equal(t, req.StartDate, p.StartDate)
-				assert.Equal(t, req.EndDate, p.EndDate)
-				assert.Equal(t, "open", p.Status)
-				return nil
+func TestUpdateAttendancePeriod(t *testing.T) {
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateAttendancePeriodRequest
+		setupMocks  func(a *mockAttendance.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "success",
+			input: entity.UpdateAttendancePeriodRequest{ID: 4, StartDate: start, EndDate: end},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{ID: "4"}).
+					Return([]entity.AttendancePeriod{{ID: 4, Status: entity.AttendancePeriodOpen}}, nil)
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{
+					OverlapStart: &start,
+					OverlapEnd:   &end,
+					ExcludeID:    4,
+				}).Return(nil, nil)
+				a.EXPECT().GetAttendance(gomock.Any(), entity.GetAttendance{AttendancePeriodID: 4}).
+					Return([]entity.Attendance{{ID: 1, Date: start}, {ID: 2, Date: end}}, nil)
+				a.EXPECT().UpdateAttendancePeriod(gomock.Any(), entity.UpdateAttendancePeriod{
+					ID:             4,
+					StartDate:      &start,
+					EndDate:        &end,
+					ExpectedStatus: entity.AttendancePeriodOpen,
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "locked period",
+			input: entity.UpdateAttendancePeriodRequest{ID: 4, StartDate: start, EndDate: end},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+					Return([]entity.AttendancePeriod{{ID: 4, Status: entity.AttendancePeriodLocked}}, nil)
+			},
+			expectErr:   true,
+			errorString: "attendance period is locked and can no longer be edited",
+		},
+		{
+			name:  "attendance left outside",
+			input: entity.UpdateAttendancePeriodRequest{ID: 4, StartDate: start, EndDate: end},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+					Return([]entity.AttendancePeriod{{ID: 4, Status: entity.AttendancePeriodReopened}}, nil)
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+				a.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+					Return([]entity.Attendance{{ID: 1, Date: end.AddDate(0, 0, 1)}}, nil)
+			},
+			expectErr:   true,
+			errorString: "attendance period has attendance outside the new dates",
+		},
+		{
+			name:  "not found",
+			input: entity.UpdateAttendancePeriodRequest{ID: 4, StartDate: start, EndDate: end},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "attendance period not found",
+		},
+		{
+			name:        "start after end",
+			input:       entity.UpdateAttendancePeriodRequest{ID: 4, StartDate: end, EndDate: start},
+			setupMocks:  func(a *mockAttendance.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "start date cannot be after end date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			tt.setupMocks(mockAtt)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTxDom,
 			})
 
-		err := usecase.CreateAttendancePeriod(context.Background(), req)
-		assert.NoError(t, err)
-	})
+			err := usecase.UpdateAttendancePeriod(context.Background(), tt.input)
 
-	t.Run("failed create attendance period", func(t *testing.T) {
-		mockAtt.EXPECT().
-			CreateAttendancePeriod(gomock.Any(), gomock.Any()).
-			Return(errors.New("insert failed"))
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
 
-		err := usecase.CreateAttendancePeriod(context.Background(), req)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "failed to create attendance period")
-	})
+func TestTransitionAttendancePeriod(t *testing.T) {
+	adminID := uint(99)
+
+	tests := []struct {
+		name        string
+		from        string
+		to          string
+		changedBy   *uint
+		expectErr   bool
+		errorString string
+	}{
+		{name: "lock open period", from: entity.AttendancePeriodOpen, to: entity.AttendancePeriodLocked, changedBy: &adminID},
+		{name: "unlock period", from: entity.AttendancePeriodLocked, to: entity.AttendancePeriodOpen, changedBy: &adminID},
+		{name: "close after payroll", from: entity.AttendancePeriodPayrollProcessing, to: entity.AttendancePeriodClosed},
+		{name: "reopen closed period", from: entity.AttendancePeriodClosed, to: entity.AttendancePeriodReopened, changedBy: &adminID},
+		{name: "lock reopened period", from: entity.AttendancePeriodReopened, to: entity.AttendancePeriodLocked, changedBy: &adminID},
+		{
+			name:        "skip payroll",
+			from:        entity.AttendancePeriodLocked,
+			to:          entity.AttendancePeriodClosed,
+			expectErr:   true,
+			errorString: "attendance period cannot move from locked to closed",
+		},
+		{
+			name:        "close open period",
+			from:        entity.AttendancePeriodOpen,
+			to:          entity.AttendancePeriodClosed,
+			expectErr:   true,
+			errorString: "attendance period cannot move from open to closed",
+		},
+		{
+			name:        "reopen open period",
+			from:        entity.AttendancePeriodOpen,
+			to:          entity.AttendancePeriodReopened,
+			expectErr:   true,
+			errorString: "attendance period cannot move from open to reopened",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockAtt.EXPECT().GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{ID: "4"}).
+				Return([]entity.AttendancePeriod{{ID: 4, Status: tt.from}}, nil)
+
+			if !tt.expectErr {
+				mockAtt.EXPECT().UpdateAttendancePeriod(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, u entity.UpdateAttendancePeriod) error {
+						assert.Equal(t, tt.to, *u.Status)
+						assert.Equal(t, tt.from, u.ExpectedStatus)
+						assert.Equal(t, tt.to == entity.AttendancePeriodClosed, u.ClosedAt != nil)
+						return nil
+					})
+				mockAtt.EXPECT().CreateAttendancePeriodTransition(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, tr entity.AttendancePeriodTransition) error {
+						assert.Equal(t, uint(4), tr.AttendancePeriodID)
+						assert.Equal(t, tt.from, tr.FromStatus)
+						assert.Equal(t, tt.to, tr.ToStatus)
+						assert.Equal(t, tt.changedBy, tr.ChangedBy)
+						return nil
+					})
+			}
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:  mockAtt,
+				TransactionDom: mockTxDom,
+			})
+
+			err := usecase.TransitionAttendancePeriod(context.Background(), entity.TransitionAttendancePeriodRequest{
+				ID:        4,
+				Status:    tt.to,
+				ChangedBy: tt.changedBy,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGenerateAttendancePeriods(t *testing.T) {
+	tests := []struct {
+		name        string
+		calendar    entity.PayrollCalendar
+		setupMocks  func(a *mockAttendance.MockDomainItf)
+		wantCreated int
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:       "calendar disabled",
+			calendar:   entity.PayrollCalendar{},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {},
+		},
+		{
+			name:     "creates current and next period",
+			calendar: entity.PayrollCalendar{Frequency: entity.PayrollSemiMonthly, PeriodsAhead: 1},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				a.EXPECT().CreateAttendancePeriod(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, p entity.AttendancePeriod) error {
+						assert.Equal(t, entity.AttendancePeriodOpen, p.Status)
+						assert.Equal(t, 23, p.EndDate.Hour())
+						assert.Equal(t, 59, p.EndDate.Second())
+						return nil
+					}).Times(2)
+			},
+			wantCreated: 2,
+		},
+		{
+			name:     "skips overlapped period",
+			calendar: entity.PayrollCalendar{Frequency: entity.PayrollMonthly, PeriodsAhead: 1},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+					Return([]entity.AttendancePeriod{{ID: 1}}, nil)
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+				a.EXPECT().CreateAttendancePeriod(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantCreated: 1,
+		},
+		{
+			name:     "create failed",
+			calendar: entity.PayrollCalendar{Frequency: entity.PayrollBiweekly},
+			setupMocks: func(a *mockAttendance.MockDomainItf) {
+				a.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).Return(nil, nil)
+				a.EXPECT().CreateAttendancePeriod(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+			},
+			expectErr:   true,
+			errorString: "db down",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAtt := mockAttendance.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockAtt)
+
+			usecase := uc.InitAttendanceUsecase(uc.Option{
+				AttendanceDom:   mockAtt,
+				PayrollCalendar: tt.calendar,
+			})
+
+			created, err := usecase.GenerateAttendancePeriods(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCreated, created)
+		})
+	}
+}
+
+func TestPayrollCalendarPeriodContaining(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name      string
+		calendar  entity.PayrollCalendar
+		at        time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "monthly",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollMonthly},
+			at:        date(2024, 2, 10),
+			wantStart: date(2024, 2, 1),
+			wantEnd:   date(2024, 2, 29),
+		},
+		{
+			name:      "monthly with cutoff, before cutoff",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollMonthly, CutoffDay: 25},
+			at:        date(2025, 1, 10),
+			wantStart: date(2024, 12, 26),
+			wantEnd:   date(2025, 1, 25),
+		},
+		{
+			name:      "monthly with cutoff, after cutoff",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollMonthly, CutoffDay: 25},
+			at:        date(2025, 1, 26),
+			wantStart: date(2025, 1, 26),
+			wantEnd:   date(2025, 2, 25),
+		},
+		{
+			name:      "semi-monthly first half",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollSemiMonthly},
+			at:        date(2025, 6, 15),
+			wantStart: date(2025, 6, 1),
+			wantEnd:   date(2025, 6, 15),
+		},
+		{
+			name:      "semi-monthly second half",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollSemiMonthly},
+			at:        date(2025, 6, 16),
+			wantStart: date(2025, 6, 16),
+			wantEnd:   date(2025, 6, 30),
+		},
+		{
+			name:      "biweekly",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollBiweekly, Anchor: date(2024, 1, 1)},
+			at:        date(2024, 1, 20),
+			wantStart: date(2024, 1, 15),
+			wantEnd:   date(2024, 1, 28),
+		},
+		{
+			name:      "biweekly before anchor",
+			calendar:  entity.PayrollCalendar{Frequency: entity.PayrollBiweekly, Anchor: date(2024, 1, 1)},
+			at:        date(2023, 12, 31),
+			wantStart: date(2023, 12, 18),
+			wantEnd:   date(2023, 12, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.calendar.PeriodContaining(tt.at)
+			assert.True(t, tt.wantStart.Equal(start), "start %s", start)
+			assert.True(t, tt.wantEnd.Equal(end), "end %s", end)
+		})
+	}
 }
@@ -0,0 +1,30 @@
+package device
+
+import (
+	"context"
+
+	deviceDom "github.com/zuhrulumam/go-hris/business/domain/device"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	RegisterDevice(ctx context.Context, req entity.RegisterDeviceRequest) (*entity.MobileDevice, error)
+	UpdateDevice(ctx context.Context, data entity.UpdateDevice) error
+	GetDevices(ctx context.Context, filter entity.GetDeviceFilter) ([]entity.MobileDevice, error)
+}
+
+type Option struct {
+	DeviceDom deviceDom.DomainItf
+}
+
+type device struct {
+	DeviceDom deviceDom.DomainItf
+}
+
+func InitDeviceUsecase(opt Option) UsecaseItf {
+	d := &device{
+		DeviceDom: opt.DeviceDom,
+	}
+
+	return d
+}
@@ -0,0 +1,39 @@
+package device
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (d *device) RegisterDevice(ctx context.Context, req entity.RegisterDeviceRequest) (*entity.MobileDevice, error) {
+	if req.Name == "" {
+		return nil, x.NewWithCode(http.StatusBadRequest, "device name is required")
+	}
+
+	secret, err := pkg.GenerateDeviceSecret()
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate device secret")
+	}
+
+	return d.DeviceDom.CreateDevice(ctx, entity.MobileDevice{
+		UserID:    req.UserID,
+		Name:      req.Name,
+		Secret:    secret,
+		Active:    true,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+}
+
+func (d *device) UpdateDevice(ctx context.Context, data entity.UpdateDevice) error {
+	return d.DeviceDom.UpdateDevice(ctx, data)
+}
+
+func (d *device) GetDevices(ctx context.Context, filter entity.GetDeviceFilter) ([]entity.MobileDevice, error) {
+	return d.DeviceDom.GetDevices(ctx, filter)
+}
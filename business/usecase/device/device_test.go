@@ -0,0 +1,72 @@
+package device_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/device"
+	mockDevice "github.com/zuhrulumam/go-hris/mocks/domain/device"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRegisterDevice(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.RegisterDeviceRequest
+		setupMocks  func(d mockDevice.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "success",
+			input: entity.RegisterDeviceRequest{UserID: 1, Name: "Pixel"},
+			setupMocks: func(d mockDevice.MockDomainItf) {
+				d.EXPECT().CreateDevice(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.MobileDevice) (*entity.MobileDevice, error) {
+						assert.Equal(t, uint(1), data.UserID)
+						assert.True(t, data.Active)
+						assert.Len(t, data.Secret, 64)
+						data.ID = 5
+						return &data, nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:        "missing name",
+			input:       entity.RegisterDeviceRequest{UserID: 1},
+			setupMocks:  func(d mockDevice.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "device name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDevice := mockDevice.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockDevice)
+
+			usecase := uc.InitDeviceUsecase(uc.Option{
+				DeviceDom: mockDevice,
+			})
+
+			device, err := usecase.RegisterDevice(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(5), device.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package holiday
+
+import (
+	"context"
+
+	holidayDom "github.com/zuhrulumam/go-hris/business/domain/holiday"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	CreateHoliday(ctx context.Context, data entity.CreateHolidayRequest) error
+	DeleteHoliday(ctx context.Context, id uint) error
+	GetHolidays(ctx context.Context, filter entity.GetHolidayFilter) ([]entity.Holiday, error)
+}
+
+type Option struct {
+	HolidayDom holidayDom.DomainItf
+}
+
+type holiday struct {
+	HolidayDom holidayDom.DomainItf
+}
+
+func InitHolidayUsecase(opt Option) UsecaseItf {
+	h := &holiday{
+		HolidayDom: opt.HolidayDom,
+	}
+
+	return h
+}
@@ -0,0 +1,39 @@
+package holiday
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (h *holiday) CreateHoliday(ctx context.Context, data entity.CreateHolidayRequest) error {
+	day := time.Date(data.Date.Year(), data.Date.Month(), data.Date.Day(), 0, 0, 0, 0, data.Date.Location())
+
+	existing, err := h.HolidayDom.GetHolidays(ctx, entity.GetHolidayFilter{
+		StartDate: &day,
+		EndDate:   &day,
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 {
+		return x.NewWithCode(http.StatusBadRequest, "a holiday already exists on this date")
+	}
+
+	return h.HolidayDom.CreateHoliday(ctx, entity.Holiday{
+		Date: day,
+		Name: data.Name,
+	})
+}
+
+func (h *holiday) DeleteHoliday(ctx context.Context, id uint) error {
+	return h.HolidayDom.DeleteHoliday(ctx, id)
+}
+
+func (h *holiday) GetHolidays(ctx context.Context, filter entity.GetHolidayFilter) ([]entity.Holiday, error) {
+	return h.HolidayDom.GetHolidays(ctx, filter)
+}
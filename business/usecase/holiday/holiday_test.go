@@ -0,0 +1,76 @@
+package holiday_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/holiday"
+	mockHoliday "github.com/zuhrulumam/go-hris/mocks/domain/holiday"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateHoliday(t *testing.T) {
+	day := time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.CreateHolidayRequest
+		setupMocks  func(h *mockHoliday.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "success",
+			input: entity.CreateHolidayRequest{Date: day.Add(10 * time.Hour), Name: "Independence Day"},
+			setupMocks: func(h *mockHoliday.MockDomainItf) {
+				h.EXPECT().GetHolidays(gomock.Any(), entity.GetHolidayFilter{StartDate: &day, EndDate: &day}).Return(nil, nil)
+				h.EXPECT().CreateHoliday(gomock.Any(), entity.Holiday{Date: day, Name: "Independence Day"}).Return(nil)
+			},
+		},
+		{
+			name:  "date already taken",
+			input: entity.CreateHolidayRequest{Date: day, Name: "Independence Day"},
+			setupMocks: func(h *mockHoliday.MockDomainItf) {
+				h.EXPECT().GetHolidays(gomock.Any(), gomock.Any()).Return([]entity.Holiday{{ID: 1, Date: day}}, nil)
+			},
+			expectErr:   true,
+			errorString: "a holiday already exists on this date",
+		},
+		{
+			name:  "lookup fails",
+			input: entity.CreateHolidayRequest{Date: day, Name: "Independence Day"},
+			setupMocks: func(h *mockHoliday.MockDomainItf) {
+				h.EXPECT().GetHolidays(gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+			},
+			expectErr:   true,
+			errorString: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockHolidayDom := mockHoliday.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockHolidayDom)
+
+			usecase := uc.InitHolidayUsecase(uc.Option{
+				HolidayDom: mockHolidayDom,
+			})
+
+			err := usecase.CreateHoliday(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package kiosk
+
+import (
+	"context"
+
+	kioskDom "github.com/zuhrulumam/go-hris/business/domain/kiosk"
+	locationDom "github.com/zuhrulumam/go-hris/business/domain/location"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	RegisterKiosk(ctx context.Context, req entity.RegisterKioskRequest) (*entity.Kiosk, error)
+	UpdateKiosk(ctx context.Context, data entity.UpdateKiosk) error
+	GetKiosks(ctx context.Context, filter entity.GetKioskFilter) ([]entity.Kiosk, error)
+
+	IssueToken(ctx context.Context, req entity.IssueKioskTokenRequest) (*entity.KioskToken, error)
+}
+
+type Option struct {
+	KioskDom    kioskDom.DomainItf
+	LocationDom locationDom.DomainItf
+}
+
+type kiosk struct {
+	KioskDom    kioskDom.DomainItf
+	LocationDom locationDom.DomainItf
+}
+
+func InitKioskUsecase(opt Option) UsecaseItf {
+	k := &kiosk{
+		KioskDom:    opt.KioskDom,
+		LocationDom: opt.LocationDom,
+	}
+
+	return k
+}
@@ -0,0 +1,74 @@
+package kiosk
+
+import (
+	"context"
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (k *kiosk) RegisterKiosk(ctx context.Context, req entity.RegisterKioskRequest) (*entity.Kiosk, error) {
+	if req.Name == "" {
+		return nil, x.NewWithCode(http.StatusBadRequest, "kiosk name is required")
+	}
+
+	offices, err := k.LocationDom.GetOfficeLocations(ctx, entity.GetOfficeLocationFilter{ID: req.OfficeLocationID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(offices) < 1 {
+		return nil, x.NewWithCode(http.StatusNotFound, "office location not found")
+	}
+
+	secret, err := pkg.GenerateDeviceSecret()
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate kiosk secret")
+	}
+
+	return k.KioskDom.CreateKiosk(ctx, entity.Kiosk{
+		Name:             req.Name,
+		OfficeLocationID: req.OfficeLocationID,
+		Secret:           secret,
+		Active:           true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	})
+}
+
+func (k *kiosk) UpdateKiosk(ctx context.Context, data entity.UpdateKiosk) error {
+	return k.KioskDom.UpdateKiosk(ctx, data)
+}
+
+func (k *kiosk) GetKiosks(ctx context.Context, filter entity.GetKioskFilter) ([]entity.Kiosk, error) {
+	return k.KioskDom.GetKiosks(ctx, filter)
+}
+
+func (k *kiosk) IssueToken(ctx context.Context, req entity.IssueKioskTokenRequest) (*entity.KioskToken, error) {
+	kiosks, err := k.KioskDom.GetKiosks(ctx, entity.GetKioskFilter{
+		ID:     req.KioskID,
+		Active: pkg.BoolPtr(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Same answer for unknown kiosks and wrong secrets
+	if len(kiosks) < 1 || subtle.ConstantTimeCompare([]byte(kiosks[0].Secret), []byte(req.Secret)) != 1 {
+		return nil, x.NewWithCode(http.StatusUnauthorized, "invalid kiosk credentials")
+	}
+
+	token, expiresAt, err := pkg.IssueKioskToken(kiosks[0].ID, kiosks[0].Secret, time.Now())
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to issue kiosk token")
+	}
+
+	return &entity.KioskToken{
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
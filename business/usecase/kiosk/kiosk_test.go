@@ -0,0 +1,138 @@
+package kiosk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/kiosk"
+	mockKiosk "github.com/zuhrulumam/go-hris/mocks/domain/kiosk"
+	mockLocation "github.com/zuhrulumam/go-hris/mocks/domain/location"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRegisterKiosk(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.RegisterKioskRequest
+		setupMocks  func(k mockKiosk.MockDomainItf, l mockLocation.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success",
+			input: entity.RegisterKioskRequest{
+				Name:             "Lobby",
+				OfficeLocationID: 1,
+			},
+			setupMocks: func(k mockKiosk.MockDomainItf, l mockLocation.MockDomainItf) {
+				l.EXPECT().GetOfficeLocations(gomock.Any(), entity.GetOfficeLocationFilter{ID: 1}).
+					Return([]entity.OfficeLocation{{ID: 1, Active: true}}, nil)
+				k.EXPECT().CreateKiosk(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.Kiosk) (*entity.Kiosk, error) {
+						assert.True(t, data.Active)
+						assert.NotEmpty(t, data.Secret)
+						data.ID = 3
+						return &data, nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "unknown office",
+			input: entity.RegisterKioskRequest{
+				Name:             "Lobby",
+				OfficeLocationID: 9,
+			},
+			setupMocks: func(k mockKiosk.MockDomainItf, l mockLocation.MockDomainItf) {
+				l.EXPECT().GetOfficeLocations(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "office location not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockKiosk := mockKiosk.NewMockDomainItf(ctrl)
+			mockLocation := mockLocation.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockKiosk, *mockLocation)
+
+			usecase := uc.InitKioskUsecase(uc.Option{
+				KioskDom:    mockKiosk,
+				LocationDom: mockLocation,
+			})
+
+			kiosk, err := usecase.RegisterKiosk(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, uint(3), kiosk.ID)
+			}
+		})
+	}
+}
+
+func TestIssueToken(t *testing.T) {
+	kiosk := entity.Kiosk{ID: 3, Secret: "s3cret", Active: true}
+
+	tests := []struct {
+		name        string
+		input       entity.IssueKioskTokenRequest
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:      "valid secret",
+			input:     entity.IssueKioskTokenRequest{KioskID: 3, Secret: "s3cret"},
+			expectErr: false,
+		},
+		{
+			name:        "wrong secret",
+			input:       entity.IssueKioskTokenRequest{KioskID: 3, Secret: "guess"},
+			expectErr:   true,
+			errorString: "invalid kiosk credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockKiosk := mockKiosk.NewMockDomainItf(ctrl)
+			mockKiosk.EXPECT().GetKiosks(gomock.Any(), entity.GetKioskFilter{ID: 3, Active: pkg.BoolPtr(true)}).
+				Return([]entity.Kiosk{kiosk}, nil)
+
+			usecase := uc.InitKioskUsecase(uc.Option{
+				KioskDom: mockKiosk,
+			})
+
+			token, err := usecase.IssueToken(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+
+				claims, err := pkg.ParseKioskToken(token.Token)
+				assert.NoError(t, err)
+				assert.True(t, claims.Verify(kiosk.Secret))
+			}
+		})
+	}
+}
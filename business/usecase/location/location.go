@@ -0,0 +1,30 @@
+package location
+
+import (
+	"context"
+
+	locationDom "github.com/zuhrulumam/go-hris/business/domain/location"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	CreateOfficeLocation(ctx context.Context, req entity.CreateOfficeLocationRequest) error
+	UpdateOfficeLocation(ctx context.Context, data entity.UpdateOfficeLocation) error
+	GetOfficeLocations(ctx context.Context, filter entity.GetOfficeLocationFilter) ([]entity.OfficeLocation, error)
+}
+
+type Option struct {
+	LocationDom locationDom.DomainItf
+}
+
+type location struct {
+	LocationDom locationDom.DomainItf
+}
+
+func InitLocationUsecase(opt Option) UsecaseItf {
+	l := &location{
+		LocationDom: opt.LocationDom,
+	}
+
+	return l
+}
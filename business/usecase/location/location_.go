@@ -0,0 +1,66 @@
+package location
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (l *location) CreateOfficeLocation(ctx context.Context, req entity.CreateOfficeLocationRequest) error {
+	if req.Name == "" {
+		return x.NewWithCode(http.StatusBadRequest, "office location name is required")
+	}
+
+	if err := validateCoordinates(req.Latitude, req.Longitude); err != nil {
+		return err
+	}
+
+	if req.RadiusMeters <= 0 {
+		return x.NewWithCode(http.StatusBadRequest, "radius must be greater than zero")
+	}
+
+	return l.LocationDom.CreateOfficeLocation(ctx, entity.OfficeLocation{
+		Name:         req.Name,
+		Latitude:     req.Latitude,
+		Longitude:    req.Longitude,
+		RadiusMeters: req.RadiusMeters,
+		Active:       true,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	})
+}
+
+func (l *location) UpdateOfficeLocation(ctx context.Context, data entity.UpdateOfficeLocation) error {
+	if data.Latitude != nil && (*data.Latitude < -90 || *data.Latitude > 90) {
+		return x.NewWithCode(http.StatusBadRequest, "latitude must be between -90 and 90")
+	}
+
+	if data.Longitude != nil && (*data.Longitude < -180 || *data.Longitude > 180) {
+		return x.NewWithCode(http.StatusBadRequest, "longitude must be between -180 and 180")
+	}
+
+	if data.RadiusMeters != nil && *data.RadiusMeters <= 0 {
+		return x.NewWithCode(http.StatusBadRequest, "radius must be greater than zero")
+	}
+
+	return l.LocationDom.UpdateOfficeLocation(ctx, data)
+}
+
+func (l *location) GetOfficeLocations(ctx context.Context, filter entity.GetOfficeLocationFilter) ([]entity.OfficeLocation, error) {
+	return l.LocationDom.GetOfficeLocations(ctx, filter)
+}
+
+func validateCoordinates(lat, lng float64) error {
+	if lat < -90 || lat > 90 {
+		return x.NewWithCode(http.StatusBadRequest, "latitude must be between -90 and 90")
+	}
+
+	if lng < -180 || lng > 180 {
+		return x.NewWithCode(http.StatusBadRequest, "longitude must be between -180 and 180")
+	}
+
+	return nil
+}
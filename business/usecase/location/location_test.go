@@ -0,0 +1,90 @@
+package location_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/location"
+	mockLocation "github.com/zuhrulumam/go-hris/mocks/domain/location"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateOfficeLocation(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.CreateOfficeLocationRequest
+		setupMocks  func(l mockLocation.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success",
+			input: entity.CreateOfficeLocationRequest{
+				Name:         "HQ",
+				Latitude:     -6.175392,
+				Longitude:    106.827153,
+				RadiusMeters: 100,
+			},
+			setupMocks: func(l mockLocation.MockDomainItf) {
+				l.EXPECT().CreateOfficeLocation(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.OfficeLocation) error {
+						assert.True(t, data.Active)
+						assert.Equal(t, float64(100), data.RadiusMeters)
+						return nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid latitude",
+			input: entity.CreateOfficeLocationRequest{
+				Name:         "HQ",
+				Latitude:     -96,
+				Longitude:    106.827153,
+				RadiusMeters: 100,
+			},
+			setupMocks:  func(l mockLocation.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "latitude must be between -90 and 90",
+		},
+		{
+			name: "zero radius",
+			input: entity.CreateOfficeLocationRequest{
+				Name:      "HQ",
+				Latitude:  -6.175392,
+				Longitude: 106.827153,
+			},
+			setupMocks:  func(l mockLocation.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "radius must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockLocation := mockLocation.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockLocation)
+
+			usecase := uc.InitLocationUsecase(uc.Option{
+				LocationDom: mockLocation,
+			})
+
+			err := usecase.CreateOfficeLocation(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package notification
+
+import (
+	"context"
+
+	notificationDom "github.com/zuhrulumam/go-hris/business/domain/notification"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	GetNotifications(ctx context.Context, filter entity.GetNotificationFilter) ([]entity.Notification, error)
+	MarkRead(ctx context.Context, data entity.MarkNotificationRead) error
+}
+
+type Option struct {
+	NotificationDom notificationDom.DomainItf
+}
+
+type notification struct {
+	NotificationDom notificationDom.DomainItf
+}
+
+func InitNotificationUsecase(opt Option) UsecaseItf {
+	n := &notification{
+		NotificationDom: opt.NotificationDom,
+	}
+
+	return n
+}
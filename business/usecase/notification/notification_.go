@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+func (n *notification) GetNotifications(ctx context.Context, filter entity.GetNotificationFilter) ([]entity.Notification, error) {
+	return n.NotificationDom.GetNotifications(ctx, filter)
+}
+
+func (n *notification) MarkRead(ctx context.Context, data entity.MarkNotificationRead) error {
+	return n.NotificationDom.MarkNotificationRead(ctx, data)
+}
@@ -0,0 +1,46 @@
+package organization
+
+import (
+	"context"
+
+	organizationDom "github.com/zuhrulumam/go-hris/business/domain/organization"
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	CreateDepartment(ctx context.Context, name string) error
+	UpdateDepartment(ctx context.Context, data entity.UpdateDepartment) error
+	GetDepartments(ctx context.Context, filter entity.GetDepartmentFilter) ([]entity.Department, error)
+
+	CreatePosition(ctx context.Context, data entity.Position) error
+	UpdatePosition(ctx context.Context, data entity.UpdatePosition) error
+	GetPositions(ctx context.Context, filter entity.GetPositionFilter) ([]entity.Position, error)
+
+	AssignEmployee(ctx context.Context, req entity.AssignEmployeeRequest) error
+	GetOrgChart(ctx context.Context) ([]entity.OrgChartNode, error)
+	GetApproverChain(ctx context.Context, userID uint, levels int) ([]entity.User, error)
+}
+
+type Option struct {
+	OrganizationDom organizationDom.DomainItf
+	UserDom         userDom.DomainItf
+	TransactionDom  transactionDom.DomainItf
+}
+
+type organization struct {
+	OrganizationDom organizationDom.DomainItf
+	UserDom         userDom.DomainItf
+	TransactionDom  transactionDom.DomainItf
+}
+
+func InitOrganizationUsecase(opt Option) UsecaseItf {
+	o := &organization{
+		OrganizationDom: opt.OrganizationDom,
+		UserDom:         opt.UserDom,
+		TransactionDom:  opt.TransactionDom,
+	}
+
+	return o
+}
@@ -0,0 +1,327 @@
+package organization
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (o *organization) CreateDepartment(ctx context.Context, name string) error {
+	return o.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		existing, err := o.OrganizationDom.GetDepartments(newCtx, entity.GetDepartmentFilter{Name: name})
+		if err != nil {
+			return err
+		}
+
+		if len(existing) > 0 {
+			return x.NewWithCode(http.StatusBadRequest, "department already exists")
+		}
+
+		return o.OrganizationDom.CreateDepartment(newCtx, entity.Department{Name: name})
+	})
+}
+
+func (o *organization) UpdateDepartment(ctx context.Context, data entity.UpdateDepartment) error {
+	return o.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if data.Name != nil {
+			existing, err := o.OrganizationDom.GetDepartments(newCtx, entity.GetDepartmentFilter{Name: *data.Name})
+			if err != nil {
+				return err
+			}
+
+			for _, d := range existing {
+				if d.ID != data.ID {
+					return x.NewWithCode(http.StatusBadRequest, "department already exists")
+				}
+			}
+		}
+
+		return o.OrganizationDom.UpdateDepartment(newCtx, data)
+	})
+}
+
+func (o *organization) GetDepartments(ctx context.Context, filter entity.GetDepartmentFilter) ([]entity.Department, error) {
+	return o.OrganizationDom.GetDepartments(ctx, filter)
+}
+
+func (o *organization) CreatePosition(ctx context.Context, data entity.Position) error {
+	return o.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if err := o.checkPositionName(newCtx, 0, data.Name, data.DepartmentID); err != nil {
+			return err
+		}
+
+		return o.OrganizationDom.CreatePosition(newCtx, entity.Position{
+			Name:         data.Name,
+			DepartmentID: data.DepartmentID,
+		})
+	})
+}
+
+func (o *organization) UpdatePosition(ctx context.Context, data entity.UpdatePosition) error {
+	return o.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		positions, err := o.OrganizationDom.GetPositions(newCtx, entity.GetPositionFilter{ID: data.ID})
+		if err != nil {
+			return err
+		}
+
+		if len(positions) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "position not found")
+		}
+
+		name, departmentID := positions[0].Name, positions[0].DepartmentID
+		if data.Name != nil {
+			name = *data.Name
+		}
+		if data.DepartmentID != nil {
+			departmentID = *data.DepartmentID
+		}
+
+		if err := o.checkPositionName(newCtx, data.ID, name, departmentID); err != nil {
+			return err
+		}
+
+		return o.OrganizationDom.UpdatePosition(newCtx, data)
+	})
+}
+
+func (o *organization) GetPositions(ctx context.Context, filter entity.GetPositionFilter) ([]entity.Position, error) {
+	return o.OrganizationDom.GetPositions(ctx, filter)
+}
+
+// AssignEmployee places an employee in a department and position and sets
+// who they report to. A position must belong to the employee's department,
+// and a manager must be an active employee outside the employee's own
+// reporting line.
+func (o *organization) AssignEmployee(ctx context.Context, req entity.AssignEmployeeRequest) error {
+	return o.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		employee, err := o.user(newCtx, req.UserID)
+		if err != nil {
+			return err
+		}
+
+		departmentID := employee.DepartmentID
+		if req.DepartmentID != nil {
+			departmentID = req.DepartmentID
+			if *req.DepartmentID > 0 {
+				if err := o.checkDepartment(newCtx, *req.DepartmentID); err != nil {
+					return err
+				}
+			}
+		}
+
+		positionID := employee.PositionID
+		if req.PositionID != nil {
+			positionID = req.PositionID
+		}
+
+		if positionID != nil && *positionID > 0 && (req.PositionID != nil || req.DepartmentID != nil) {
+			positions, err := o.OrganizationDom.GetPositions(newCtx, entity.GetPositionFilter{ID: *positionID})
+			if err != nil {
+				return err
+			}
+
+			if len(positions) < 1 {
+				return x.NewWithCode(http.StatusNotFound, "position not found")
+			}
+
+			if departmentID == nil || positions[0].DepartmentID != *departmentID {
+				return x.NewWithCode(http.StatusBadRequest, "position does not belong to the employee's department")
+			}
+		}
+
+		if req.ManagerID != nil && *req.ManagerID > 0 {
+			if err := o.checkManager(newCtx, req.UserID, *req.ManagerID); err != nil {
+				return err
+			}
+		}
+
+		return o.UserDom.UpdateUser(newCtx, entity.UpdateUser{
+			ID:           req.UserID,
+			DepartmentID: req.DepartmentID,
+			PositionID:   req.PositionID,
+			ManagerID:    req.ManagerID,
+		})
+	})
+}
+
+// GetOrgChart builds the reporting tree of active employees. Employees
+// without an active manager are at the top.
+func (o *organization) GetOrgChart(ctx context.Context) ([]entity.OrgChartNode, error) {
+	users, err := o.UserDom.GetUsers(ctx, entity.GetUserFilter{IsActive: pkg.BoolPtr(true)})
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[uint]bool, len(users))
+	for _, u := range users {
+		active[u.ID] = true
+	}
+
+	reports := map[uint][]entity.User{}
+	var roots []entity.User
+	for _, u := range users {
+		u.Password = ""
+		if u.ManagerID != nil && active[*u.ManagerID] && *u.ManagerID != u.ID {
+			reports[*u.ManagerID] = append(reports[*u.ManagerID], u)
+			continue
+		}
+		roots = append(roots, u)
+	}
+
+	var build func(list []entity.User, depth int) []entity.OrgChartNode
+	build = func(list []entity.User, depth int) []entity.OrgChartNode {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+
+		nodes := make([]entity.OrgChartNode, 0, len(list))
+		for _, u := range list {
+			node := entity.OrgChartNode{User: u}
+			if depth < entity.MaxReportingDepth {
+				node.Reports = build(reports[u.ID], depth+1)
+			}
+			nodes = append(nodes, node)
+		}
+		return nodes
+	}
+
+	return build(roots, 0), nil
+}
+
+// GetApproverChain returns the managers above an employee, nearest first,
+// for approval workflows. Deactivated managers are skipped in favour of
+// their own manager. Levels limits how many approvers are returned; zero
+// returns the whole chain.
+func (o *organization) GetApproverChain(ctx context.Context, userID uint, levels int) ([]entity.User, error) {
+	employee, err := o.user(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		chain []entity.User
+		seen  = map[uint]bool{userID: true}
+		next  = employee.ManagerID
+	)
+
+	for depth := 0; next != nil && *next > 0 && depth < entity.MaxReportingDepth; depth++ {
+		if seen[*next] {
+			break
+		}
+		seen[*next] = true
+
+		users, err := o.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: *next})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(users) < 1 {
+			break
+		}
+
+		manager := users[0]
+		manager.Password = ""
+		if manager.IsActive {
+			chain = append(chain, manager)
+			if levels > 0 && len(chain) >= levels {
+				break
+			}
+		}
+
+		next = manager.ManagerID
+	}
+
+	return chain, nil
+}
+
+func (o *organization) user(ctx context.Context, id uint) (*entity.User, error) {
+	users, err := o.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) < 1 {
+		return nil, x.NewWithCode(http.StatusNotFound, "employee not found")
+	}
+
+	return &users[0], nil
+}
+
+func (o *organization) checkDepartment(ctx context.Context, id uint) error {
+	departments, err := o.OrganizationDom.GetDepartments(ctx, entity.GetDepartmentFilter{ID: id})
+	if err != nil {
+		return err
+	}
+
+	if len(departments) < 1 {
+		return x.NewWithCode(http.StatusNotFound, "department not found")
+	}
+
+	return nil
+}
+
+// checkPositionName makes sure the department exists and has no other
+// position with the same name.
+func (o *organization) checkPositionName(ctx context.Context, id uint, name string, departmentID uint) error {
+	if err := o.checkDepartment(ctx, departmentID); err != nil {
+		return err
+	}
+
+	existing, err := o.OrganizationDom.GetPositions(ctx, entity.GetPositionFilter{
+		Name:         name,
+		DepartmentID: departmentID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range existing {
+		if p.ID != id {
+			return x.NewWithCode(http.StatusBadRequest, "position already exists in this department")
+		}
+	}
+
+	return nil
+}
+
+// checkManager rejects managers who are missing, deactivated, the employee
+// themselves or somewhere below the employee in the reporting line.
+func (o *organization) checkManager(ctx context.Context, userID, managerID uint) error {
+	if managerID == userID {
+		return x.NewWithCode(http.StatusBadRequest, "an employee cannot report to themselves")
+	}
+
+	managers, err := o.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: managerID})
+	if err != nil {
+		return err
+	}
+
+	if len(managers) < 1 {
+		return x.NewWithCode(http.StatusNotFound, "manager not found")
+	}
+
+	if !managers[0].IsActive {
+		return x.NewWithCode(http.StatusBadRequest, "manager is deactivated")
+	}
+
+	next := managers[0].ManagerID
+	for depth := 0; next != nil && *next > 0 && depth < entity.MaxReportingDepth; depth++ {
+		if *next == userID {
+			return x.NewWithCode(http.StatusBadRequest, "manager reports to this employee, the reporting line would loop")
+		}
+
+		above, err := o.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: *next})
+		if err != nil {
+			return err
+		}
+
+		if len(above) < 1 {
+			break
+		}
+		next = above[0].ManagerID
+	}
+
+	return nil
+}
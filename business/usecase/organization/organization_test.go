@@ -0,0 +1,298 @@
+package organization_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/organization"
+	mockOrganization "github.com/zuhrulumam/go-hris/mocks/domain/organization"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreatePosition(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.Position
+		setupMocks  func(o *mockOrganization.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "success",
+			input: entity.Position{Name: "Lead", DepartmentID: 2},
+			setupMocks: func(o *mockOrganization.MockDomainItf) {
+				o.EXPECT().GetDepartments(gomock.Any(), entity.GetDepartmentFilter{ID: 2}).
+					Return([]entity.Department{{ID: 2}}, nil)
+				o.EXPECT().GetPositions(gomock.Any(), entity.GetPositionFilter{Name: "Lead", DepartmentID: 2}).
+					Return(nil, nil)
+				o.EXPECT().CreatePosition(gomock.Any(), entity.Position{Name: "Lead", DepartmentID: 2}).
+					Return(nil)
+			},
+		},
+		{
+			name:  "department not found",
+			input: entity.Position{Name: "Lead", DepartmentID: 2},
+			setupMocks: func(o *mockOrganization.MockDomainItf) {
+				o.EXPECT().GetDepartments(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "department not found",
+		},
+		{
+			name:  "duplicate name",
+			input: entity.Position{Name: "Lead", DepartmentID: 2},
+			setupMocks: func(o *mockOrganization.MockDomainItf) {
+				o.EXPECT().GetDepartments(gomock.Any(), gomock.Any()).Return([]entity.Department{{ID: 2}}, nil)
+				o.EXPECT().GetPositions(gomock.Any(), gomock.Any()).Return([]entity.Position{{ID: 1}}, nil)
+			},
+			expectErr:   true,
+			errorString: "position already exists in this department",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrg := mockOrganization.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockOrg)
+
+			usecase := uc.InitOrganizationUsecase(uc.Option{
+				OrganizationDom: mockOrg,
+				TransactionDom:  mockTxDom,
+			})
+
+			err := usecase.CreatePosition(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssignEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.AssignEmployeeRequest
+		setupMocks  func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "assigns department, position and manager",
+			input: entity.AssignEmployeeRequest{UserID: 5, DepartmentID: pkg.UintPtr(2), PositionID: pkg.UintPtr(3), ManagerID: pkg.UintPtr(4)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).Return([]entity.User{{ID: 5}}, nil)
+				o.EXPECT().GetDepartments(gomock.Any(), entity.GetDepartmentFilter{ID: 2}).Return([]entity.Department{{ID: 2}}, nil)
+				o.EXPECT().GetPositions(gomock.Any(), entity.GetPositionFilter{ID: 3}).Return([]entity.Position{{ID: 3, DepartmentID: 2}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 4}).
+					Return([]entity.User{{ID: 4, IsActive: true, ManagerID: pkg.UintPtr(1)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 1}).
+					Return([]entity.User{{ID: 1, IsActive: true}}, nil)
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{
+					ID:           5,
+					DepartmentID: pkg.UintPtr(2),
+					PositionID:   pkg.UintPtr(3),
+					ManagerID:    pkg.UintPtr(4),
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "position from another department",
+			input: entity.AssignEmployeeRequest{UserID: 5, PositionID: pkg.UintPtr(3)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 5, DepartmentID: pkg.UintPtr(1)}}, nil)
+				o.EXPECT().GetPositions(gomock.Any(), gomock.Any()).Return([]entity.Position{{ID: 3, DepartmentID: 2}}, nil)
+			},
+			expectErr:   true,
+			errorString: "position does not belong to the employee's department",
+		},
+		{
+			name:  "reports to self",
+			input: entity.AssignEmployeeRequest{UserID: 5, ManagerID: pkg.UintPtr(5)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 5}}, nil)
+			},
+			expectErr:   true,
+			errorString: "an employee cannot report to themselves",
+		},
+		{
+			name:  "manager reports to the employee",
+			input: entity.AssignEmployeeRequest{UserID: 5, ManagerID: pkg.UintPtr(4)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).Return([]entity.User{{ID: 5}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 4}).
+					Return([]entity.User{{ID: 4, IsActive: true, ManagerID: pkg.UintPtr(6)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 6}).
+					Return([]entity.User{{ID: 6, IsActive: true, ManagerID: pkg.UintPtr(5)}}, nil)
+			},
+			expectErr:   true,
+			errorString: "the reporting line would loop",
+		},
+		{
+			name:  "deactivated manager",
+			input: entity.AssignEmployeeRequest{UserID: 5, ManagerID: pkg.UintPtr(4)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).Return([]entity.User{{ID: 5}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 4}).Return([]entity.User{{ID: 4}}, nil)
+			},
+			expectErr:   true,
+			errorString: "manager is deactivated",
+		},
+		{
+			name:  "clears manager",
+			input: entity.AssignEmployeeRequest{UserID: 5, ManagerID: pkg.UintPtr(0)},
+			setupMocks: func(o *mockOrganization.MockDomainItf, u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 5, ManagerID: pkg.UintPtr(4)}}, nil)
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{ID: 5, ManagerID: pkg.UintPtr(0)}).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrg := mockOrganization.NewMockDomainItf(ctrl)
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockOrg, mockUserDom)
+
+			usecase := uc.InitOrganizationUsecase(uc.Option{
+				OrganizationDom: mockOrg,
+				UserDom:         mockUserDom,
+				TransactionDom:  mockTxDom,
+			})
+
+			err := usecase.AssignEmployee(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetOrgChart(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	// 9 reports to a deactivated manager so is shown at the top
+	mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{IsActive: pkg.BoolPtr(true)}).
+		Return([]entity.User{
+			{ID: 3, ManagerID: pkg.UintPtr(2)},
+			{ID: 1},
+			{ID: 2, ManagerID: pkg.UintPtr(1)},
+			{ID: 4, ManagerID: pkg.UintPtr(1)},
+			{ID: 9, ManagerID: pkg.UintPtr(8)},
+		}, nil)
+
+	usecase := uc.InitOrganizationUsecase(uc.Option{
+		UserDom: mockUserDom,
+	})
+
+	chart, err := usecase.GetOrgChart(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, chart, 2)
+	assert.Equal(t, uint(1), chart[0].User.ID)
+	assert.Equal(t, uint(9), chart[1].User.ID)
+	assert.Len(t, chart[0].Reports, 2)
+	assert.Equal(t, uint(2), chart[0].Reports[0].User.ID)
+	assert.Equal(t, uint(3), chart[0].Reports[0].Reports[0].User.ID)
+	assert.Equal(t, uint(4), chart[0].Reports[1].User.ID)
+}
+
+func TestGetApproverChain(t *testing.T) {
+	tests := []struct {
+		name       string
+		levels     int
+		setupMocks func(u *mockUser.MockDomainItf)
+		want       []uint
+	}{
+		{
+			name: "skips deactivated managers",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).
+					Return([]entity.User{{ID: 5, ManagerID: pkg.UintPtr(4)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 4}).
+					Return([]entity.User{{ID: 4, ManagerID: pkg.UintPtr(2)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 2}).
+					Return([]entity.User{{ID: 2, IsActive: true, ManagerID: pkg.UintPtr(1)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 1}).
+					Return([]entity.User{{ID: 1, IsActive: true}}, nil)
+			},
+			want: []uint{2, 1},
+		},
+		{
+			name:   "limited levels",
+			levels: 1,
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).
+					Return([]entity.User{{ID: 5, ManagerID: pkg.UintPtr(2)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 2}).
+					Return([]entity.User{{ID: 2, IsActive: true, ManagerID: pkg.UintPtr(1)}}, nil)
+			},
+			want: []uint{2},
+		},
+		{
+			name: "stops on a loop",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 5}).
+					Return([]entity.User{{ID: 5, ManagerID: pkg.UintPtr(2)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 2}).
+					Return([]entity.User{{ID: 2, IsActive: true, ManagerID: pkg.UintPtr(5)}}, nil)
+			},
+			want: []uint{2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockUserDom)
+
+			usecase := uc.InitOrganizationUsecase(uc.Option{
+				UserDom: mockUserDom,
+			})
+
+			chain, err := usecase.GetApproverChain(context.Background(), 5, tt.levels)
+
+			assert.NoError(t, err)
+			var got []uint
+			for _, u := range chain {
+				got = append(got, u.ID)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
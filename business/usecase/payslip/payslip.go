@@ -3,21 +3,23 @@ package payslip
 import (
 	"context"
 
-	"github.com/hibiken/asynq"
 	attendanceDom "github.com/zuhrulumam/go-hris/business/domain/attendance"
 	payslipDom "github.com/zuhrulumam/go-hris/business/domain/payslip"
 	reimbursementDom "github.com/zuhrulumam/go-hris/business/domain/reimbursement"
 	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
 	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
 	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/task"
 )
 
 type UsecaseItf interface {
-	CreatePayroll(ctx context.Context, periodID uint) error
+	CreatePayroll(ctx context.Context, periodID uint, requestedBy uint) error
 	GetPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error)
+	ListPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error)
 
 	CreatePayslipForUser(ctx context.Context, data entity.CreatePayslipForUserData) error
 	GetPayrollSummary(ctx context.Context, filter entity.GetPayrollSummaryRequest) (*entity.GetPayrollSummaryResponse, error)
+	GetPayrollVariance(ctx context.Context, req entity.GetPayrollVarianceRequest) (*entity.GetPayrollVarianceResponse, error)
 }
 
 type Option struct {
@@ -26,7 +28,8 @@ type Option struct {
 	AttendanceDom    attendanceDom.DomainItf
 	ReimbursementDom reimbursementDom.DomainItf
 	UserDom          userDom.DomainItf
-	AsynqClient      *asynq.Client
+	AsynqClient      task.Enqueuer
+	LatenessPolicy   entity.LatenessPolicy
 }
 
 type payslip struct {
@@ -35,7 +38,8 @@ type payslip struct {
 	AttendanceDom    attendanceDom.DomainItf
 	ReimbursementDom reimbursementDom.DomainItf
 	UserDom          userDom.DomainItf
-	AsynqClient      *asynq.Client
+	AsynqClient      task.Enqueuer
+	LatenessPolicy   entity.LatenessPolicy
 }
 
 func InitPayslipUsecase(opt Option) UsecaseItf {
@@ -46,6 +50,7 @@ func InitPayslipUsecase(opt Option) UsecaseItf {
 		ReimbursementDom: opt.ReimbursementDom,
 		UserDom:          opt.UserDom,
 		AsynqClient:      opt.AsynqClient,
+		LatenessPolicy:   opt.LatenessPolicy,
 	}
 
 	return p
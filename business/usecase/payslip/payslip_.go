@@ -2,15 +2,21 @@ package payslip
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"net/http"
 	"time"
 
 	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
 	x "github.com/zuhrulumam/go-hris/pkg/errors"
 	"github.com/zuhrulumam/go-hris/task"
 )
 
 func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error) {
+	if filter.Status == nil {
+		filter.Status = pkg.StringPtr(entity.PayslipStatusIssued)
+	}
 
 	payslips, totalData, totalPage, err := p.PayslipDom.GetPayslip(ctx, filter)
 	if err != nil {
@@ -24,12 +30,77 @@ func (p *payslip) GetPayslip(ctx context.Context, filter entity.GetPayslipReques
 	return payslips, totalData, totalPage, nil
 }
 
-func (p *payslip) CreatePayroll(ctx context.Context, periodID uint) error {
+var payslipSortColumns = map[string]bool{
+	"attendance_period_id": true,
+	"total_pay":            true,
+	"created_at":           true,
+}
+
+func (p *payslip) ListPayslip(ctx context.Context, filter entity.GetPayslipRequest) ([]entity.Payslip, int64, int, error) {
+	if filter.SortBy != "" && !payslipSortColumns[filter.SortBy] {
+		return nil, 0, 0, x.NewWithCode(http.StatusBadRequest, "invalid sort field")
+	}
+
+	// Payslips voided by a payroll rerun only show up when asked for
+	if filter.Status == nil {
+		filter.Status = pkg.StringPtr(entity.PayslipStatusIssued)
+	}
+
+	// Unlike GetPayslip, an empty page is a valid result for a listing
+	return p.PayslipDom.GetPayslip(ctx, filter)
+}
+
+// CreatePayroll queues payslip jobs for a locked period and moves it to
+// payroll processing, so its attendance stays frozen while payslips are made.
+func (p *payslip) CreatePayroll(ctx context.Context, periodID uint, requestedBy uint) error {
 
 	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
-		// Get All Users
-		users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{
-			Role: string(entity.RoleEmployee),
+		periods, err := p.AttendanceDom.GetAttendancePeriods(newCtx, entity.GetAttendancePeriodFilter{
+			ID: fmt.Sprint(periodID),
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(periods) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "attendance period not found")
+		}
+
+		period := periods[0]
+		if period.Status != entity.AttendancePeriodLocked {
+			return x.NewWithCode(http.StatusConflict, fmt.Sprintf("attendance period is %s, lock it before running payroll", period.Status))
+		}
+
+		processing := entity.AttendancePeriodPayrollProcessing
+		err = p.AttendanceDom.UpdateAttendancePeriod(newCtx, entity.UpdateAttendancePeriod{
+			ID:             period.ID,
+			Status:         &processing,
+			ExpectedStatus: period.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = p.AttendanceDom.CreateAttendancePeriodTransition(newCtx, entity.AttendancePeriodTransition{
+			AttendancePeriodID: period.ID,
+			FromStatus:         period.Status,
+			ToStatus:           processing,
+			ChangedBy:          &requestedBy,
+			Note:               "payroll started",
+			CreatedAt:          time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+
+		// A rerun after the period was reopened replaces the earlier payslips
+		if err := p.PayslipDom.VoidPayslips(newCtx, period.ID, 0); err != nil {
+			return err
+		}
+
+		// Everyone on the payroll is paid, whatever their role
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{
+			IsActive: pkg.BoolPtr(true),
 		})
 		if err != nil {
 			return err
@@ -37,6 +108,10 @@ func (p *payslip) CreatePayroll(ctx context.Context, periodID uint) error {
 
 		// queue task to asynq
 		for _, user := range users {
+			if user.Salary <= 0 {
+				continue
+			}
+
 			// Insert PayrollJob
 			job := entity.PayrollJob{
 				AttendancePeriodID: periodID,
@@ -133,7 +208,12 @@ func (p *payslip) CreatePayslipForUser(ctx context.Context, data entity.CreatePa
 
 		attendanceAmount := (float64(attendedDays) / float64(workingDays)) * salary
 		overtimeAmount := overtimeHours * (salary / float64(workingDays)) * 1.5
-		totalPay := attendanceAmount + overtimeAmount + reimbursementTotal
+
+		lateMinutes, earlyLeaveMinutes, latenessDeduction := p.latenessDeduction(userAttendances, salary/float64(workingDays))
+		// A deduction never takes more than what was earned for attendance
+		latenessDeduction = math.Min(latenessDeduction, attendanceAmount)
+
+		totalPay := attendanceAmount + overtimeAmount + reimbursementTotal - latenessDeduction
 
 		payslip = entity.Payslip{
 			UserID:             data.UserID,
@@ -145,11 +225,19 @@ func (p *payslip) CreatePayslipForUser(ctx context.Context, data entity.CreatePa
 			OvertimeHours:      overtimeHours,
 			OvertimePay:        overtimeAmount,
 			ReimbursementTotal: reimbursementTotal,
+			LateMinutes:        lateMinutes,
+			EarlyLeaveMinutes:  earlyLeaveMinutes,
+			LatenessDeduction:  latenessDeduction,
 			TotalPay:           totalPay,
+			Status:             entity.PayslipStatusIssued,
 			CreatedAt:          time.Now(),
 		}
 
-		// Save payslip
+		// Save payslip, replacing one from an earlier run of the same job
+		if err := p.PayslipDom.VoidPayslips(newCtx, data.PeriodID, data.UserID); err != nil {
+			return err
+		}
+
 		err = p.PayslipDom.CreatePayslip(newCtx, []entity.Payslip{payslip})
 		if err != nil {
 			return x.WrapWithCode(err, http.StatusInternalServerError, "failed to save payslips")
@@ -168,6 +256,42 @@ func (p *payslip) CreatePayslipForUser(ctx context.Context, data entity.CreatePa
 	})
 }
 
+// defaultDailyMinutes is the scheduled working time a daily rate pays for
+// when the lateness policy does not set one.
+const defaultDailyMinutes = 480
+
+// latenessDeduction totals late and early-leave minutes over the attendances
+// and prices the chargeable part under the lateness policy.
+func (p *payslip) latenessDeduction(attendances []entity.Attendance, dailyRate float64) (int, int, float64) {
+	var (
+		lateMinutes, earlyLeaveMinutes, chargeable int
+		policy                                     = p.LatenessPolicy
+	)
+
+	for _, att := range attendances {
+		lateMinutes += att.LateMinutes
+		earlyLeaveMinutes += att.EarlyLeaveMinutes
+
+		if att.LateMinutes > policy.GraceMinutes {
+			chargeable += att.LateMinutes
+		}
+		if policy.IncludeEarlyLeave && att.EarlyLeaveMinutes > policy.GraceMinutes {
+			chargeable += att.EarlyLeaveMinutes
+		}
+	}
+
+	if !policy.Enabled {
+		return lateMinutes, earlyLeaveMinutes, 0
+	}
+
+	dailyMinutes := policy.DailyMinutes
+	if dailyMinutes <= 0 {
+		dailyMinutes = defaultDailyMinutes
+	}
+
+	return lateMinutes, earlyLeaveMinutes, float64(chargeable) * dailyRate / float64(dailyMinutes)
+}
+
 func (p *payslip) GetPayrollSummary(ctx context.Context, filter entity.GetPayrollSummaryRequest) (*entity.GetPayrollSummaryResponse, error) {
 	summary, err := p.PayslipDom.GetPayrollSummary(ctx, filter)
 	if err != nil {
@@ -180,3 +304,113 @@ func (p *payslip) GetPayrollSummary(ctx context.Context, filter entity.GetPayrol
 
 	return summary, nil
 }
+
+const defaultVarianceThresholdPercent = 10
+
+func (p *payslip) GetPayrollVariance(ctx context.Context, req entity.GetPayrollVarianceRequest) (*entity.GetPayrollVarianceResponse, error) {
+	if req.PreviousPeriodID == 0 || req.CurrentPeriodID == 0 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "both previous and current period IDs are required")
+	}
+
+	if req.PreviousPeriodID == req.CurrentPeriodID {
+		return nil, x.NewWithCode(http.StatusBadRequest, "previous and current period must be different")
+	}
+
+	if req.ThresholdPercent < 0 {
+		return nil, x.NewWithCode(http.StatusBadRequest, "threshold cannot be negative")
+	}
+
+	if req.ThresholdPercent == 0 {
+		req.ThresholdPercent = defaultVarianceThresholdPercent
+	}
+
+	rows, err := p.PayslipDom.GetPayslipComponents(ctx, []uint{req.PreviousPeriodID, req.CurrentPeriodID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) < 1 {
+		return nil, x.NewWithCode(http.StatusNotFound, "payslips not found for the given periods")
+	}
+
+	// Pair each employee's payslips by period, keeping first-seen order (rows come sorted by username)
+	var (
+		order    []uint
+		previous = map[uint]entity.PayslipComponentItem{}
+		current  = map[uint]entity.PayslipComponentItem{}
+		names    = map[uint]string{}
+	)
+
+	for _, row := range rows {
+		if _, ok := names[row.UserID]; !ok {
+			order = append(order, row.UserID)
+			names[row.UserID] = row.Username
+		}
+
+		if row.AttendancePeriodID == req.PreviousPeriodID {
+			previous[row.UserID] = row
+		} else {
+			current[row.UserID] = row
+		}
+	}
+
+	resp := &entity.GetPayrollVarianceResponse{
+		PreviousPeriodID: req.PreviousPeriodID,
+		CurrentPeriodID:  req.CurrentPeriodID,
+		ThresholdPercent: req.ThresholdPercent,
+	}
+
+	for _, userID := range order {
+		prev, cur := previous[userID], current[userID]
+
+		item := entity.PayrollVarianceItem{
+			UserID:   userID,
+			Username: names[userID],
+			Components: []entity.PayrollVarianceComponent{
+				compareComponent("base_salary", prev.BaseSalary, cur.BaseSalary, req.ThresholdPercent),
+				compareComponent("attendance_amount", prev.AttendanceAmount, cur.AttendanceAmount, req.ThresholdPercent),
+				compareComponent("overtime_pay", prev.OvertimePay, cur.OvertimePay, req.ThresholdPercent),
+				compareComponent("reimbursement_total", prev.ReimbursementTotal, cur.ReimbursementTotal, req.ThresholdPercent),
+				compareComponent("lateness_deduction", prev.LatenessDeduction, cur.LatenessDeduction, req.ThresholdPercent),
+				compareComponent("total_pay", prev.TotalPay, cur.TotalPay, req.ThresholdPercent),
+			},
+		}
+
+		for _, c := range item.Components {
+			if c.OverThreshold {
+				item.OverThreshold = true
+				break
+			}
+		}
+
+		if item.OverThreshold {
+			resp.FlaggedCount++
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+// compareComponent computes the delta between two periods. A component that
+// appears from zero has no percentage and is flagged whenever it changes.
+func compareComponent(name string, previous, current, threshold float64) entity.PayrollVarianceComponent {
+	c := entity.PayrollVarianceComponent{
+		Component: name,
+		Previous:  previous,
+		Current:   current,
+		Delta:     current - previous,
+	}
+
+	if previous == 0 {
+		c.OverThreshold = c.Delta != 0
+		return c
+	}
+
+	pct := c.Delta / previous * 100
+	c.DeltaPercent = &pct
+	c.OverThreshold = math.Abs(pct) > threshold
+
+	return c
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/assert"
 	"github.com/zuhrulumam/go-hris/business/entity"
 	uc "github.com/zuhrulumam/go-hris/business/usecase/payslip"
@@ -13,6 +14,7 @@ import (
 	mockReimbursement "github.com/zuhrulumam/go-hris/mocks/domain/reimbursement"
 	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
 	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	mockTask "github.com/zuhrulumam/go-hris/mocks/task"
 	"github.com/zuhrulumam/go-hris/pkg"
 	"go.uber.org/mock/gomock"
 )
@@ -31,6 +33,10 @@ func TestGetPayslip(t *testing.T) {
 		UserID: pkg.UintPtr(1),
 	}
 
+	// Only issued payslips unless another status is asked for
+	issued := filter
+	issued.Status = pkg.StringPtr(entity.PayslipStatusIssued)
+
 	expectedPayslips := []entity.Payslip{
 		{
 			ID:     1,
@@ -78,7 +84,7 @@ func TestGetPayslip(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockPayslipDom.EXPECT().
-				GetPayslip(gomock.Any(), filter).
+				GetPayslip(gomock.Any(), issued).
 				Return(tt.mockReturnData, tt.mockTotalData, tt.mockTotalPage, tt.mockErr)
 
 			result, totalData, totalPage, err := usecase.GetPayslip(context.Background(), filter)
@@ -99,69 +105,112 @@ func TestGetPayslip(t *testing.T) {
 	}
 }
 
-// func TestCreatePayroll(t *testing.T) {
-// 	ctrl := gomock.NewController(t)
-// 	defer ctrl.Finish()
-
-// 	mockTransactionDom := mockTx.NewMockDomainItf(ctrl)
-// 	mockUserDom := mockUser.NewMockDomainItf(ctrl)
-// 	mockPayslipDom := mockPayslip.NewMockDomainItf(ctrl)
-// 	mockAsynqClient := mockAsynq.NewMockClient(ctrl)
-
-// 	// override task function
-// 	originalCreateTask := task.NewCreatePayrollTask
-// 	defer func() {
-// 		task.NewCreatePayrollTask = originalCreateTask
-// 	}()
-
-// 	usecase := uc.InitPayslipUsecase(uc.Option{
-// 		TransactionDom: mockTransactionDom,
-// 		UserDom:        mockUserDom,
-// 		PayslipDom:     mockPayslipDom,
-// 		AsynqClient:    mockAsynqClient,
-// 	})
-
-// 	periodID := uint(10)
-// 	users := []entity.User{
-// 		{ID: 1},
-// 		{ID: 2},
-// 	}
-
-// 	payrollJobs := []entity.PayrollJob{
-// 		{ID: 1001, UserID: 1, AttendancePeriodID: periodID},
-// 		{ID: 1002, UserID: 2, AttendancePeriodID: periodID},
-// 	}
-
-// 	fakeTask := asynq.NewTask("fake", []byte("payload"))
-
-// 	task.NewCreatePayrollTask = func(periodID, userID, jobID uint) (*asynq.Task, error) {
-// 		return fakeTask, nil
-// 	}
-
-// 	mockTransactionDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
-// 		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
-// 			return fn(ctx)
-// 		})
-
-// 	mockUserDom.EXPECT().
-// 		GetUsers(gomock.Any(), entity.GetUserFilter{Role: string(entity.RoleEmployee)}).
-// 		Return(users, nil)
-
-// 	for i, user := range users {
-// 		mockPayslipDom.EXPECT().
-// 			CreatePayrollJob(gomock.Any(), gomock.AssignableToTypeOf(entity.PayrollJob{})).
-// 			DoAndReturn(func(_ context.Context, job entity.PayrollJob) (entity.PayrollJob, error) {
-// 				return payrollJobs[i], nil
-// 			})
-
-// 		mockAsynqClient.EXPECT().
-// 			Enqueue(fakeTask).
-// 			Return(&asynq.TaskInfo{}, nil)
-// 	}
-
-// 	err := usecase.CreatePayroll(context.Background(), periodID)
-// 	assert.NoError(t, err)
-// }
+func TestCreatePayroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTransactionDom := mockTx.NewMockDomainItf(ctrl)
+	mockAttendanceDom := mockAttendance.NewMockDomainItf(ctrl)
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockPayslipDom := mockPayslip.NewMockDomainItf(ctrl)
+	mockEnqueuer := mockTask.NewMockEnqueuer(ctrl)
+
+	usecase := uc.InitPayslipUsecase(uc.Option{
+		TransactionDom: mockTransactionDom,
+		AttendanceDom:  mockAttendanceDom,
+		UserDom:        mockUserDom,
+		PayslipDom:     mockPayslipDom,
+		AsynqClient:    mockEnqueuer,
+	})
+
+	periodID := uint(10)
+
+	mockTransactionDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	// Locked again after a reopen, so payslips from the first run exist
+	mockAttendanceDom.EXPECT().GetAttendancePeriods(gomock.Any(), gomock.Any()).
+		Return([]entity.AttendancePeriod{{ID: periodID, Status: entity.AttendancePeriodLocked}}, nil)
+	mockAttendanceDom.EXPECT().UpdateAttendancePeriod(gomock.Any(), gomock.Any()).Return(nil)
+	mockAttendanceDom.EXPECT().CreateAttendancePeriodTransition(gomock.Any(), gomock.Any()).Return(nil)
+
+	mockPayslipDom.EXPECT().VoidPayslips(gomock.Any(), periodID, uint(0)).Return(nil)
+
+	// Every active role is paid, but only users with a salary
+	mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{IsActive: pkg.BoolPtr(true)}).
+		Return([]entity.User{
+			{ID: 1, Role: entity.RoleEmployee, Salary: 5000000},
+			{ID: 2, Role: entity.RoleManager, Salary: 9000000},
+			{ID: 3, Role: entity.RoleAdmin},
+		}, nil)
+
+	var queued []uint
+	mockPayslipDom.EXPECT().CreatePayrollJob(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job entity.PayrollJob) (*entity.PayrollJob, error) {
+			assert.Equal(t, periodID, job.AttendancePeriodID)
+			queued = append(queued, job.UserID)
+			job.ID = 100 + job.UserID
+			return &job, nil
+		}).Times(2)
+	mockEnqueuer.EXPECT().Enqueue(gomock.Any()).Return(&asynq.TaskInfo{}, nil).Times(2)
+
+	err := usecase.CreatePayroll(context.Background(), periodID, 99)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2}, queued)
+}
+
+func TestCreatePayrollRequiresLockedPeriod(t *testing.T) {
+	tests := []struct {
+		name        string
+		periods     []entity.AttendancePeriod
+		errorString string
+	}{
+		{
+			name:        "period not found",
+			errorString: "attendance period not found",
+		},
+		{
+			name:        "period still open",
+			periods:     []entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodOpen}},
+			errorString: "attendance period is open, lock it before running payroll",
+		},
+		{
+			name:        "payroll already running",
+			periods:     []entity.AttendancePeriod{{ID: 10, Status: entity.AttendancePeriodPayrollProcessing}},
+			errorString: "attendance period is payroll_processing, lock it before running payroll",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTransactionDom := mockTx.NewMockDomainItf(ctrl)
+			mockAttendanceDom := mockAttendance.NewMockDomainItf(ctrl)
+
+			mockTransactionDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+
+			mockAttendanceDom.EXPECT().
+				GetAttendancePeriods(gomock.Any(), entity.GetAttendancePeriodFilter{ID: "10"}).
+				Return(tt.periods, nil)
+
+			usecase := uc.InitPayslipUsecase(uc.Option{
+				TransactionDom: mockTransactionDom,
+				AttendanceDom:  mockAttendanceDom,
+			})
+
+			err := usecase.CreatePayroll(context.Background(), 10, 99)
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorString)
+		})
+	}
+}
 
 func TestGetPayrollSummary(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -293,6 +342,7 @@ func TestCreatePayslipForUser(t *testing.T) {
 					UserID: userID, AttendancePeriodID: periodID,
 				}).Return([]entity.Reimbursement{{Amount: 100000}}, nil)
 
+				mockPayslipDom.EXPECT().VoidPayslips(gomock.Any(), periodID, userID).Return(nil)
 				mockPayslipDom.EXPECT().CreatePayslip(gomock.Any(), gomock.Any()).Return(nil)
 				mockPayslipDom.EXPECT().UpdatePayslipJob(gomock.Any(), entity.UpdatePayslipJob{
 					ID: jobID, Status: "completed",
@@ -351,6 +401,7 @@ func TestCreatePayslipForUser(t *testing.T) {
 				mockReimbursementDom.EXPECT().GetReimbursements(gomock.Any(), gomock.Any()).
 					Return([]entity.Reimbursement{}, nil)
 
+				mockPayslipDom.EXPECT().VoidPayslips(gomock.Any(), periodID, userID).Return(nil)
 				mockPayslipDom.EXPECT().CreatePayslip(gomock.Any(), gomock.Any()).
 					Return(errors.New("db error"))
 			},
@@ -378,3 +429,274 @@ func TestCreatePayslipForUser(t *testing.T) {
 		})
 	}
 }
+
+func TestCreatePayslipForUser_LatenessDeduction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTx := mockTx.NewMockDomainItf(ctrl)
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockAttendanceDom := mockAttendance.NewMockDomainItf(ctrl)
+	mockReimbursementDom := mockReimbursement.NewMockDomainItf(ctrl)
+	mockPayslipDom := mockPayslip.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitPayslipUsecase(uc.Option{
+		TransactionDom:   mockTx,
+		UserDom:          mockUserDom,
+		AttendanceDom:    mockAttendanceDom,
+		ReimbursementDom: mockReimbursementDom,
+		PayslipDom:       mockPayslipDom,
+		LatenessPolicy: entity.LatenessPolicy{
+			Enabled:           true,
+			GraceMinutes:      10,
+			IncludeEarlyLeave: true,
+		},
+	})
+
+	mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	// 22 working days puts the daily rate at 100000
+	mockUserDom.EXPECT().GetUsers(gomock.Any(), gomock.Any()).
+		Return([]entity.User{{ID: 1, Salary: 2200000}}, nil)
+
+	mockAttendanceDom.EXPECT().GetAttendance(gomock.Any(), gomock.Any()).
+		Return([]entity.Attendance{
+			{ID: 1, LateMinutes: 20},
+			{ID: 2, LateMinutes: 5}, // within grace
+			{ID: 3, EarlyLeaveMinutes: 30},
+		}, nil)
+
+	mockAttendanceDom.EXPECT().GetOvertime(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockReimbursementDom.EXPECT().GetReimbursements(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	mockPayslipDom.EXPECT().VoidPayslips(gomock.Any(), uint(100), uint(1)).Return(nil)
+	mockPayslipDom.EXPECT().CreatePayslip(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, payslips []entity.Payslip) error {
+			assert.Len(t, payslips, 1)
+			assert.Equal(t, 25, payslips[0].LateMinutes)
+			assert.Equal(t, 30, payslips[0].EarlyLeaveMinutes)
+			// 50 chargeable minutes at 100000 per 480 minutes
+			assert.InDelta(t, 10416.67, payslips[0].LatenessDeduction, 0.01)
+			assert.InDelta(t, 300000-10416.67, payslips[0].TotalPay, 0.01)
+			return nil
+		})
+	mockPayslipDom.EXPECT().UpdatePayslipJob(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := usecase.CreatePayslipForUser(context.Background(), entity.CreatePayslipForUserData{
+		UserID:   1,
+		PeriodID: 100,
+		JobID:    500,
+	})
+	assert.NoError(t, err)
+}
+
+func TestGetPayrollVariance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayslipDom := mockPayslip.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitPayslipUsecase(uc.Option{
+		PayslipDom: mockPayslipDom,
+	})
+
+	rows := []entity.PayslipComponentItem{
+		{UserID: 1, Username: "alice", AttendancePeriodID: 1, BaseSalary: 5000000, AttendanceAmount: 5000000, TotalPay: 5000000},
+		{UserID: 1, Username: "alice", AttendancePeriodID: 2, BaseSalary: 5000000, AttendanceAmount: 4800000, TotalPay: 4800000},
+		{UserID: 2, Username: "bob", AttendancePeriodID: 1, BaseSalary: 4000000, AttendanceAmount: 4000000, TotalPay: 4000000},
+		{UserID: 2, Username: "bob", AttendancePeriodID: 2, BaseSalary: 4000000, AttendanceAmount: 4000000, OvertimePay: 500000, TotalPay: 4500000},
+	}
+
+	tests := []struct {
+		name          string
+		req           entity.GetPayrollVarianceRequest
+		mockSetup     func()
+		expectError   bool
+		errorContains string
+		assertResult  func(t *testing.T, res *entity.GetPayrollVarianceResponse)
+	}{
+		{
+			name: "success with default threshold",
+			req:  entity.GetPayrollVarianceRequest{PreviousPeriodID: 1, CurrentPeriodID: 2},
+			mockSetup: func() {
+				mockPayslipDom.EXPECT().
+					GetPayslipComponents(gomock.Any(), []uint{1, 2}).
+					Return(rows, nil)
+			},
+			assertResult: func(t *testing.T, res *entity.GetPayrollVarianceResponse) {
+				assert.Equal(t, float64(10), res.ThresholdPercent)
+				assert.Len(t, res.Items, 2)
+				assert.Equal(t, 1, res.FlaggedCount)
+
+				// alice: -4% total pay, under threshold
+				assert.Equal(t, "alice", res.Items[0].Username)
+				assert.False(t, res.Items[0].OverThreshold)
+				assert.InDelta(t, -4, *res.Items[0].Components[5].DeltaPercent, 0.001)
+
+				// bob: overtime appears from zero, always flagged
+				assert.Equal(t, "bob", res.Items[1].Username)
+				assert.True(t, res.Items[1].OverThreshold)
+				assert.Nil(t, res.Items[1].Components[2].DeltaPercent)
+				assert.True(t, res.Items[1].Components[2].OverThreshold)
+			},
+		},
+		{
+			name: "custom threshold flags smaller change",
+			req:  entity.GetPayrollVarianceRequest{PreviousPeriodID: 1, CurrentPeriodID: 2, ThresholdPercent: 3},
+			mockSetup: func() {
+				mockPayslipDom.EXPECT().
+					GetPayslipComponents(gomock.Any(), []uint{1, 2}).
+					Return(rows, nil)
+			},
+			assertResult: func(t *testing.T, res *entity.GetPayrollVarianceResponse) {
+				assert.Equal(t, 2, res.FlaggedCount)
+				assert.True(t, res.Items[0].OverThreshold)
+			},
+		},
+		{
+			name:          "same period",
+			req:           entity.GetPayrollVarianceRequest{PreviousPeriodID: 1, CurrentPeriodID: 1},
+			mockSetup:     func() {},
+			expectError:   true,
+			errorContains: "must be different",
+		},
+		{
+			name:          "missing period",
+			req:           entity.GetPayrollVarianceRequest{CurrentPeriodID: 2},
+			mockSetup:     func() {},
+			expectError:   true,
+			errorContains: "period IDs are required",
+		},
+		{
+			name: "no payslips",
+			req:  entity.GetPayrollVarianceRequest{PreviousPeriodID: 1, CurrentPeriodID: 2},
+			mockSetup: func() {
+				mockPayslipDom.EXPECT().
+					GetPayslipComponents(gomock.Any(), []uint{1, 2}).
+					Return([]entity.PayslipComponentItem{}, nil)
+			},
+			expectError:   true,
+			errorContains: "payslips not found",
+		},
+		{
+			name: "error from domain",
+			req:  entity.GetPayrollVarianceRequest{PreviousPeriodID: 1, CurrentPeriodID: 2},
+			mockSetup: func() {
+				mockPayslipDom.EXPECT().
+					GetPayslipComponents(gomock.Any(), []uint{1, 2}).
+					Return(nil, errors.New("db error"))
+			},
+			expectError:   true,
+			errorContains: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockSetup()
+
+			result, err := usecase.GetPayrollVariance(context.Background(), tt.req)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+			} else {
+				assert.NoError(t, err)
+				tt.assertResult(t, result)
+			}
+		})
+	}
+}
+
+func TestListPayslip(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPayslipDom := mockPayslip.NewMockDomainItf(ctrl)
+
+	usecase := uc.InitPayslipUsecase(uc.Option{
+		PayslipDom: mockPayslipDom,
+	})
+
+	tests := []struct {
+		name          string
+		filter        entity.GetPayslipRequest
+		mockSetup     func(filter entity.GetPayslipRequest)
+		expectedLen   int
+		expectError   bool
+		errorContains string
+	}{
+		{
+			name:   "success list own history",
+			filter: entity.GetPayslipRequest{UserID: pkg.UintPtr(1), SortBy: "total_pay", SortDesc: true, Page: 1, Limit: 10},
+			mockSetup: func(filter entity.GetPayslipRequest) {
+				mockPayslipDom.EXPECT().GetPayslip(gomock.Any(), filter).
+					Return([]entity.Payslip{{ID: 1}, {ID: 2}}, int64(2), 1, nil)
+			},
+			expectedLen: 2,
+		},
+		{
+			name:   "empty page is not an error",
+			filter: entity.GetPayslipRequest{UserID: pkg.UintPtr(1), Page: 3},
+			mockSetup: func(filter entity.GetPayslipRequest) {
+				mockPayslipDom.EXPECT().GetPayslip(gomock.Any(), filter).
+					Return([]entity.Payslip{}, int64(2), 1, nil)
+			},
+			expectedLen: 0,
+		},
+		{
+			name:   "voided payslips on request",
+			filter: entity.GetPayslipRequest{AttendancePeriodID: pkg.UintPtr(7), Status: pkg.StringPtr(entity.PayslipStatusVoid)},
+			mockSetup: func(filter entity.GetPayslipRequest) {
+				mockPayslipDom.EXPECT().GetPayslip(gomock.Any(), filter).
+					Return([]entity.Payslip{{ID: 3, Status: entity.PayslipStatusVoid}}, int64(1), 1, nil)
+			},
+			expectedLen: 1,
+		},
+		{
+			name:          "invalid sort field",
+			filter:        entity.GetPayslipRequest{SortBy: "password"},
+			mockSetup:     func(entity.GetPayslipRequest) {},
+			expectError:   true,
+			errorContains: "invalid sort field",
+		},
+		{
+			name:   "error from domain",
+			filter: entity.GetPayslipRequest{},
+			mockSetup: func(filter entity.GetPayslipRequest) {
+				mockPayslipDom.EXPECT().GetPayslip(gomock.Any(), filter).
+					Return(nil, int64(0), 0, errors.New("db error"))
+			},
+			expectError:   true,
+			errorContains: "db error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.filter
+			if expected.Status == nil {
+				expected.Status = pkg.StringPtr(entity.PayslipStatusIssued)
+			}
+			tt.mockSetup(expected)
+
+			result, _, _, err := usecase.ListPayslip(context.Background(), tt.filter)
+
+			if tt.expectError {
+				assert.Error(t, err)
+				if tt.errorContains != "" {
+					assert.Contains(t, err.Error(), tt.errorContains)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectedLen)
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package reimbursement
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
 )
 
 func (p *reimbursement) SubmitReimbursement(ctx context.Context, data entity.SubmitReimbursementData) error {
@@ -12,12 +14,16 @@ func (p *reimbursement) SubmitReimbursement(ctx context.Context, data entity.Sub
 
 		attPeriod, err := p.AttendanceDom.GetAttendancePeriods(newCtx, entity.GetAttendancePeriodFilter{
 			ContainsDate: &data.Date,
-			Status:       "open",
+			Status:       entity.AttendancePeriodOpen,
 		})
 		if err != nil {
 			return err
 		}
 
+		if len(attPeriod) < 1 {
+			return x.NewWithCode(http.StatusBadRequest, "no open attendance period for this date")
+		}
+
 		data.AttendancePeriodID = attPeriod[0].ID
 
 		err = p.ReimbursementDom.SubmitReimbursement(ctx, data)
@@ -143,6 +143,21 @@ func TestSubmitReimbursement(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "no open attendance period",
+			mockSetup: func() {
+				mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					},
+				)
+
+				mockAttendance.EXPECT().
+					GetAttendancePeriods(gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			expectErr: true,
+		},
 		{
 			name: "submit reimbursement error",
 			mockSetup: func() {
@@ -0,0 +1,41 @@
+package shift
+
+import (
+	"context"
+
+	shiftDom "github.com/zuhrulumam/go-hris/business/domain/shift"
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	CreateShift(ctx context.Context, req entity.CreateShiftRequest) error
+	UpdateShift(ctx context.Context, data entity.UpdateShift) error
+	GetShifts(ctx context.Context, filter entity.GetShiftFilter) ([]entity.Shift, error)
+
+	AssignWeeklyRoster(ctx context.Context, req entity.AssignWeeklyRosterRequest) error
+	GetRosters(ctx context.Context, filter entity.GetShiftRosterFilter) ([]entity.ShiftRoster, error)
+}
+
+type Option struct {
+	ShiftDom       shiftDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+type shift struct {
+	ShiftDom       shiftDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+func InitShiftUsecase(opt Option) UsecaseItf {
+	s := &shift{
+		ShiftDom:       opt.ShiftDom,
+		UserDom:        opt.UserDom,
+		TransactionDom: opt.TransactionDom,
+	}
+
+	return s
+}
@@ -0,0 +1,178 @@
+package shift
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *shift) CreateShift(ctx context.Context, req entity.CreateShiftRequest) error {
+	if req.Name == "" {
+		return x.NewWithCode(http.StatusBadRequest, "shift name is required")
+	}
+
+	crossesMidnight, err := validateShiftTimes(req.StartTime, req.EndTime, req.BreakMinutes)
+	if err != nil {
+		return err
+	}
+
+	return s.ShiftDom.CreateShift(ctx, entity.Shift{
+		Name:            req.Name,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		BreakMinutes:    req.BreakMinutes,
+		CrossesMidnight: crossesMidnight,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	})
+}
+
+func (s *shift) UpdateShift(ctx context.Context, data entity.UpdateShift) error {
+	return s.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		shifts, err := s.ShiftDom.GetShifts(newCtx, entity.GetShiftFilter{ID: data.ID})
+		if err != nil {
+			return err
+		}
+
+		if len(shifts) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "shift not found")
+		}
+
+		// Validate the shift as it will look after the update
+		current := shifts[0]
+		if data.StartTime != nil {
+			current.StartTime = *data.StartTime
+		}
+		if data.EndTime != nil {
+			current.EndTime = *data.EndTime
+		}
+		if data.BreakMinutes != nil {
+			current.BreakMinutes = *data.BreakMinutes
+		}
+
+		crossesMidnight, err := validateShiftTimes(current.StartTime, current.EndTime, current.BreakMinutes)
+		if err != nil {
+			return err
+		}
+
+		data.CrossesMidnight = &crossesMidnight
+
+		return s.ShiftDom.UpdateShift(newCtx, data)
+	})
+}
+
+func (s *shift) GetShifts(ctx context.Context, filter entity.GetShiftFilter) ([]entity.Shift, error) {
+	return s.ShiftDom.GetShifts(ctx, filter)
+}
+
+func (s *shift) AssignWeeklyRoster(ctx context.Context, req entity.AssignWeeklyRosterRequest) error {
+	if req.WeekStart.Weekday() != time.Monday {
+		return x.NewWithCode(http.StatusBadRequest, "week must start on a Monday")
+	}
+
+	weekStart := time.Date(req.WeekStart.Year(), req.WeekStart.Month(), req.WeekStart.Day(), 0, 0, 0, 0, req.WeekStart.Location())
+	weekEnd := weekStart.AddDate(0, 0, 6)
+
+	return s.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		users, err := s.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: req.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "user not found")
+		}
+
+		var (
+			shiftIDs []uint
+			rosters  []entity.ShiftRoster
+		)
+
+		for i, shiftID := range req.ShiftIDs {
+			if shiftID == nil {
+				continue
+			}
+
+			shiftIDs = append(shiftIDs, *shiftID)
+			rosters = append(rosters, entity.ShiftRoster{
+				UserID:    req.UserID,
+				ShiftID:   *shiftID,
+				Date:      weekStart.AddDate(0, 0, i),
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			})
+		}
+
+		if len(shiftIDs) > 0 {
+			shifts, err := s.ShiftDom.GetShifts(newCtx, entity.GetShiftFilter{IDs: shiftIDs})
+			if err != nil {
+				return err
+			}
+
+			known := map[uint]bool{}
+			for _, sh := range shifts {
+				known[sh.ID] = true
+			}
+
+			for _, id := range shiftIDs {
+				if !known[id] {
+					return x.NewWithCode(http.StatusBadRequest, "unknown shift in roster")
+				}
+			}
+		}
+
+		// Replace the whole week so days taken off the roster are removed
+		err = s.ShiftDom.DeleteRosters(newCtx, entity.DeleteShiftRosterFilter{
+			UserID:    req.UserID,
+			StartDate: weekStart,
+			EndDate:   weekEnd,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(rosters) == 0 {
+			return nil
+		}
+
+		return s.ShiftDom.CreateRosters(newCtx, rosters)
+	})
+}
+
+func (s *shift) GetRosters(ctx context.Context, filter entity.GetShiftRosterFilter) ([]entity.ShiftRoster, error) {
+	return s.ShiftDom.GetRosters(ctx, filter)
+}
+
+// validateShiftTimes checks the HH:MM values and reports whether the shift
+// ends on the following day.
+func validateShiftTimes(startTime, endTime string, breakMinutes int) (bool, error) {
+	start, err := time.Parse(entity.ShiftTimeLayout, startTime)
+	if err != nil {
+		return false, x.NewWithCode(http.StatusBadRequest, "invalid shift start time, expected HH:MM")
+	}
+
+	end, err := time.Parse(entity.ShiftTimeLayout, endTime)
+	if err != nil {
+		return false, x.NewWithCode(http.StatusBadRequest, "invalid shift end time, expected HH:MM")
+	}
+
+	if start.Equal(end) {
+		return false, x.NewWithCode(http.StatusBadRequest, "shift start and end cannot be the same")
+	}
+
+	crossesMidnight := end.Before(start)
+	if crossesMidnight {
+		end = end.Add(24 * time.Hour)
+	}
+
+	if breakMinutes < 0 || time.Duration(breakMinutes)*time.Minute >= end.Sub(start) {
+		return false, x.NewWithCode(http.StatusBadRequest, "break must be shorter than the shift")
+	}
+
+	return crossesMidnight, nil
+}
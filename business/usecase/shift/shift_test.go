@@ -0,0 +1,233 @@
+package shift_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/shift"
+	mockShift "github.com/zuhrulumam/go-hris/mocks/domain/shift"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateShift(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.CreateShiftRequest
+		setupMocks  func(s mockShift.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success night shift",
+			input: entity.CreateShiftRequest{
+				Name:         "Night",
+				StartTime:    "22:00",
+				EndTime:      "06:00",
+				BreakMinutes: 60,
+			},
+			setupMocks: func(s mockShift.MockDomainItf) {
+				s.EXPECT().CreateShift(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, data entity.Shift) error {
+						assert.True(t, data.CrossesMidnight)
+						return nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid start time",
+			input: entity.CreateShiftRequest{
+				Name:      "Broken",
+				StartTime: "25:00",
+				EndTime:   "06:00",
+			},
+			setupMocks:  func(s mockShift.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "invalid shift start time",
+		},
+		{
+			name: "break longer than shift",
+			input: entity.CreateShiftRequest{
+				Name:         "Short",
+				StartTime:    "09:00",
+				EndTime:      "10:00",
+				BreakMinutes: 60,
+			},
+			setupMocks:  func(s mockShift.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "break must be shorter than the shift",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockShift)
+
+			usecase := uc.InitShiftUsecase(uc.Option{
+				ShiftDom: mockShift,
+			})
+
+			err := usecase.CreateShift(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssignWeeklyRoster(t *testing.T) {
+	monday := time.Date(2025, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.AssignWeeklyRosterRequest
+		setupMocks  func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success",
+			input: entity.AssignWeeklyRosterRequest{
+				UserID:    2,
+				WeekStart: monday,
+				ShiftIDs:  [7]*uint{pkg.UintPtr(1), pkg.UintPtr(1), nil, nil, nil, pkg.UintPtr(3), nil},
+			},
+			setupMocks: func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 2}).
+							Return([]entity.User{{ID: 2}}, nil)
+
+						s.EXPECT().GetShifts(gomock.Any(), entity.GetShiftFilter{IDs: []uint{1, 1, 3}}).
+							Return([]entity.Shift{{ID: 1}, {ID: 3}}, nil)
+
+						s.EXPECT().DeleteRosters(gomock.Any(), entity.DeleteShiftRosterFilter{
+							UserID:    2,
+							StartDate: monday,
+							EndDate:   monday.AddDate(0, 0, 6),
+						}).Return(nil)
+
+						s.EXPECT().CreateRosters(gomock.Any(), gomock.Any()).
+							DoAndReturn(func(ctx context.Context, rosters []entity.ShiftRoster) error {
+								assert.Len(t, rosters, 3)
+								assert.Equal(t, monday.AddDate(0, 0, 5), rosters[2].Date)
+								assert.Equal(t, uint(3), rosters[2].ShiftID)
+								return nil
+							})
+
+						return fn(ctx)
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name: "week not starting on monday",
+			input: entity.AssignWeeklyRosterRequest{
+				UserID:    2,
+				WeekStart: monday.AddDate(0, 0, 1),
+			},
+			setupMocks:  func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "week must start on a Monday",
+		},
+		{
+			name: "user not found",
+			input: entity.AssignWeeklyRosterRequest{
+				UserID:    99,
+				WeekStart: monday,
+			},
+			setupMocks: func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "user not found",
+		},
+		{
+			name: "unknown shift",
+			input: entity.AssignWeeklyRosterRequest{
+				UserID:    2,
+				WeekStart: monday,
+				ShiftIDs:  [7]*uint{pkg.UintPtr(7)},
+			},
+			setupMocks: func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 2}}, nil)
+						s.EXPECT().GetShifts(gomock.Any(), gomock.Any()).Return(nil, nil)
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "unknown shift in roster",
+		},
+		{
+			name: "delete rosters failed",
+			input: entity.AssignWeeklyRosterRequest{
+				UserID:    2,
+				WeekStart: monday,
+			},
+			setupMocks: func(s mockShift.MockDomainItf, u mockUser.MockDomainItf, tx mockTx.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 2}}, nil)
+						s.EXPECT().DeleteRosters(gomock.Any(), gomock.Any()).Return(errors.New("delete failed"))
+						return fn(ctx)
+					})
+			},
+			expectErr:   true,
+			errorString: "delete failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockShift := mockShift.NewMockDomainItf(ctrl)
+			mockUser := mockUser.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(*mockShift, *mockUser, *mockTx)
+
+			usecase := uc.InitShiftUsecase(uc.Option{
+				ShiftDom:       mockShift,
+				UserDom:        mockUser,
+				TransactionDom: mockTx,
+			})
+
+			err := usecase.AssignWeeklyRoster(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package signingkey
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	signingKeyDom "github.com/zuhrulumam/go-hris/business/domain/signingkey"
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+type UsecaseItf interface {
+	// RotateKeys creates a signing key when none exists or the newest is
+	// due for rotation, and retires keys that are no longer needed.
+	RotateKeys(ctx context.Context) error
+	// LoadKeys reads the active keys into the shared key set so tokens are
+	// signed with the newest one that has activated. Each API instance calls
+	// it every signing key reload interval.
+	LoadKeys(ctx context.Context) error
+
+	// Keyfunc finds the key a token was signed with. An unknown kid reloads
+	// the key set first, since another instance may have rotated already.
+	Keyfunc(token *jwt.Token) (interface{}, error)
+	JWKS() []pkg.JWK
+}
+
+type Option struct {
+	SigningKeyDom  signingKeyDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+	KeySet         *pkg.KeySet
+	Policy         entity.KeyRotationPolicy
+}
+
+type signingKey struct {
+	SigningKeyDom  signingKeyDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+	KeySet         *pkg.KeySet
+	Policy         entity.KeyRotationPolicy
+
+	reloadMu   sync.Mutex
+	lastReload time.Time
+}
+
+func InitSigningKeyUsecase(opt Option) UsecaseItf {
+	s := &signingKey{
+		SigningKeyDom:  opt.SigningKeyDom,
+		TransactionDom: opt.TransactionDom,
+		KeySet:         opt.KeySet,
+		Policy:         opt.Policy,
+	}
+
+	return s
+}
@@ -0,0 +1,120 @@
+package signingkey
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (s *signingKey) RotateKeys(ctx context.Context) error {
+	now := time.Now()
+
+	return s.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		keys, err := s.SigningKeyDom.GetSigningKeys(newCtx, entity.GetSigningKeyFilter{ActiveOnly: true})
+		if err != nil {
+			return err
+		}
+
+		if len(keys) == 0 || now.Sub(keys[0].CreatedAt) >= s.Policy.Interval {
+			// Instances verify with the new key before any token is signed
+			// with it; the first key has no tokens to wait for
+			activatesAt := now
+			if len(keys) > 0 {
+				activatesAt = now.Add(s.Policy.PublishAhead)
+			}
+
+			kid, privatePEM, publicPEM, err := pkg.GenerateSigningKey(s.Policy.Algorithm)
+			if err != nil {
+				return x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate signing key")
+			}
+
+			created, err := s.SigningKeyDom.CreateSigningKey(newCtx, entity.SigningKey{
+				KeyID:       kid,
+				Algorithm:   s.Policy.Algorithm,
+				PrivateKey:  privatePEM,
+				PublicKey:   publicPEM,
+				CreatedAt:   now,
+				ActivatesAt: activatesAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			keys = append([]entity.SigningKey{*created}, keys...)
+		}
+
+		// Older keys are kept until tokens they signed have expired
+		if now.Sub(keys[0].ActivatesAt) < s.Policy.RetireAfter {
+			return nil
+		}
+
+		var retire []uint
+		for _, k := range keys[1:] {
+			retire = append(retire, k.ID)
+		}
+
+		return s.SigningKeyDom.RetireSigningKeys(newCtx, retire, now)
+	})
+}
+
+func (s *signingKey) LoadKeys(ctx context.Context) error {
+	keys, err := s.SigningKeyDom.GetSigningKeys(ctx, entity.GetSigningKeyFilter{ActiveOnly: true})
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return x.NewWithCode(http.StatusInternalServerError, "no active signing keys")
+	}
+
+	parsed := make([]pkg.SigningKey, 0, len(keys))
+	for _, k := range keys {
+		key, err := pkg.ParseSigningKey(k.KeyID, k.Algorithm, k.PrivateKey)
+		if err != nil {
+			return x.WrapWithCode(err, http.StatusInternalServerError, "failed to parse signing key")
+		}
+		key.ActivatesAt = k.ActivatesAt
+		parsed = append(parsed, key)
+	}
+
+	s.KeySet.Replace(parsed)
+
+	return nil
+}
+
+// unknownKidReloadInterval limits how often tokens with an unknown kid can
+// make an instance reload its keys, so forged kids cannot flood the database.
+const unknownKidReloadInterval = 10 * time.Second
+
+func (s *signingKey) Keyfunc(token *jwt.Token) (interface{}, error) {
+	key, err := s.KeySet.Keyfunc(token)
+	if !errors.Is(err, pkg.ErrUnknownKeyID) || !s.reloadForUnknownKid() {
+		return key, err
+	}
+
+	return s.KeySet.Keyfunc(token)
+}
+
+// reloadForUnknownKid reloads the key set unless it was reloaded for an
+// unknown kid recently. It reports whether the keys were reloaded.
+func (s *signingKey) reloadForUnknownKid() bool {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+
+	if time.Since(s.lastReload) < unknownKidReloadInterval {
+		return false
+	}
+	s.lastReload = time.Now()
+
+	return s.LoadKeys(context.Background()) == nil
+}
+
+func (s *signingKey) JWKS() []pkg.JWK {
+	return s.KeySet.JWKS()
+}
@@ -0,0 +1,200 @@
+package signingkey_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/signingkey"
+	mockSigningKey "github.com/zuhrulumam/go-hris/mocks/domain/signingkey"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRotateKeys(t *testing.T) {
+	policy := entity.KeyRotationPolicy{
+		Algorithm:    pkg.AlgEdDSA,
+		Interval:     30 * 24 * time.Hour,
+		PublishAhead: 2 * time.Minute,
+		RetireAfter:  25 * time.Minute,
+	}
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		active       []entity.SigningKey
+		expectNew    bool
+		expectAhead  bool // the new key is published before it signs
+		expectRetire []uint
+	}{
+		{
+			name:      "first key signs straight away",
+			expectNew: true,
+		},
+		{
+			name: "retire older keys once their tokens have expired",
+			active: []entity.SigningKey{
+				{ID: 2, CreatedAt: now.Add(-time.Hour), ActivatesAt: now.Add(-58 * time.Minute)},
+				{ID: 1, CreatedAt: now.Add(-31 * 24 * time.Hour), ActivatesAt: now.Add(-31 * 24 * time.Hour)},
+			},
+			expectRetire: []uint{1},
+		},
+		{
+			name:        "new key is published before it signs and keeps the previous one",
+			active:      []entity.SigningKey{{ID: 1, CreatedAt: now.Add(-31 * 24 * time.Hour), ActivatesAt: now.Add(-31 * 24 * time.Hour)}},
+			expectNew:   true,
+			expectAhead: true,
+		},
+		{
+			name: "older keys kept while the newest has not signed for long",
+			active: []entity.SigningKey{
+				{ID: 2, CreatedAt: now.Add(-30 * time.Minute), ActivatesAt: now.Add(-10 * time.Minute)},
+				{ID: 1, CreatedAt: now.Add(-31 * 24 * time.Hour), ActivatesAt: now.Add(-31 * 24 * time.Hour)},
+			},
+		},
+		{
+			name: "older keys kept while the newest is only published",
+			active: []entity.SigningKey{
+				{ID: 2, CreatedAt: now.Add(-time.Minute), ActivatesAt: now.Add(time.Minute)},
+				{ID: 1, CreatedAt: now.Add(-31 * 24 * time.Hour), ActivatesAt: now.Add(-31 * 24 * time.Hour)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSigningKeyDom := mockSigningKey.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			mockSigningKeyDom.EXPECT().GetSigningKeys(gomock.Any(), entity.GetSigningKeyFilter{ActiveOnly: true}).
+				Return(tt.active, nil)
+
+			if tt.expectNew {
+				mockSigningKeyDom.EXPECT().CreateSigningKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, k entity.SigningKey) (*entity.SigningKey, error) {
+						assert.Equal(t, pkg.AlgEdDSA, k.Algorithm)
+						assert.NotEmpty(t, k.KeyID)
+						assert.Contains(t, k.PrivateKey, "PRIVATE KEY")
+						if tt.expectAhead {
+							assert.Equal(t, k.CreatedAt.Add(policy.PublishAhead), k.ActivatesAt)
+						} else {
+							assert.Equal(t, k.CreatedAt, k.ActivatesAt)
+						}
+						k.ID = 9
+						return &k, nil
+					})
+			}
+
+			if tt.expectRetire != nil {
+				mockSigningKeyDom.EXPECT().RetireSigningKeys(gomock.Any(), tt.expectRetire, gomock.Any()).
+					Return(nil)
+			}
+
+			usecase := uc.InitSigningKeyUsecase(uc.Option{
+				SigningKeyDom:  mockSigningKeyDom,
+				TransactionDom: mockTxDom,
+				Policy:         policy,
+			})
+
+			assert.NoError(t, usecase.RotateKeys(context.Background()))
+		})
+	}
+}
+
+func TestLoadKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kid, privatePEM, _, err := pkg.GenerateSigningKey(pkg.AlgRS256)
+	assert.NoError(t, err)
+
+	mockSigningKeyDom := mockSigningKey.NewMockDomainItf(ctrl)
+	mockSigningKeyDom.EXPECT().GetSigningKeys(gomock.Any(), entity.GetSigningKeyFilter{ActiveOnly: true}).
+		Return([]entity.SigningKey{{ID: 1, KeyID: kid, Algorithm: pkg.AlgRS256, PrivateKey: privatePEM}}, nil)
+
+	usecase := uc.InitSigningKeyUsecase(uc.Option{
+		SigningKeyDom: mockSigningKeyDom,
+		KeySet:        pkg.NewKeySet(),
+	})
+
+	assert.NoError(t, usecase.LoadKeys(context.Background()))
+
+	jwks := usecase.JWKS()
+	assert.Len(t, jwks, 1)
+	assert.Equal(t, kid, jwks[0].Kid)
+	assert.Equal(t, "RSA", jwks[0].Kty)
+}
+
+func TestLoadKeys_NoKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSigningKeyDom := mockSigningKey.NewMockDomainItf(ctrl)
+	mockSigningKeyDom.EXPECT().GetSigningKeys(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	usecase := uc.InitSigningKeyUsecase(uc.Option{
+		SigningKeyDom: mockSigningKeyDom,
+		KeySet:        pkg.NewKeySet(),
+	})
+
+	err := usecase.LoadKeys(context.Background())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no active signing keys")
+}
+
+func TestKeyfunc_ReloadsOnUnknownKid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	oldKid, oldPEM, _, err := pkg.GenerateSigningKey(pkg.AlgEdDSA)
+	assert.NoError(t, err)
+	newKid, newPEM, _, err := pkg.GenerateSigningKey(pkg.AlgEdDSA)
+	assert.NoError(t, err)
+
+	oldKey, err := pkg.ParseSigningKey(oldKid, pkg.AlgEdDSA, oldPEM)
+	assert.NoError(t, err)
+	newKey, err := pkg.ParseSigningKey(newKid, pkg.AlgEdDSA, newPEM)
+	assert.NoError(t, err)
+
+	// Another instance already signs with the new key
+	signer := pkg.NewKeySet()
+	signer.Replace([]pkg.SigningKey{newKey, oldKey})
+	token, err := signer.GenerateJWT(pkg.CustomClaims{UserID: 1}, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+
+	keySet := pkg.NewKeySet()
+	keySet.Replace([]pkg.SigningKey{oldKey})
+
+	mockSigningKeyDom := mockSigningKey.NewMockDomainItf(ctrl)
+	mockSigningKeyDom.EXPECT().GetSigningKeys(gomock.Any(), entity.GetSigningKeyFilter{ActiveOnly: true}).
+		Return([]entity.SigningKey{
+			{ID: 2, KeyID: newKid, Algorithm: pkg.AlgEdDSA, PrivateKey: newPEM},
+			{ID: 1, KeyID: oldKid, Algorithm: pkg.AlgEdDSA, PrivateKey: oldPEM},
+		}, nil).Times(1)
+
+	usecase := uc.InitSigningKeyUsecase(uc.Option{
+		SigningKeyDom: mockSigningKeyDom,
+		KeySet:        keySet,
+	})
+
+	_, err = jwt.Parse(token, usecase.Keyfunc)
+	assert.NoError(t, err)
+
+	// A second unknown kid right away does not reload again
+	unknown := jwt.New(jwt.SigningMethodEdDSA)
+	unknown.Header["kid"] = "forged"
+	_, err = usecase.Keyfunc(unknown)
+	assert.ErrorIs(t, err, pkg.ErrUnknownKeyID)
+}
@@ -0,0 +1,44 @@
+package timeclock
+
+import (
+	"context"
+
+	locationDom "github.com/zuhrulumam/go-hris/business/domain/location"
+	timeClockDom "github.com/zuhrulumam/go-hris/business/domain/timeclock"
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	RegisterTimeClock(ctx context.Context, req entity.RegisterTimeClockRequest) (*entity.TimeClock, error)
+	GetTimeClocks(ctx context.Context, filter entity.GetTimeClockFilter) ([]entity.TimeClock, error)
+
+	MapEmployee(ctx context.Context, req entity.MapTimeClockEmployeeRequest) error
+	GetPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) ([]entity.TimeClockPunch, error)
+}
+
+type Option struct {
+	TimeClockDom   timeClockDom.DomainItf
+	LocationDom    locationDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+type timeClock struct {
+	TimeClockDom   timeClockDom.DomainItf
+	LocationDom    locationDom.DomainItf
+	UserDom        userDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+func InitTimeClockUsecase(opt Option) UsecaseItf {
+	t := &timeClock{
+		TimeClockDom:   opt.TimeClockDom,
+		LocationDom:    opt.LocationDom,
+		UserDom:        opt.UserDom,
+		TransactionDom: opt.TransactionDom,
+	}
+
+	return t
+}
@@ -0,0 +1,89 @@
+package timeclock
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (t *timeClock) RegisterTimeClock(ctx context.Context, req entity.RegisterTimeClockRequest) (*entity.TimeClock, error) {
+	if req.Name == "" {
+		return nil, x.NewWithCode(http.StatusBadRequest, "time clock name is required")
+	}
+
+	if req.OfficeLocationID != nil {
+		offices, err := t.LocationDom.GetOfficeLocations(ctx, entity.GetOfficeLocationFilter{ID: *req.OfficeLocationID})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(offices) < 1 {
+			return nil, x.NewWithCode(http.StatusNotFound, "office location not found")
+		}
+	}
+
+	secret, err := pkg.GenerateDeviceSecret()
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate time clock secret")
+	}
+
+	return t.TimeClockDom.CreateTimeClock(ctx, entity.TimeClock{
+		Name:             req.Name,
+		OfficeLocationID: req.OfficeLocationID,
+		Secret:           secret,
+		Active:           true,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	})
+}
+
+func (t *timeClock) GetTimeClocks(ctx context.Context, filter entity.GetTimeClockFilter) ([]entity.TimeClock, error) {
+	return t.TimeClockDom.GetTimeClocks(ctx, filter)
+}
+
+func (t *timeClock) MapEmployee(ctx context.Context, req entity.MapTimeClockEmployeeRequest) error {
+	if req.EmployeeCode == "" {
+		return x.NewWithCode(http.StatusBadRequest, "employee code is required")
+	}
+
+	return t.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		users, err := t.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: req.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "user not found")
+		}
+
+		existing, err := t.TimeClockDom.GetTimeClockEmployees(newCtx, entity.GetTimeClockEmployeeFilter{
+			EmployeeCode: req.EmployeeCode,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Re-mapping a code moves it to the new user
+		if len(existing) > 0 {
+			return t.TimeClockDom.UpdateTimeClockEmployee(newCtx, entity.UpdateTimeClockEmployee{
+				ID:     existing[0].ID,
+				UserID: req.UserID,
+			})
+		}
+
+		return t.TimeClockDom.CreateTimeClockEmployee(newCtx, entity.TimeClockEmployee{
+			EmployeeCode: req.EmployeeCode,
+			UserID:       req.UserID,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})
+	})
+}
+
+func (t *timeClock) GetPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) ([]entity.TimeClockPunch, error) {
+	return t.TimeClockDom.GetTimeClockPunches(ctx, filter)
+}
@@ -0,0 +1,94 @@
+package timeclock_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/timeclock"
+	mockTimeClock "github.com/zuhrulumam/go-hris/mocks/domain/timeclock"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestMapEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.MapTimeClockEmployeeRequest
+		setupMocks  func(tc mockTimeClock.MockDomainItf, u mockUser.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "new code",
+			input: entity.MapTimeClockEmployeeRequest{EmployeeCode: "00042", UserID: 1},
+			setupMocks: func(tc mockTimeClock.MockDomainItf, u mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 1}).Return([]entity.User{{ID: 1}}, nil)
+				tc.EXPECT().GetTimeClockEmployees(gomock.Any(), entity.GetTimeClockEmployeeFilter{EmployeeCode: "00042"}).Return(nil, nil)
+				tc.EXPECT().CreateTimeClockEmployee(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.TimeClockEmployee) error {
+						assert.Equal(t, "00042", data.EmployeeCode)
+						assert.Equal(t, uint(1), data.UserID)
+						return nil
+					})
+			},
+			expectErr: false,
+		},
+		{
+			name:  "existing code moves to the new user",
+			input: entity.MapTimeClockEmployeeRequest{EmployeeCode: "00042", UserID: 2},
+			setupMocks: func(tc mockTimeClock.MockDomainItf, u mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 2}}, nil)
+				tc.EXPECT().GetTimeClockEmployees(gomock.Any(), gomock.Any()).
+					Return([]entity.TimeClockEmployee{{ID: 5, EmployeeCode: "00042", UserID: 1}}, nil)
+				tc.EXPECT().UpdateTimeClockEmployee(gomock.Any(), entity.UpdateTimeClockEmployee{ID: 5, UserID: 2}).Return(nil)
+			},
+			expectErr: false,
+		},
+		{
+			name:  "unknown user",
+			input: entity.MapTimeClockEmployeeRequest{EmployeeCode: "00042", UserID: 9},
+			setupMocks: func(tc mockTimeClock.MockDomainItf, u mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "user not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTimeClock := mockTimeClock.NewMockDomainItf(ctrl)
+			mockUser := mockUser.NewMockDomainItf(ctrl)
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					tt.setupMocks(*mockTimeClock, *mockUser)
+					return fn(ctx)
+				})
+
+			usecase := uc.InitTimeClockUsecase(uc.Option{
+				TimeClockDom:   mockTimeClock,
+				UserDom:        mockUser,
+				TransactionDom: mockTx,
+			})
+
+			err := usecase.MapEmployee(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
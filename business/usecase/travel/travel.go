@@ -0,0 +1,34 @@
+package travel
+
+import (
+	"context"
+
+	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	travelDom "github.com/zuhrulumam/go-hris/business/domain/travel"
+	"github.com/zuhrulumam/go-hris/business/entity"
+)
+
+type UsecaseItf interface {
+	SubmitTravelRequest(ctx context.Context, data entity.SubmitTravelRequest) error
+	ReviewTravelRequest(ctx context.Context, data entity.ReviewTravelRequest) error
+	GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error)
+}
+
+type Option struct {
+	TravelDom      travelDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+type travel struct {
+	TravelDom      travelDom.DomainItf
+	TransactionDom transactionDom.DomainItf
+}
+
+func InitTravelUsecase(opt Option) UsecaseItf {
+	t := &travel{
+		TravelDom:      opt.TravelDom,
+		TransactionDom: opt.TransactionDom,
+	}
+
+	return t
+}
@@ -0,0 +1,62 @@
+package travel
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+func (t *travel) SubmitTravelRequest(ctx context.Context, data entity.SubmitTravelRequest) error {
+	if data.EndDate.Before(data.StartDate) {
+		return x.NewWithCode(http.StatusBadRequest, "end date cannot be before start date")
+	}
+
+	return t.TravelDom.CreateTravelRequest(ctx, entity.TravelRequest{
+		UserID:      data.UserID,
+		Destination: data.Destination,
+		Purpose:     data.Purpose,
+		StartDate:   data.StartDate,
+		EndDate:     data.EndDate,
+		Status:      entity.TravelStatusPending,
+	})
+}
+
+func (t *travel) ReviewTravelRequest(ctx context.Context, data entity.ReviewTravelRequest) error {
+	return t.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+
+		requests, err := t.TravelDom.GetTravelRequests(newCtx, entity.GetTravelRequestFilter{
+			ID: data.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(requests) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "travel request not found")
+		}
+
+		if requests[0].Status != entity.TravelStatusPending {
+			return x.NewWithCode(http.StatusBadRequest, "travel request was already reviewed")
+		}
+
+		status := entity.TravelStatusRejected
+		if data.Approve {
+			status = entity.TravelStatusApproved
+		}
+
+		return t.TravelDom.UpdateTravelRequest(newCtx, entity.UpdateTravelRequest{
+			ID:         data.ID,
+			Status:     status,
+			ReviewedBy: data.ReviewerID,
+			ReviewedAt: time.Now(),
+			ReviewNote: data.Note,
+		})
+	})
+}
+
+func (t *travel) GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error) {
+	return t.TravelDom.GetTravelRequests(ctx, filter)
+}
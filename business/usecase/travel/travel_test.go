@@ -0,0 +1,173 @@
+package travel_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	uc "github.com/zuhrulumam/go-hris/business/usecase/travel"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockTravel "github.com/zuhrulumam/go-hris/mocks/domain/travel"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubmitTravelRequest(t *testing.T) {
+	start := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       entity.SubmitTravelRequest
+		setupMocks  func(tr mockTravel.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success",
+			input: entity.SubmitTravelRequest{
+				UserID:      1,
+				Destination: "Surabaya",
+				Purpose:     "Client onboarding",
+				StartDate:   start,
+				EndDate:     start.AddDate(0, 0, 2),
+			},
+			setupMocks: func(tr mockTravel.MockDomainItf) {
+				tr.EXPECT().CreateTravelRequest(gomock.Any(), entity.TravelRequest{
+					UserID:      1,
+					Destination: "Surabaya",
+					Purpose:     "Client onboarding",
+					StartDate:   start,
+					EndDate:     start.AddDate(0, 0, 2),
+					Status:      entity.TravelStatusPending,
+				}).Return(nil)
+			},
+		},
+		{
+			name: "end before start",
+			input: entity.SubmitTravelRequest{
+				UserID:    1,
+				StartDate: start,
+				EndDate:   start.AddDate(0, 0, -1),
+			},
+			setupMocks:  func(tr mockTravel.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "end date cannot be before start date",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTravel := mockTravel.NewMockDomainItf(ctrl)
+			tt.setupMocks(*mockTravel)
+
+			usecase := uc.InitTravelUsecase(uc.Option{
+				TravelDom: mockTravel,
+			})
+
+			err := usecase.SubmitTravelRequest(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReviewTravelRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       entity.ReviewTravelRequest
+		setupMocks  func(tr mockTravel.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "approve pending request",
+			input: entity.ReviewTravelRequest{ID: 3, ReviewerID: 99, Approve: true, Note: "ok"},
+			setupMocks: func(tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), entity.GetTravelRequestFilter{ID: 3}).
+					Return([]entity.TravelRequest{{ID: 3, Status: entity.TravelStatusPending}}, nil)
+				tr.EXPECT().UpdateTravelRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.UpdateTravelRequest) error {
+						assert.Equal(t, entity.TravelStatusApproved, data.Status)
+						assert.Equal(t, uint(99), data.ReviewedBy)
+						assert.Equal(t, "ok", data.ReviewNote)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "reject pending request",
+			input: entity.ReviewTravelRequest{ID: 3, ReviewerID: 99},
+			setupMocks: func(tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), gomock.Any()).
+					Return([]entity.TravelRequest{{ID: 3, Status: entity.TravelStatusPending}}, nil)
+				tr.EXPECT().UpdateTravelRequest(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, data entity.UpdateTravelRequest) error {
+						assert.Equal(t, entity.TravelStatusRejected, data.Status)
+						return nil
+					})
+			},
+		},
+		{
+			name:  "already reviewed",
+			input: entity.ReviewTravelRequest{ID: 3, ReviewerID: 99, Approve: true},
+			setupMocks: func(tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), gomock.Any()).
+					Return([]entity.TravelRequest{{ID: 3, Status: entity.TravelStatusApproved}}, nil)
+			},
+			expectErr:   true,
+			errorString: "travel request was already reviewed",
+		},
+		{
+			name:  "not found",
+			input: entity.ReviewTravelRequest{ID: 3, ReviewerID: 99, Approve: true},
+			setupMocks: func(tr mockTravel.MockDomainItf) {
+				tr.EXPECT().GetTravelRequests(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "travel request not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTx := mockTx.NewMockDomainItf(ctrl)
+			mockTravel := mockTravel.NewMockDomainItf(ctrl)
+
+			mockTx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(*mockTravel)
+
+			usecase := uc.InitTravelUsecase(uc.Option{
+				TravelDom:      mockTravel,
+				TransactionDom: mockTx,
+			})
+
+			err := usecase.ReviewTravelRequest(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				if tt.errorString != "" {
+					assert.Contains(t, err.Error(), tt.errorString)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
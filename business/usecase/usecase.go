@@ -3,10 +3,23 @@ package usecase
 import (
 	"github.com/hibiken/asynq"
 	"github.com/zuhrulumam/go-hris/business/domain"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase/access"
 	"github.com/zuhrulumam/go-hris/business/usecase/attendance"
+	"github.com/zuhrulumam/go-hris/business/usecase/device"
+	"github.com/zuhrulumam/go-hris/business/usecase/holiday"
+	"github.com/zuhrulumam/go-hris/business/usecase/kiosk"
+	"github.com/zuhrulumam/go-hris/business/usecase/location"
+	"github.com/zuhrulumam/go-hris/business/usecase/notification"
+	"github.com/zuhrulumam/go-hris/business/usecase/organization"
 	"github.com/zuhrulumam/go-hris/business/usecase/payslip"
 	"github.com/zuhrulumam/go-hris/business/usecase/reimbursement"
+	"github.com/zuhrulumam/go-hris/business/usecase/shift"
+	"github.com/zuhrulumam/go-hris/business/usecase/signingkey"
+	"github.com/zuhrulumam/go-hris/business/usecase/timeclock"
+	"github.com/zuhrulumam/go-hris/business/usecase/travel"
 	"github.com/zuhrulumam/go-hris/business/usecase/user"
+	"github.com/zuhrulumam/go-hris/pkg"
 )
 
 type Usecase struct {
@@ -14,17 +27,55 @@ type Usecase struct {
 	Reimbursement reimbursement.UsecaseItf
 	Payslip       payslip.UsecaseItf
 	User          user.UsecaseItf
+	Shift         shift.UsecaseItf
+	Location      location.UsecaseItf
+	Kiosk         kiosk.UsecaseItf
+	TimeClock     timeclock.UsecaseItf
+	Device        device.UsecaseItf
+	Notification  notification.UsecaseItf
+	Travel        travel.UsecaseItf
+	Holiday       holiday.UsecaseItf
+	Organization  organization.UsecaseItf
+	Access        access.UsecaseItf
+	SigningKey    signingkey.UsecaseItf
 }
 
 type Option struct {
-	AsynqClient *asynq.Client
+	AsynqClient     *asynq.Client
+	LatenessPolicy  entity.LatenessPolicy
+	GeofenceMode    entity.GeofenceMode
+	WFHQuota        int
+	PayrollCalendar entity.PayrollCalendar
+	TokenPolicy     entity.TokenPolicy
+	KeyRotation     entity.KeyRotationPolicy
+	TwoFactor       entity.TwoFactorPolicy
+	LoginThrottle   entity.LoginThrottlePolicy
+	Password        entity.PasswordPolicy
+	PasswordReset   entity.PasswordResetPolicy
+	OIDC            entity.OIDCConfig
+
+	DisableSelfRegistration bool
 }
 
 func Init(dom *domain.Domain, opt Option) *Usecase {
+	// Shared so tokens are signed with the keys LoadKeys reads
+	keySet := pkg.NewKeySet()
+
 	u := &Usecase{
 		Attendance: attendance.InitAttendanceUsecase(attendance.Option{
-			AttendanceDom:  dom.Attendance,
-			TransactionDom: dom.Transaction,
+			AttendanceDom:   dom.Attendance,
+			TransactionDom:  dom.Transaction,
+			ShiftDom:        dom.Shift,
+			LocationDom:     dom.Location,
+			KioskDom:        dom.Kiosk,
+			TimeClockDom:    dom.TimeClock,
+			DeviceDom:       dom.Device,
+			NotificationDom: dom.Notification,
+			TravelDom:       dom.Travel,
+			HolidayDom:      dom.Holiday,
+			GeofenceMode:    opt.GeofenceMode,
+			WFHQuota:        opt.WFHQuota,
+			PayrollCalendar: opt.PayrollCalendar,
 		}),
 		Reimbursement: reimbursement.InitReimbursementUsecase(reimbursement.Option{
 			ReimbursementDom: dom.Reimbursement,
@@ -38,11 +89,74 @@ func Init(dom *domain.Domain, opt Option) *Usecase {
 			ReimbursementDom: dom.Reimbursement,
 			UserDom:          dom.User,
 			AsynqClient:      opt.AsynqClient,
+			LatenessPolicy:   opt.LatenessPolicy,
 		}),
 		User: user.InitUserUsecase(user.Option{
+			UserDom:                 dom.User,
+			AccessDom:               dom.Access,
+			SessionDom:              dom.Session,
+			TwoFactorDom:            dom.TwoFactor,
+			SecurityDom:             dom.Security,
+			MailerDom:               dom.Mailer,
+			SSODom:                  dom.SSO,
+			TransactionDom:          dom.Transaction,
+			AsynqClient:             opt.AsynqClient,
+			TokenPolicy:             opt.TokenPolicy,
+			TwoFactorPolicy:         opt.TwoFactor,
+			LoginThrottle:           opt.LoginThrottle,
+			PasswordPolicy:          opt.Password,
+			PasswordReset:           opt.PasswordReset,
+			OIDC:                    opt.OIDC,
+			KeySet:                  keySet,
+			DisableSelfRegistration: opt.DisableSelfRegistration,
+		}),
+		Shift: shift.InitShiftUsecase(shift.Option{
+			ShiftDom:       dom.Shift,
+			UserDom:        dom.User,
+			TransactionDom: dom.Transaction,
+		}),
+		Location: location.InitLocationUsecase(location.Option{
+			LocationDom: dom.Location,
+		}),
+		Kiosk: kiosk.InitKioskUsecase(kiosk.Option{
+			KioskDom:    dom.Kiosk,
+			LocationDom: dom.Location,
+		}),
+		TimeClock: timeclock.InitTimeClockUsecase(timeclock.Option{
+			TimeClockDom:   dom.TimeClock,
+			LocationDom:    dom.Location,
+			UserDom:        dom.User,
+			TransactionDom: dom.Transaction,
+		}),
+		Device: device.InitDeviceUsecase(device.Option{
+			DeviceDom: dom.Device,
+		}),
+		Notification: notification.InitNotificationUsecase(notification.Option{
+			NotificationDom: dom.Notification,
+		}),
+		Travel: travel.InitTravelUsecase(travel.Option{
+			TravelDom:      dom.Travel,
+			TransactionDom: dom.Transaction,
+		}),
+		Holiday: holiday.InitHolidayUsecase(holiday.Option{
+			HolidayDom: dom.Holiday,
+		}),
+		Organization: organization.InitOrganizationUsecase(organization.Option{
+			OrganizationDom: dom.Organization,
+			UserDom:         dom.User,
+			TransactionDom:  dom.Transaction,
+		}),
+		Access: access.InitAccessUsecase(access.Option{
+			AccessDom:      dom.Access,
 			UserDom:        dom.User,
 			TransactionDom: dom.Transaction,
 		}),
+		SigningKey: signingkey.InitSigningKeyUsecase(signingkey.Option{
+			SigningKeyDom:  dom.SigningKey,
+			TransactionDom: dom.Transaction,
+			KeySet:         keySet,
+			Policy:         opt.KeyRotation,
+		}),
 	}
 
 	return u
@@ -3,30 +3,124 @@ package user
 import (
 	"context"
 
+	accessDom "github.com/zuhrulumam/go-hris/business/domain/access"
+	mailerDom "github.com/zuhrulumam/go-hris/business/domain/mailer"
+	securityDom "github.com/zuhrulumam/go-hris/business/domain/security"
+	sessionDom "github.com/zuhrulumam/go-hris/business/domain/session"
+	ssoDom "github.com/zuhrulumam/go-hris/business/domain/sso"
 	transactionDom "github.com/zuhrulumam/go-hris/business/domain/transaction"
+	twoFactorDom "github.com/zuhrulumam/go-hris/business/domain/twofactor"
 	userDom "github.com/zuhrulumam/go-hris/business/domain/user"
 	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"github.com/zuhrulumam/go-hris/task"
 )
 
 type UsecaseItf interface {
 	Register(ctx context.Context, input entity.RegisterRequest) error
-	Login(ctx context.Context, input entity.LoginRequest) (string, error)
+	Login(ctx context.Context, input entity.LoginRequest) (entity.LoginResult, error)
+	VerifyLogin(ctx context.Context, input entity.VerifyLoginRequest) (entity.AuthTokens, error)
+	EnrollLoginTwoFactor(ctx context.Context, challengeToken string) (entity.TwoFactorEnrollment, error)
+	StartSSO(ctx context.Context) (entity.SSOStart, error)
+	CompleteSSO(ctx context.Context, input entity.SSOCallbackRequest) (entity.LoginResult, error)
+
+	RefreshSession(ctx context.Context, input entity.RefreshSessionRequest) (entity.AuthTokens, error)
+	GetSessions(ctx context.Context, userID uint) ([]entity.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID uint) error
+	RevokeAllSessions(ctx context.Context, userID uint) error
+	IsSessionRevoked(ctx context.Context, sessionID uint) (bool, error)
+
+	GetTwoFactorStatus(ctx context.Context, userID uint) (entity.TwoFactorStatus, error)
+	EnrollTwoFactor(ctx context.Context, userID uint) (entity.TwoFactorEnrollment, error)
+	ConfirmTwoFactor(ctx context.Context, userID uint, code string) ([]string, error)
+	RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error)
+	DisableTwoFactor(ctx context.Context, userID uint, code string) error
+	ResetTwoFactor(ctx context.Context, userID uint) error
+
+	UnlockAccount(ctx context.Context, userID, actorID uint) error
+	UnlockIP(ctx context.Context, ipAddress string, actorID uint) error
+	ListSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error)
+
+	RequestPasswordReset(ctx context.Context, input entity.ForgotPasswordRequest) error
+	SendPasswordResetEmail(ctx context.Context, login string) error
+	SendPasswordReset(ctx context.Context, userID uint) error
+	ResetPassword(ctx context.Context, input entity.ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, input entity.ChangePasswordRequest) (entity.AuthTokens, error)
+
+	CreateEmployee(ctx context.Context, input entity.RegisterRequest) (*entity.User, error)
+	GetEmployee(ctx context.Context, id uint) (*entity.User, error)
+	UpdateEmployee(ctx context.Context, input entity.UpdateEmployeeRequest) error
+	SetEmployeeActive(ctx context.Context, id, actorID uint, active bool) error
+	ListEmployees(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error)
+
+	GetEmployeeProfile(ctx context.Context, userID uint) (*entity.EmployeeProfile, error)
+	UpdateEmployeeProfile(ctx context.Context, input entity.UpdateEmployeeProfile) error
+	UpdateOwnProfile(ctx context.Context, input entity.UpdateOwnProfileRequest) error
 }
 
 type Option struct {
 	UserDom        userDom.DomainItf
+	AccessDom      accessDom.DomainItf
+	SessionDom     sessionDom.DomainItf
+	TwoFactorDom   twoFactorDom.DomainItf
+	SecurityDom    securityDom.DomainItf
+	MailerDom      mailerDom.DomainItf
+	SSODom         ssoDom.DomainItf
 	TransactionDom transactionDom.DomainItf
+	AsynqClient    task.Enqueuer
+
+	TokenPolicy     entity.TokenPolicy
+	TwoFactorPolicy entity.TwoFactorPolicy
+	LoginThrottle   entity.LoginThrottlePolicy
+	PasswordPolicy  entity.PasswordPolicy
+	PasswordReset   entity.PasswordResetPolicy
+	OIDC            entity.OIDCConfig
+	KeySet          *pkg.KeySet
+
+	// DisableSelfRegistration turns off the public register endpoint so
+	// accounts can only be created by an admin.
+	DisableSelfRegistration bool
 }
 
 type user struct {
-	UserDom        userDom.DomainItf
-	TransactionDom transactionDom.DomainItf
+	UserDom                 userDom.DomainItf
+	AccessDom               accessDom.DomainItf
+	SessionDom              sessionDom.DomainItf
+	TwoFactorDom            twoFactorDom.DomainItf
+	SecurityDom             securityDom.DomainItf
+	MailerDom               mailerDom.DomainItf
+	SSODom                  ssoDom.DomainItf
+	TransactionDom          transactionDom.DomainItf
+	AsynqClient             task.Enqueuer
+	TokenPolicy             entity.TokenPolicy
+	TwoFactorPolicy         entity.TwoFactorPolicy
+	LoginThrottle           entity.LoginThrottlePolicy
+	PasswordPolicy          entity.PasswordPolicy
+	PasswordReset           entity.PasswordResetPolicy
+	OIDC                    entity.OIDCConfig
+	KeySet                  *pkg.KeySet
+	DisableSelfRegistration bool
 }
 
 func InitUserUsecase(opt Option) UsecaseItf {
 	p := &user{
-		UserDom:        opt.UserDom,
-		TransactionDom: opt.TransactionDom,
+		UserDom:                 opt.UserDom,
+		AccessDom:               opt.AccessDom,
+		SessionDom:              opt.SessionDom,
+		TwoFactorDom:            opt.TwoFactorDom,
+		SecurityDom:             opt.SecurityDom,
+		MailerDom:               opt.MailerDom,
+		SSODom:                  opt.SSODom,
+		TransactionDom:          opt.TransactionDom,
+		AsynqClient:             opt.AsynqClient,
+		TokenPolicy:             opt.TokenPolicy,
+		TwoFactorPolicy:         opt.TwoFactorPolicy,
+		LoginThrottle:           opt.LoginThrottle,
+		PasswordPolicy:          opt.PasswordPolicy,
+		PasswordReset:           opt.PasswordReset,
+		OIDC:                    opt.OIDC,
+		KeySet:                  opt.KeySet,
+		DisableSelfRegistration: opt.DisableSelfRegistration,
 	}
 
 	return p
@@ -2,33 +2,1540 @@ package user
 
 import (
 	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
 	"github.com/zuhrulumam/go-hris/pkg/tracer"
+	"github.com/zuhrulumam/go-hris/task"
+)
+
+const (
+	// maxChallengeAttempts is how many wrong codes a login challenge takes
+	// before the password has to be entered again.
+	maxChallengeAttempts = 5
+
+	recoveryCodeCount = 10
 )
 
 func (p *user) Register(ctx context.Context, input entity.RegisterRequest) error {
+	if p.DisableSelfRegistration {
+		return x.NewWithCode(http.StatusForbidden, "self-registration is disabled, ask an admin to create your account")
+	}
+
+	if err := p.validatePassword(input.Password); err != nil {
+		return err
+	}
 
 	return p.UserDom.Register(ctx, input)
 }
 
-func (p *user) Login(ctx context.Context, input entity.LoginRequest) (string, error) {
+// Login checks the user's credentials and starts a session with a
+// short-lived access token and a refresh token. Users with two-factor
+// authentication, or whose role requires it, get a challenge instead and
+// finish with VerifyLogin.
+func (p *user) Login(ctx context.Context, input entity.LoginRequest) (entity.LoginResult, error) {
 	ctx, done := tracer.Start(ctx, "useruc.login")
 	defer done()
 
+	user, err := p.checkPassword(ctx, input)
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	result, err := p.finishLogin(ctx, *user, input.UserAgent, input.IPAddress)
+	if err != nil || result.Challenge != nil {
+		return result, err
+	}
+
+	// Only the username is cleared, and only once every factor passed; a
+	// valid account must not reset the count for an address trying many
+	// others
+	if err := p.SecurityDom.ClearFailures(ctx, usernameSubject(input.Username)); err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	return result, nil
+}
+
+// finishLogin starts a session for a user who passed the first factor, a
+// password or single sign-on, or opens a challenge for the second.
+func (p *user) finishLogin(ctx context.Context, user entity.User, userAgent, ipAddress string) (entity.LoginResult, error) {
+	tf, err := p.TwoFactorDom.GetTwoFactor(ctx, user.ID)
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	required, err := p.requiresTwoFactor(ctx, user.Role)
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	now := time.Now()
+	enabled := tf != nil && tf.EnabledAt != nil
+
+	if enabled || required {
+		token, err := pkg.GenerateRefreshToken()
+		if err != nil {
+			return entity.LoginResult{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate login challenge")
+		}
+
+		challenge := entity.LoginChallenge{
+			UserID:    user.ID,
+			TokenHash: pkg.HashRefreshToken(token),
+			UserAgent: userAgent,
+			IPAddress: ipAddress,
+			ExpiresAt: now.Add(p.TwoFactorPolicy.ChallengeTTL),
+			CreatedAt: now,
+		}
+
+		if err := p.TwoFactorDom.CreateLoginChallenge(ctx, challenge); err != nil {
+			return entity.LoginResult{}, err
+		}
+
+		return entity.LoginResult{Challenge: &entity.TwoFactorChallenge{
+			Token:              token,
+			ExpiresAt:          challenge.ExpiresAt,
+			EnrollmentRequired: !enabled,
+		}}, nil
+	}
+
+	var tokens entity.AuthTokens
+
+	err = p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		tokens, err = p.startSession(newCtx, user, userAgent, ipAddress, now)
+		return err
+	})
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	return entity.LoginResult{Tokens: &tokens}, nil
+}
+
+// VerifyLogin completes a login challenge with a TOTP or recovery code.
+// If the user was still enrolling, the code confirms their authenticator
+// and the new recovery codes are handed back with the tokens.
+func (p *user) VerifyLogin(ctx context.Context, input entity.VerifyLoginRequest) (entity.AuthTokens, error) {
+	var (
+		tokens        entity.AuthTokens
+		recoveryCodes []string
+		rejected      *entity.SecurityEvent
+		username      string
+		now           = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		challenge, err := p.openChallenge(newCtx, input.ChallengeToken, now)
+		if err != nil {
+			return err
+		}
+
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: challenge.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 || !users[0].IsActive {
+			return x.NewWithCode(http.StatusForbidden, "account is deactivated")
+		}
+
+		// A lockout also stops guessing on challenges opened before it
+		username = users[0].Username
+		until, err := p.SecurityDom.LockedUntil(newCtx, usernameSubject(username))
+		if err != nil {
+			return err
+		}
+
+		if until != nil {
+			return x.NewWithCode(http.StatusTooManyRequests,
+				fmt.Sprintf("too many failed login attempts, try again after %s", until.UTC().Format(time.RFC3339)))
+		}
+
+		tf, err := p.TwoFactorDom.GetTwoFactor(newCtx, challenge.UserID)
+		if err != nil {
+			return err
+		}
+
+		if tf == nil {
+			return x.NewWithCode(http.StatusBadRequest, "set up an authenticator before verifying")
+		}
+
+		ok, err := p.verifyCode(newCtx, *tf, input.Code, now)
+		if err != nil {
+			return err
+		}
+
+		// Committed so failed attempts count towards the limit
+		if !ok {
+			rejected = &entity.SecurityEvent{
+				Type:      entity.SecurityEventTwoFactorFailed,
+				UserID:    &users[0].ID,
+				Username:  users[0].Username,
+				IPAddress: challenge.IPAddress,
+				UserAgent: challenge.UserAgent,
+				CreatedAt: now,
+			}
+			attempts := challenge.Attempts + 1
+			return p.TwoFactorDom.UpdateLoginChallenge(newCtx, entity.UpdateLoginChallenge{ID: challenge.ID, Attempts: &attempts})
+		}
+
+		if tf.EnabledAt == nil {
+			recoveryCodes, err = p.replaceRecoveryCodes(newCtx, tf.UserID, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = p.TwoFactorDom.UpdateLoginChallenge(newCtx, entity.UpdateLoginChallenge{ID: challenge.ID, CompletedAt: &now})
+		if err != nil {
+			return err
+		}
+
+		tokens, err = p.startSession(newCtx, users[0], challenge.UserAgent, challenge.IPAddress, now)
+		return err
+	})
+	if err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	if rejected != nil {
+		if err := p.SecurityDom.CreateSecurityEvent(ctx, *rejected); err != nil {
+			return entity.AuthTokens{}, err
+		}
+
+		// Counted like a wrong password, so fresh challenges cannot be used
+		// to keep guessing
+		subjects := loginSubjects(entity.LoginRequest{Username: rejected.Username, IPAddress: rejected.IPAddress})
+		if err := p.countFailure(ctx, *rejected, subjects); err != nil {
+			return entity.AuthTokens{}, err
+		}
+
+		return entity.AuthTokens{}, x.NewWithCode(http.StatusUnauthorized, "invalid verification code")
+	}
+
+	if err := p.SecurityDom.ClearFailures(ctx, usernameSubject(username)); err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	tokens.RecoveryCodes = recoveryCodes
+
+	return tokens, nil
+}
+
+// EnrollLoginTwoFactor lets a user whose role requires two-factor
+// authentication set up an authenticator midway through logging in.
+func (p *user) EnrollLoginTwoFactor(ctx context.Context, challengeToken string) (entity.TwoFactorEnrollment, error) {
+	var enrollment entity.TwoFactorEnrollment
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		challenge, err := p.openChallenge(newCtx, challengeToken, time.Now())
+		if err != nil {
+			return err
+		}
+
+		enrollment, err = p.enroll(newCtx, challenge.UserID)
+		return err
+	})
+
+	return enrollment, err
+}
+
+// RefreshSession trades a refresh token for a new access token and a new
+// refresh token. Each refresh token works once; presenting one that was
+// already rotated out means it leaked, so the session is revoked.
+func (p *user) RefreshSession(ctx context.Context, input entity.RefreshSessionRequest) (entity.AuthTokens, error) {
+	refreshToken, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return entity.AuthTokens{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate refresh token")
+	}
+
 	var (
-		isAdmin bool
+		tokens  entity.AuthTokens
+		reused  []uint
+		now     = time.Now()
+		oldHash = pkg.HashRefreshToken(input.RefreshToken)
+		newHash = pkg.HashRefreshToken(refreshToken)
 	)
 
+	err = p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		sessions, err := p.SessionDom.GetSessions(newCtx, entity.GetSessionFilter{RefreshTokenHash: oldHash})
+		if err != nil {
+			return err
+		}
+
+		if len(sessions) < 1 {
+			previous, err := p.SessionDom.GetSessions(newCtx, entity.GetSessionFilter{PreviousTokenHash: oldHash, ActiveOnly: true})
+			if err != nil {
+				return err
+			}
+
+			// Commit the revocation and report the reuse after the transaction
+			for _, s := range previous {
+				reused = append(reused, s.ID)
+			}
+			if len(reused) > 0 {
+				return p.SessionDom.RevokeSessions(newCtx, reused, now)
+			}
+
+			return x.NewWithCode(http.StatusUnauthorized, "invalid refresh token")
+		}
+
+		session := sessions[0]
+		if session.RevokedAt != nil || !session.ExpiresAt.After(now) {
+			return x.NewWithCode(http.StatusUnauthorized, "session has expired, please log in again")
+		}
+
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: session.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 || !users[0].IsActive {
+			return x.NewWithCode(http.StatusForbidden, "account is deactivated")
+		}
+
+		err = p.SessionDom.UpdateSession(newCtx, entity.UpdateSession{
+			ID:                session.ID,
+			CurrentTokenHash:  &oldHash,
+			RefreshTokenHash:  &newHash,
+			PreviousTokenHash: &oldHash,
+			LastUsedAt:        &now,
+		})
+
+		// Another refresh rotated the token first, so it was used twice
+		if x.ErrCode(err) == http.StatusNotFound {
+			reused = []uint{session.ID}
+			return p.SessionDom.RevokeSessions(newCtx, reused, now)
+		}
+		if err != nil {
+			return err
+		}
+
+		// The role is read again so changes apply from the next refresh
+		tokens, err = p.accessToken(users[0], session.ID, now)
+		return err
+	})
+	if err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	if len(reused) > 0 {
+		if err := p.SessionDom.MarkRevoked(ctx, reused, p.TokenPolicy.AccessTTL); err != nil {
+			return entity.AuthTokens{}, err
+		}
+
+		return entity.AuthTokens{}, x.NewWithCode(http.StatusUnauthorized, "refresh token was already used, the session has been revoked")
+	}
+
+	tokens.RefreshToken = refreshToken
+
+	return tokens, nil
+}
+
+// GetSessions lists the user's sessions that are still usable, most
+// recently used first.
+func (p *user) GetSessions(ctx context.Context, userID uint) ([]entity.Session, error) {
+	return p.SessionDom.GetSessions(ctx, entity.GetSessionFilter{UserID: userID, ActiveOnly: true})
+}
+
+// RevokeSession logs out one of the user's sessions.
+func (p *user) RevokeSession(ctx context.Context, userID, sessionID uint) error {
+	revoked, err := p.revokeSessions(ctx, entity.GetSessionFilter{ID: sessionID, UserID: userID}, 0)
+	if err != nil {
+		return err
+	}
+
+	if revoked == 0 {
+		return x.NewWithCode(http.StatusNotFound, "session not found")
+	}
+
+	return nil
+}
+
+// RevokeAllSessions logs the user out everywhere.
+func (p *user) RevokeAllSessions(ctx context.Context, userID uint) error {
+	_, err := p.revokeSessions(ctx, entity.GetSessionFilter{UserID: userID}, 0)
+	return err
+}
+
+func (p *user) IsSessionRevoked(ctx context.Context, sessionID uint) (bool, error) {
+	return p.SessionDom.IsRevoked(ctx, sessionID)
+}
+
+// revokeSessions ends the active sessions matching filter, except keep,
+// and returns how many there were. Revocations are recorded in Redis once
+// committed so access tokens already issued stop working straight away.
+func (p *user) revokeSessions(ctx context.Context, filter entity.GetSessionFilter, keep uint) (int, error) {
+	var ids []uint
+
+	filter.ActiveOnly = true
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		sessions, err := p.SessionDom.GetSessions(newCtx, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range sessions {
+			if s.ID != keep {
+				ids = append(ids, s.ID)
+			}
+		}
+
+		return p.SessionDom.RevokeSessions(newCtx, ids, time.Now())
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := p.SessionDom.MarkRevoked(ctx, ids, p.TokenPolicy.AccessTTL); err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// checkPassword verifies the user's credentials unless the username or IP
+// address is locked out. Repeated failures slow down further attempts and
+// then lock the username or address for a while.
+func (p *user) checkPassword(ctx context.Context, input entity.LoginRequest) (*entity.User, error) {
+	subjects := loginSubjects(input)
+
+	for _, subject := range subjects {
+		until, err := p.SecurityDom.LockedUntil(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+
+		if until != nil {
+			err := p.SecurityDom.CreateSecurityEvent(ctx, entity.SecurityEvent{
+				Type:      entity.SecurityEventLoginBlocked,
+				Username:  input.Username,
+				IPAddress: input.IPAddress,
+				UserAgent: input.UserAgent,
+				CreatedAt: time.Now(),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, x.NewWithCode(http.StatusTooManyRequests,
+				fmt.Sprintf("too many failed login attempts, try again after %s", until.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	failures, err := p.SecurityDom.GetFailures(ctx, subjects[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if delay := p.LoginThrottle.Delay(failures); delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
 	user, err := p.UserDom.Login(ctx, input)
+	if x.ErrCode(err) == http.StatusUnauthorized {
+		if err := p.loginFailed(ctx, input, subjects); err != nil {
+			return nil, err
+		}
+		return nil, err
+	} else if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// loginFailed records a wrong password and counts it against the username
+// and IP address.
+func (p *user) loginFailed(ctx context.Context, input entity.LoginRequest, subjects []string) error {
+	event := entity.SecurityEvent{
+		Type:      entity.SecurityEventLoginFailed,
+		Username:  input.Username,
+		IPAddress: input.IPAddress,
+		UserAgent: input.UserAgent,
+		CreatedAt: time.Now(),
+	}
+
+	if err := p.SecurityDom.CreateSecurityEvent(ctx, event); err != nil {
+		return err
+	}
+
+	return p.countFailure(ctx, event, subjects)
+}
+
+// countFailure counts a failed password or second factor against the
+// username and IP address and locks out whichever reached its limit.
+func (p *user) countFailure(ctx context.Context, event entity.SecurityEvent, subjects []string) error {
+	now := event.CreatedAt
+
+	for i, subject := range subjects {
+		failures, err := p.SecurityDom.RecordFailure(ctx, subject, p.LoginThrottle.Window)
+		if err != nil {
+			return err
+		}
+
+		limit, lockType := p.LoginThrottle.LockAfter, entity.SecurityEventAccountLocked
+		if i > 0 {
+			limit, lockType = p.LoginThrottle.IPLockAfter, entity.SecurityEventIPLocked
+		}
+
+		if limit <= 0 || failures < limit {
+			continue
+		}
+
+		until := now.Add(p.LoginThrottle.LockDuration)
+		if err := p.SecurityDom.Lock(ctx, subject, until); err != nil {
+			return err
+		}
+
+		// The next lockout needs a full set of failures again
+		if err := p.SecurityDom.ClearFailures(ctx, subject); err != nil {
+			return err
+		}
+
+		locked := event
+		locked.Type = lockType
+		locked.Detail = fmt.Sprintf("%d failed attempts, locked until %s", failures, until.UTC().Format(time.RFC3339))
+		if err := p.SecurityDom.CreateSecurityEvent(ctx, locked); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UnlockAccount lifts a lockout on the user's username before it expires.
+func (p *user) UnlockAccount(ctx context.Context, userID, actorID uint) error {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: userID})
+	if err != nil {
+		return err
+	}
+
+	if len(users) < 1 {
+		return x.NewWithCode(http.StatusNotFound, "user not found")
+	}
+
+	unlocked, err := p.SecurityDom.Unlock(ctx, usernameSubject(users[0].Username))
+	if err != nil {
+		return err
+	}
+
+	if !unlocked {
+		return x.NewWithCode(http.StatusNotFound, "account is not locked")
+	}
+
+	return p.SecurityDom.CreateSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventAccountUnlocked,
+		UserID:    &users[0].ID,
+		Username:  users[0].Username,
+		ActorID:   &actorID,
+		CreatedAt: time.Now(),
+	})
+}
+
+// UnlockIP lifts a lockout on an IP address before it expires.
+func (p *user) UnlockIP(ctx context.Context, ipAddress string, actorID uint) error {
+	unlocked, err := p.SecurityDom.Unlock(ctx, ipSubject(ipAddress))
+	if err != nil {
+		return err
+	}
+
+	if !unlocked {
+		return x.NewWithCode(http.StatusNotFound, "IP address is not locked")
+	}
+
+	return p.SecurityDom.CreateSecurityEvent(ctx, entity.SecurityEvent{
+		Type:      entity.SecurityEventIPUnlocked,
+		IPAddress: ipAddress,
+		ActorID:   &actorID,
+		CreatedAt: time.Now(),
+	})
+}
+
+func (p *user) ListSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error) {
+	return p.SecurityDom.GetSecurityEvents(ctx, filter)
+}
+
+// loginSubjects lists what a login attempt is counted against: the
+// username first, then the IP address when known.
+func loginSubjects(input entity.LoginRequest) []string {
+	subjects := []string{usernameSubject(input.Username)}
+	if input.IPAddress != "" {
+		subjects = append(subjects, ipSubject(input.IPAddress))
+	}
+	return subjects
+}
+
+// Usernames are matched case-insensitively so changing case does not
+// reset the count.
+func usernameSubject(username string) string {
+	return "user:" + strings.ToLower(username)
+}
+
+func ipSubject(ip string) string {
+	return "ip:" + ip
+}
+
+// StartSSO begins a single sign-on login and returns the identity
+// provider's sign-in page to send the browser to, with a binding the
+// browser keeps in a cookie. Without it a sign-in started by someone else
+// cannot be finished, so a victim cannot be logged into an attacker's
+// account through a crafted callback link.
+func (p *user) StartSSO(ctx context.Context) (entity.SSOStart, error) {
+	if !p.OIDC.Enabled() {
+		return entity.SSOStart{}, x.NewWithCode(http.StatusNotFound, "single sign-on is not configured")
+	}
+
+	state, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return entity.SSOStart{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate sign-in state")
+	}
+
+	binding, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return entity.SSOStart{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate sign-in binding")
+	}
+
+	nonce, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return entity.SSOStart{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate sign-in nonce")
+	}
+
+	verifier, err := pkg.GeneratePKCEVerifier()
+	if err != nil {
+		return entity.SSOStart{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate PKCE verifier")
+	}
+
+	// Fails while the provider is unreachable, before anything is stored
+	authURL, err := p.SSODom.AuthCodeURL(ctx, state, nonce, pkg.PKCEChallenge(verifier))
+	if err != nil {
+		return entity.SSOStart{}, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(p.OIDC.StateTTL)
+
+	err = p.SSODom.CreateSSOState(ctx, entity.SSOState{
+		StateHash:    pkg.HashRefreshToken(state),
+		BindingHash:  pkg.HashRefreshToken(binding),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return entity.SSOStart{}, err
+	}
+
+	return entity.SSOStart{AuthURL: authURL, Binding: binding, ExpiresAt: expiresAt}, nil
+}
+
+// CompleteSSO finishes a single sign-on login when the provider sends the
+// browser back. The ID token's subject is mapped to a user through a
+// linked identity, or on first sign-on through the email address. The
+// login then continues like a password login, second factor included.
+func (p *user) CompleteSSO(ctx context.Context, input entity.SSOCallbackRequest) (entity.LoginResult, error) {
+	if !p.OIDC.Enabled() {
+		return entity.LoginResult{}, x.NewWithCode(http.StatusNotFound, "single sign-on is not configured")
+	}
+
+	var (
+		state *entity.SSOState
+		now   = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		var err error
+
+		state, err = p.SSODom.GetSSOState(newCtx, pkg.HashRefreshToken(input.State))
+		if err != nil {
+			return err
+		}
+
+		if state == nil || state.UsedAt != nil || now.After(state.ExpiresAt) {
+			return x.NewWithCode(http.StatusBadRequest, "sign-in has expired, please start again")
+		}
+
+		// Left unused so the browser that started it can still finish
+		binding := pkg.HashRefreshToken(input.Binding)
+		if input.Binding == "" || subtle.ConstantTimeCompare([]byte(binding), []byte(state.BindingHash)) != 1 {
+			return x.NewWithCode(http.StatusBadRequest, "sign-in was started in another browser, please start again")
+		}
+
+		return p.SSODom.UseSSOState(newCtx, state.ID, now)
+	})
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	claims, err := p.SSODom.Exchange(ctx, input.Code, state.CodeVerifier)
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(state.Nonce)) != 1 {
+		return entity.LoginResult{}, x.NewWithCode(http.StatusUnauthorized, "ID token nonce does not match")
+	}
+
+	u, err := p.ssoUser(ctx, claims, input, now)
+	if err != nil {
+		return entity.LoginResult{}, err
+	}
+
+	return p.finishLogin(ctx, *u, input.UserAgent, input.IPAddress)
+}
+
+// ssoUser finds the user behind a provider account, linking the account
+// on first sign-on when exactly one user has its email address.
+func (p *user) ssoUser(ctx context.Context, claims entity.OIDCClaims, input entity.SSOCallbackRequest, now time.Time) (*entity.User, error) {
+	identity, err := p.SSODom.GetUserIdentity(ctx, claims.Issuer, claims.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []entity.User
+
+	if identity != nil {
+		users, err = p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: identity.UserID})
+		if err != nil {
+			return nil, err
+		}
+	} else if claims.Email != "" && (claims.EmailVerified || !p.OIDC.RequireVerifiedEmail) {
+		users, err = p.UserDom.GetUsers(ctx, entity.GetUserFilter{Email: claims.Email})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(users) != 1 {
+		err := p.SecurityDom.CreateSecurityEvent(ctx, entity.SecurityEvent{
+			Type:      entity.SecurityEventSSOFailed,
+			Username:  claims.Email,
+			IPAddress: input.IPAddress,
+			UserAgent: input.UserAgent,
+			Detail:    fmt.Sprintf("no user for subject %q at %s", claims.Subject, claims.Issuer),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, x.NewWithCode(http.StatusForbidden, "no account is linked to this identity")
+	}
+
+	u := users[0]
+
+	if !u.IsActive {
+		return nil, x.NewWithCode(http.StatusForbidden, "account is deactivated")
+	}
+
+	if identity == nil {
+		err := p.SSODom.CreateUserIdentity(ctx, entity.UserIdentity{
+			UserID:    u.ID,
+			Issuer:    claims.Issuer,
+			Subject:   claims.Subject,
+			Email:     claims.Email,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &u, nil
+}
+
+// RequestPasswordReset queues a reset link for the account with the given
+// username or email address. The account is looked up and emailed by the
+// worker, so the request answers the same, and as fast, whether or not
+// there is such an account.
+func (p *user) RequestPasswordReset(ctx context.Context, input entity.ForgotPasswordRequest) error {
+	subjects := []string{"reset:" + usernameSubject(input.Login)}
+	if input.IPAddress != "" {
+		subjects = append(subjects, "reset:"+ipSubject(input.IPAddress))
+	}
+
+	for i, subject := range subjects {
+		requests, err := p.SecurityDom.RecordFailure(ctx, subject, p.PasswordReset.RequestWindow)
+		if err != nil {
+			return err
+		}
+
+		limit := p.PasswordReset.RequestLimit
+		if i > 0 {
+			limit = p.PasswordReset.IPRequestLimit
+		}
+
+		if limit > 0 && requests > limit {
+			return x.NewWithCode(http.StatusTooManyRequests, "too many password reset requests, try again later")
+		}
+	}
+
+	t, err := task.NewSendPasswordResetTask(input.Login)
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to create password reset task")
+	}
+
+	if _, err := p.AsynqClient.Enqueue(t); err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to queue password reset email")
+	}
+
+	return nil
+}
+
+// SendPasswordResetEmail emails a reset link to the account with the given
+// username or email address, if there is exactly one active account with
+// an email address.
+func (p *user) SendPasswordResetEmail(ctx context.Context, login string) error {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{Username: login})
+	if err != nil {
+		return err
+	}
+
+	if len(users) < 1 && strings.Contains(login, "@") {
+		users, err = p.UserDom.GetUsers(ctx, entity.GetUserFilter{Email: login})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(users) != 1 || !users[0].IsActive || users[0].Email == "" {
+		return nil
+	}
+
+	return p.sendPasswordReset(ctx, users[0])
+}
+
+// SendPasswordReset emails an employee a reset link on an admin's behalf.
+func (p *user) SendPasswordReset(ctx context.Context, userID uint) error {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: userID})
+	if err != nil {
+		return err
+	}
+
+	if len(users) < 1 {
+		return x.NewWithCode(http.StatusNotFound, "employee not found")
+	}
+
+	if !users[0].IsActive {
+		return x.NewWithCode(http.StatusBadRequest, "account is deactivated")
+	}
+
+	if users[0].Email == "" {
+		return x.NewWithCode(http.StatusBadRequest, "employee has no email address")
+	}
+
+	return p.sendPasswordReset(ctx, users[0])
+}
+
+// sendPasswordReset replaces any reset link the user still has with a new
+// one and emails it once the token is saved.
+func (p *user) sendPasswordReset(ctx context.Context, u entity.User) error {
+	token, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate password reset token")
+	}
+
+	now := time.Now()
+
+	err = p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if err := p.UserDom.InvalidatePasswordResetTokens(newCtx, u.ID, now); err != nil {
+			return err
+		}
+
+		return p.UserDom.CreatePasswordResetToken(newCtx, entity.PasswordResetToken{
+			UserID:    u.ID,
+			TokenHash: pkg.HashRefreshToken(token),
+			ExpiresAt: now.Add(p.PasswordReset.TokenTTL),
+			CreatedAt: now,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	sep := "?"
+	if strings.Contains(p.PasswordReset.URL, "?") {
+		sep = "&"
+	}
+	link := p.PasswordReset.URL + sep + "token=" + url.QueryEscape(token)
+
+	return p.MailerDom.Send(ctx, entity.Email{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to choose a new password for %s. It works once and expires in %d minutes.\n\n%s\n\nIf you did not ask for this, you can ignore this email.\n",
+			u.FullName, u.Username, int(p.PasswordReset.TokenTTL.Minutes()), link),
+	})
+}
+
+// ResetPassword sets a new password with a token from a reset email. The
+// user is logged out everywhere and a lockout on their username is lifted.
+func (p *user) ResetPassword(ctx context.Context, input entity.ResetPasswordRequest) error {
+	if err := p.validatePassword(input.NewPassword); err != nil {
+		return err
+	}
+
+	var (
+		u   entity.User
+		now = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		token, err := p.UserDom.GetPasswordResetToken(newCtx, pkg.HashRefreshToken(input.Token))
+		if err != nil {
+			return err
+		}
+
+		if token == nil || token.UsedAt != nil || !token.ExpiresAt.After(now) {
+			return x.NewWithCode(http.StatusBadRequest, "password reset link is invalid or has expired")
+		}
+
+		if err := p.UserDom.UsePasswordResetToken(newCtx, token.ID, now); err != nil {
+			return err
+		}
+
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: token.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 || !users[0].IsActive {
+			return x.NewWithCode(http.StatusForbidden, "account is deactivated")
+		}
+
+		u = users[0]
+
+		return p.UserDom.SetPassword(newCtx, u.ID, input.NewPassword, now)
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := p.revokeSessions(ctx, entity.GetSessionFilter{UserID: u.ID}, 0); err != nil {
+		return err
+	}
+
+	_, err = p.SecurityDom.Unlock(ctx, usernameSubject(u.Username))
+	return err
+}
+
+// ChangePassword replaces the user's password after checking the current
+// one. Their other sessions are logged out; the current one gets a new
+// access token, which no longer asks for a password change.
+func (p *user) ChangePassword(ctx context.Context, input entity.ChangePasswordRequest) (entity.AuthTokens, error) {
+	if input.CurrentPassword == input.NewPassword {
+		return entity.AuthTokens{}, x.NewWithCode(http.StatusBadRequest, "new password must be different from the current one")
+	}
+
+	if err := p.validatePassword(input.NewPassword); err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	var (
+		tokens entity.AuthTokens
+		now    = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if err := p.UserDom.CheckPassword(newCtx, input.UserID, input.CurrentPassword); err != nil {
+			return err
+		}
+
+		if err := p.UserDom.SetPassword(newCtx, input.UserID, input.NewPassword, now); err != nil {
+			return err
+		}
+
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: input.UserID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "user not found")
+		}
+
+		tokens, err = p.accessToken(users[0], input.SessionID, now)
+		return err
+	})
+	if err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	if _, err := p.revokeSessions(ctx, entity.GetSessionFilter{UserID: input.UserID}, input.SessionID); err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	return tokens, nil
+}
+
+func (p *user) validatePassword(password string) error {
+	if err := p.PasswordPolicy.Validate(password); err != nil {
+		return x.NewWithCode(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}
+
+// GetTwoFactorStatus reports whether the user has an authenticator and
+// how many recovery codes are left.
+func (p *user) GetTwoFactorStatus(ctx context.Context, userID uint) (entity.TwoFactorStatus, error) {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: userID})
+	if err != nil {
+		return entity.TwoFactorStatus{}, err
+	}
+
+	if len(users) < 1 {
+		return entity.TwoFactorStatus{}, x.NewWithCode(http.StatusNotFound, "user not found")
+	}
+
+	tf, err := p.TwoFactorDom.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return entity.TwoFactorStatus{}, err
+	}
+
+	required, err := p.requiresTwoFactor(ctx, users[0].Role)
 	if err != nil {
-		return "", err
+		return entity.TwoFactorStatus{}, err
+	}
+
+	status := entity.TwoFactorStatus{
+		Enabled:  tf != nil && tf.EnabledAt != nil,
+		Pending:  tf != nil && tf.EnabledAt == nil,
+		Required: required,
+	}
+
+	if status.Enabled {
+		codes, err := p.TwoFactorDom.GetRecoveryCodes(ctx, entity.GetRecoveryCodeFilter{UserID: userID, UnusedOnly: true})
+		if err != nil {
+			return entity.TwoFactorStatus{}, err
+		}
+		status.RecoveryCodesLeft = len(codes)
+	}
+
+	return status, nil
+}
+
+// EnrollTwoFactor starts setting up an authenticator. It stays pending
+// until ConfirmTwoFactor sees a code from it.
+func (p *user) EnrollTwoFactor(ctx context.Context, userID uint) (entity.TwoFactorEnrollment, error) {
+	var enrollment entity.TwoFactorEnrollment
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) (err error) {
+		enrollment, err = p.enroll(newCtx, userID)
+		return err
+	})
+
+	return enrollment, err
+}
+
+// ConfirmTwoFactor turns two-factor authentication on with a first code
+// from the authenticator and returns the user's recovery codes.
+func (p *user) ConfirmTwoFactor(ctx context.Context, userID uint, code string) ([]string, error) {
+	var (
+		codes []string
+		now   = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		tf, err := p.TwoFactorDom.GetTwoFactor(newCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		if tf == nil {
+			return x.NewWithCode(http.StatusBadRequest, "start two-factor enrolment first")
+		}
+
+		if tf.EnabledAt != nil {
+			return x.NewWithCode(http.StatusConflict, "two-factor authentication is already enabled")
+		}
+
+		ok, err := p.verifyCode(newCtx, *tf, code, now)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return x.NewWithCode(http.StatusBadRequest, "invalid verification code")
+		}
+
+		codes, err = p.replaceRecoveryCodes(newCtx, userID, now)
+		return err
+	})
+
+	return codes, err
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes, unused ones
+// included.
+func (p *user) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	var (
+		codes []string
+		now   = time.Now()
+	)
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if err := p.requireCode(newCtx, userID, code, now); err != nil {
+			return err
+		}
+
+		var err error
+		codes, err = p.replaceRecoveryCodes(newCtx, userID, now)
+		return err
+	})
+
+	return codes, err
+}
+
+// DisableTwoFactor turns two-factor authentication off, unless the user's
+// role requires it.
+func (p *user) DisableTwoFactor(ctx context.Context, userID uint, code string) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		users, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{ID: userID})
+		if err != nil {
+			return err
+		}
+
+		if len(users) < 1 {
+			return x.NewWithCode(http.StatusNotFound, "user not found")
+		}
+
+		required, err := p.requiresTwoFactor(newCtx, users[0].Role)
+		if err != nil {
+			return err
+		}
+
+		if required {
+			return x.NewWithCode(http.StatusForbidden, "two-factor authentication is required for your role")
+		}
+
+		if err := p.requireCode(newCtx, userID, code, time.Now()); err != nil {
+			return err
+		}
+
+		return p.TwoFactorDom.DeleteTwoFactor(newCtx, userID)
+	})
+}
+
+// ResetTwoFactor removes a user's authenticator and recovery codes, for
+// when both are lost. Users whose role requires two-factor authentication
+// enrol again at their next login.
+func (p *user) ResetTwoFactor(ctx context.Context, userID uint) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		tf, err := p.TwoFactorDom.GetTwoFactor(newCtx, userID)
+		if err != nil {
+			return err
+		}
+
+		if tf == nil {
+			return x.NewWithCode(http.StatusNotFound, "two-factor authentication is not set up")
+		}
+
+		return p.TwoFactorDom.DeleteTwoFactor(newCtx, userID)
+	})
+}
+
+// openChallenge looks up a login challenge that can still be answered.
+func (p *user) openChallenge(ctx context.Context, token string, now time.Time) (*entity.LoginChallenge, error) {
+	challenge, err := p.TwoFactorDom.GetLoginChallenge(ctx, pkg.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge == nil || challenge.CompletedAt != nil || !challenge.ExpiresAt.After(now) {
+		return nil, x.NewWithCode(http.StatusUnauthorized, "login challenge is invalid or has expired, please log in again")
+	}
+
+	if challenge.Attempts >= maxChallengeAttempts {
+		return nil, x.NewWithCode(http.StatusUnauthorized, "too many invalid codes, please log in again")
+	}
+
+	return challenge, nil
+}
+
+// enroll gives the user a new TOTP secret, replacing one that was never
+// confirmed.
+func (p *user) enroll(ctx context.Context, userID uint) (entity.TwoFactorEnrollment, error) {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: userID})
+	if err != nil {
+		return entity.TwoFactorEnrollment{}, err
+	}
+
+	if len(users) < 1 {
+		return entity.TwoFactorEnrollment{}, x.NewWithCode(http.StatusNotFound, "user not found")
+	}
+
+	tf, err := p.TwoFactorDom.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return entity.TwoFactorEnrollment{}, err
+	}
+
+	if tf != nil && tf.EnabledAt != nil {
+		return entity.TwoFactorEnrollment{}, x.NewWithCode(http.StatusConflict, "two-factor authentication is already enabled")
+	}
+
+	if tf != nil {
+		if err := p.TwoFactorDom.DeleteTwoFactor(ctx, userID); err != nil {
+			return entity.TwoFactorEnrollment{}, err
+		}
+	}
+
+	secret, err := pkg.GenerateTOTPSecret()
+	if err != nil {
+		return entity.TwoFactorEnrollment{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate two-factor secret")
+	}
+
+	now := time.Now()
+	err = p.TwoFactorDom.CreateTwoFactor(ctx, entity.TwoFactor{
+		UserID:    userID,
+		Secret:    secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return entity.TwoFactorEnrollment{}, err
+	}
+
+	return entity.TwoFactorEnrollment{
+		Secret:          secret,
+		ProvisioningURI: pkg.TOTPProvisioningURI(p.TwoFactorPolicy.Issuer, users[0].Username, secret),
+	}, nil
+}
+
+// verifyCode checks a code from the authenticator or, once enrolment is
+// complete, an unused recovery code. Accepted codes cannot be used again,
+// and the first accepted TOTP code completes a pending enrolment.
+func (p *user) verifyCode(ctx context.Context, tf entity.TwoFactor, code string, now time.Time) (bool, error) {
+	if step, ok := pkg.ValidateTOTP(tf.Secret, code, now, tf.LastUsedStep); ok {
+		if tf.EnabledAt == nil {
+			return true, p.TwoFactorDom.EnableTwoFactor(ctx, tf.UserID, step, now)
+		}
+		return true, p.TwoFactorDom.UseTOTPStep(ctx, tf.UserID, step)
+	}
+
+	if tf.EnabledAt == nil {
+		return false, nil
+	}
+
+	codes, err := p.TwoFactorDom.GetRecoveryCodes(ctx, entity.GetRecoveryCodeFilter{
+		UserID:     tf.UserID,
+		CodeHash:   pkg.HashRecoveryCode(code),
+		UnusedOnly: true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if len(codes) < 1 {
+		return false, nil
+	}
+
+	return true, p.TwoFactorDom.UseRecoveryCode(ctx, codes[0].ID, now)
+}
+
+// requiresTwoFactor reports whether a role has to use a second factor,
+// either by name or because it currently grants a protected permission such
+// as running payroll. Admins hold every permission.
+func (p *user) requiresTwoFactor(ctx context.Context, role entity.UserRole) (bool, error) {
+	if role == entity.RoleAdmin {
+		return p.TwoFactorPolicy.Requires(role, entity.AllPermissions), nil
+	}
+
+	if len(p.TwoFactorPolicy.RequiredPermissions) == 0 {
+		return p.TwoFactorPolicy.Requires(role, nil), nil
+	}
+
+	rows, err := p.AccessDom.GetRolePermissions(ctx, role)
+	if err != nil {
+		return false, err
+	}
+
+	granted := make([]entity.Permission, 0, len(rows))
+	for _, r := range rows {
+		granted = append(granted, r.Permission)
+	}
+
+	return p.TwoFactorPolicy.Requires(role, granted), nil
+}
+
+// requireCode checks a code for a user who has two-factor authentication
+// enabled, before a change to their settings.
+func (p *user) requireCode(ctx context.Context, userID uint, code string, now time.Time) error {
+	tf, err := p.TwoFactorDom.GetTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if tf == nil || tf.EnabledAt == nil {
+		return x.NewWithCode(http.StatusBadRequest, "two-factor authentication is not enabled")
+	}
+
+	ok, err := p.verifyCode(ctx, *tf, code, now)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return x.NewWithCode(http.StatusBadRequest, "invalid verification code")
+	}
+
+	return nil
+}
+
+func (p *user) replaceRecoveryCodes(ctx context.Context, userID uint, now time.Time) ([]string, error) {
+	codes, err := pkg.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate recovery codes")
+	}
+
+	if err := p.TwoFactorDom.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	rows := make([]entity.RecoveryCode, len(codes))
+	for i, c := range codes {
+		rows[i] = entity.RecoveryCode{UserID: userID, CodeHash: pkg.HashRecoveryCode(c), CreatedAt: now}
+	}
+
+	if err := p.TwoFactorDom.CreateRecoveryCodes(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// startSession opens a session for a user who has fully authenticated.
+// It runs inside the caller's transaction.
+func (p *user) startSession(ctx context.Context, u entity.User, userAgent, ipAddress string, now time.Time) (entity.AuthTokens, error) {
+	refreshToken, err := pkg.GenerateRefreshToken()
+	if err != nil {
+		return entity.AuthTokens{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to generate refresh token")
+	}
+
+	session, err := p.SessionDom.CreateSession(ctx, entity.Session{
+		UserID:           u.ID,
+		RefreshTokenHash: pkg.HashRefreshToken(refreshToken),
+		UserAgent:        userAgent,
+		IPAddress:        ipAddress,
+		ExpiresAt:        now.Add(p.TokenPolicy.RefreshTTL),
+		LastUsedAt:       now,
+		CreatedAt:        now,
+	})
+	if err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	tokens, err := p.accessToken(u, session.ID, now)
+	if err != nil {
+		return entity.AuthTokens{}, err
+	}
+
+	tokens.RefreshToken = refreshToken
+
+	return tokens, nil
+}
+
+func (p *user) accessToken(u entity.User, sessionID uint, now time.Time) (entity.AuthTokens, error) {
+	expiresAt := now.Add(p.TokenPolicy.AccessTTL)
+
+	token, err := p.KeySet.GenerateJWT(pkg.CustomClaims{
+		UserID:             u.ID,
+		Username:           u.Username,
+		Role:               string(u.Role),
+		SessionID:          sessionID,
+		MustChangePassword: u.MustChangePassword,
+	}, expiresAt)
+	if err != nil {
+		return entity.AuthTokens{}, x.WrapWithCode(err, http.StatusInternalServerError, "failed to issue access token")
+	}
+
+	return entity.AuthTokens{
+		AccessToken:          token,
+		AccessTokenExpiresAt: expiresAt,
+		SessionID:            sessionID,
+		MustChangePassword:   u.MustChangePassword,
+	}, nil
+}
+
+func (p *user) CreateEmployee(ctx context.Context, input entity.RegisterRequest) (*entity.User, error) {
+	if err := p.validatePassword(input.Password); err != nil {
+		return nil, err
+	}
+
+	var created *entity.User
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		var err error
+		created, err = p.UserDom.CreateUser(newCtx, input)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (p *user) GetEmployee(ctx context.Context, id uint) (*entity.User, error) {
+	users, err := p.UserDom.GetUsers(ctx, entity.GetUserFilter{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(users) < 1 {
+		return nil, x.NewWithCode(http.StatusNotFound, "employee not found")
+	}
+
+	users[0].Password = ""
+
+	return &users[0], nil
+}
+
+func (p *user) UpdateEmployee(ctx context.Context, input entity.UpdateEmployeeRequest) error {
+	if input.ID == input.ActorID && (input.Role != nil || input.Salary != nil) {
+		return x.NewWithCode(http.StatusBadRequest, "you cannot change your own role or salary")
+	}
+
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		if input.Email != nil && *input.Email != "" {
+			taken, err := p.UserDom.GetUsers(newCtx, entity.GetUserFilter{Email: *input.Email})
+			if err != nil {
+				return err
+			}
+
+			for _, u := range taken {
+				if u.ID != input.ID {
+					return x.NewWithCode(http.StatusBadRequest, "email already taken")
+				}
+			}
+		}
+
+		return p.UserDom.UpdateUser(newCtx, entity.UpdateUser{
+			ID:       input.ID,
+			FullName: input.FullName,
+			Email:    input.Email,
+			Role:     input.Role,
+			Salary:   input.Salary,
+		})
+	})
+}
+
+// SetEmployeeActive deactivates or reactivates an account. Deactivated
+// employees are logged out everywhere, can no longer log in and are left
+// out of payroll runs; their attendance and payslip history is kept.
+func (p *user) SetEmployeeActive(ctx context.Context, id, actorID uint, active bool) error {
+	if !active && id == actorID {
+		return x.NewWithCode(http.StatusBadRequest, "you cannot deactivate your own account")
+	}
+
+	err := p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		return p.UserDom.UpdateUser(newCtx, entity.UpdateUser{
+			ID:       id,
+			IsActive: &active,
+		})
+	})
+	if err != nil || active {
+		return err
+	}
+
+	return p.RevokeAllSessions(ctx, id)
+}
+
+func (p *user) ListEmployees(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error) {
+	return p.UserDom.ListUsers(ctx, filter)
+}
+
+// GetEmployeeProfile returns the user's HR profile. Users nobody has filled
+// a profile for yet get an empty one.
+func (p *user) GetEmployeeProfile(ctx context.Context, userID uint) (*entity.EmployeeProfile, error) {
+	if _, err := p.GetEmployee(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	profiles, err := p.UserDom.GetEmployeeProfiles(ctx, entity.GetEmployeeProfileFilter{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(profiles) < 1 {
+		return &entity.EmployeeProfile{UserID: userID}, nil
+	}
+
+	return &profiles[0], nil
+}
+
+func (p *user) UpdateEmployeeProfile(ctx context.Context, input entity.UpdateEmployeeProfile) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		return p.saveEmployeeProfile(newCtx, input)
+	})
+}
+
+func (p *user) UpdateOwnProfile(ctx context.Context, input entity.UpdateOwnProfileRequest) error {
+	return p.TransactionDom.RunInTx(ctx, func(newCtx context.Context) error {
+		return p.saveEmployeeProfile(newCtx, entity.UpdateEmployeeProfile{
+			UserID:                   input.UserID,
+			Phone:                    input.Phone,
+			EmergencyContactName:     input.EmergencyContactName,
+			EmergencyContactPhone:    input.EmergencyContactPhone,
+			EmergencyContactRelation: input.EmergencyContactRelation,
+		})
+	})
+}
+
+// saveEmployeeProfile applies the changes to the user's profile, creating
+// it on first save.
+func (p *user) saveEmployeeProfile(ctx context.Context, input entity.UpdateEmployeeProfile) error {
+	if input.EmployeeNumber != nil && *input.EmployeeNumber != "" {
+		taken, err := p.UserDom.GetEmployeeProfiles(ctx, entity.GetEmployeeProfileFilter{
+			EmployeeNumber: *input.EmployeeNumber,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, profile := range taken {
+			if profile.UserID != input.UserID {
+				return x.NewWithCode(http.StatusBadRequest, "employee number already taken")
+			}
+		}
+	}
+
+	profile, err := p.GetEmployeeProfile(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if profile.ID > 0 {
+		return p.UserDom.UpdateEmployeeProfile(ctx, input)
 	}
 
-	if user.Role == entity.RoleAdmin {
-		isAdmin = true
+	profile.EmployeeNumber = pkg.StringValue(input.EmployeeNumber)
+	profile.Phone = pkg.StringValue(input.Phone)
+	profile.HireDate = input.HireDate
+	if input.EmploymentType != nil {
+		profile.EmploymentType = *input.EmploymentType
 	}
+	profile.JobTitle = pkg.StringValue(input.JobTitle)
+	profile.NIK = pkg.StringValue(input.NIK)
+	profile.NPWP = pkg.StringValue(input.NPWP)
+	profile.PTKPStatus = pkg.StringValue(input.PTKPStatus)
+	profile.BankName = pkg.StringValue(input.BankName)
+	profile.BankAccountNumber = pkg.StringValue(input.BankAccountNumber)
+	profile.BankAccountName = pkg.StringValue(input.BankAccountName)
+	profile.EmergencyContactName = pkg.StringValue(input.EmergencyContactName)
+	profile.EmergencyContactPhone = pkg.StringValue(input.EmergencyContactPhone)
+	profile.EmergencyContactRelation = pkg.StringValue(input.EmergencyContactRelation)
 
-	return pkg.GenerateJWT(user.ID, user.Username, isAdmin)
+	return p.UserDom.CreateEmployeeProfile(ctx, *profile)
 }
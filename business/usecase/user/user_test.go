@@ -3,12 +3,27 @@ package user_test
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hibiken/asynq"
 	"github.com/stretchr/testify/assert"
 	"github.com/zuhrulumam/go-hris/business/entity"
 	uc "github.com/zuhrulumam/go-hris/business/usecase/user"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/domain/access"
+	mockMailer "github.com/zuhrulumam/go-hris/mocks/domain/mailer"
+	mockSecurity "github.com/zuhrulumam/go-hris/mocks/domain/security"
+	mockSession "github.com/zuhrulumam/go-hris/mocks/domain/session"
+	mockSSO "github.com/zuhrulumam/go-hris/mocks/domain/sso"
+	mockTx "github.com/zuhrulumam/go-hris/mocks/domain/transaction"
+	mockTwoFactor "github.com/zuhrulumam/go-hris/mocks/domain/twofactor"
 	mockUser "github.com/zuhrulumam/go-hris/mocks/domain/user"
+	mockTask "github.com/zuhrulumam/go-hris/mocks/task"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+	"github.com/zuhrulumam/go-hris/task"
 	"go.uber.org/mock/gomock"
 )
 
@@ -74,8 +89,18 @@ func TestUser_Login(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+	mockTwoFactorDom := mockTwoFactor.NewMockDomainItf(ctrl)
+	mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+	mockTxDom := mockTx.NewMockDomainItf(ctrl)
 	usecase := uc.InitUserUsecase(uc.Option{
-		UserDom: mockUserDom,
+		UserDom:        mockUserDom,
+		SessionDom:     mockSessionDom,
+		TwoFactorDom:   mockTwoFactorDom,
+		SecurityDom:    mockSecurityDom,
+		TransactionDom: mockTxDom,
+		TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute, RefreshTTL: 24 * time.Hour},
+		KeySet:         testKeySet(t),
 	})
 
 	tests := []struct {
@@ -128,23 +153,1744 @@ func TestUser_Login(t *testing.T) {
 		},
 	}
 
+	allowLogins(mockSecurityDom)
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockUserDom.EXPECT().
 				Login(gomock.Any(), tt.input).
 				Return(&tt.mockUser, tt.mockErr)
 
-			token, err := usecase.Login(context.Background(), tt.input)
+			if !tt.expectErr {
+				mockTwoFactorDom.EXPECT().GetTwoFactor(gomock.Any(), tt.mockUser.ID).Return(nil, nil)
+				mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockSessionDom.EXPECT().CreateSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, s entity.Session) (*entity.Session, error) {
+						assert.Equal(t, tt.mockUser.ID, s.UserID)
+						assert.Len(t, s.RefreshTokenHash, 64)
+						assert.WithinDuration(t, time.Now().Add(24*time.Hour), s.ExpiresAt, time.Minute)
+						s.ID = 7
+						return &s, nil
+					})
+			}
+
+			result, err := usecase.Login(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Nil(t, result.Tokens)
+			} else {
+				assert.NoError(t, err)
+				assert.Nil(t, result.Challenge)
+
+				tokens := result.Tokens
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.NotEmpty(t, tokens.RefreshToken)
+				assert.Equal(t, uint(7), tokens.SessionID)
+				assert.WithinDuration(t, time.Now().Add(15*time.Minute), tokens.AccessTokenExpiresAt, time.Minute)
+			}
+		})
+	}
+}
+
+func TestUser_LoginTwoFactor(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name             string
+		user             entity.User
+		granted          []entity.RolePermission
+		twoFactor        *entity.TwoFactor
+		expectEnrollment bool
+	}{
+		{
+			name:      "two-factor enabled",
+			user:      entity.User{ID: 1, Role: entity.RoleEmployee},
+			twoFactor: &entity.TwoFactor{UserID: 1, EnabledAt: &now},
+		},
+		{
+			name:             "required by role but not enrolled",
+			user:             entity.User{ID: 2, Role: entity.RolePayrollAdmin},
+			expectEnrollment: true,
+		},
+		{
+			name:             "required by role with unconfirmed enrolment",
+			user:             entity.User{ID: 2, Role: entity.RolePayrollAdmin},
+			twoFactor:        &entity.TwoFactor{UserID: 2},
+			expectEnrollment: true,
+		},
+		{
+			name: "required by a role granted payroll:run",
+			user: entity.User{ID: 3, Role: entity.RoleHR},
+			granted: []entity.RolePermission{
+				{Role: entity.RoleHR, Permission: entity.PermEmployeeRead},
+				{Role: entity.RoleHR, Permission: entity.PermPayrollRun},
+			},
+			expectEnrollment: true,
+		},
+		{
+			name:             "required for admins",
+			user:             entity.User{ID: 4, Role: entity.RoleAdmin},
+			expectEnrollment: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockTwoFactorDom := mockTwoFactor.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+			mockAccessDom := mockAccess.NewMockDomainItf(ctrl)
+
+			allowLogins(mockSecurityDom)
+			mockAccessDom.EXPECT().GetRolePermissions(gomock.Any(), tt.user.Role).Return(tt.granted, nil).AnyTimes()
+			mockUserDom.EXPECT().Login(gomock.Any(), gomock.Any()).Return(&tt.user, nil)
+			mockTwoFactorDom.EXPECT().GetTwoFactor(gomock.Any(), tt.user.ID).Return(tt.twoFactor, nil)
+			mockTwoFactorDom.EXPECT().CreateLoginChallenge(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, c entity.LoginChallenge) error {
+					assert.Equal(t, tt.user.ID, c.UserID)
+					assert.Len(t, c.TokenHash, 64)
+					assert.WithinDuration(t, time.Now().Add(5*time.Minute), c.ExpiresAt, time.Minute)
+					return nil
+				})
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:      mockUserDom,
+				AccessDom:    mockAccessDom,
+				TwoFactorDom: mockTwoFactorDom,
+				SecurityDom:  mockSecurityDom,
+				TwoFactorPolicy: entity.TwoFactorPolicy{
+					RequiredRoles:       []entity.UserRole{entity.RolePayrollAdmin},
+					RequiredPermissions: []entity.Permission{entity.PermPayrollRun},
+					ChallengeTTL:        5 * time.Minute,
+				},
+			})
+
+			result, err := usecase.Login(context.Background(), entity.LoginRequest{Username: "u", Password: "p"})
+
+			assert.NoError(t, err)
+			assert.Nil(t, result.Tokens)
+			assert.NotNil(t, result.Challenge)
+			assert.NotEmpty(t, result.Challenge.Token)
+			assert.Equal(t, tt.expectEnrollment, result.Challenge.EnrollmentRequired)
+		})
+	}
+}
+
+func TestUser_LoginThrottle(t *testing.T) {
+	input := entity.LoginRequest{Username: "JDoe", Password: "wrong", IPAddress: "10.0.0.1"}
+	policy := entity.LoginThrottlePolicy{
+		Window:       15 * time.Minute,
+		LockAfter:    5,
+		IPLockAfter:  50,
+		LockDuration: 15 * time.Minute,
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(s *mockSecurity.MockDomainItf, u *mockUser.MockDomainItf)
+		errorText  string
+	}{
+		{
+			name: "locked out",
+			setupMocks: func(s *mockSecurity.MockDomainItf, u *mockUser.MockDomainItf) {
+				until := time.Now().Add(10 * time.Minute)
+				s.EXPECT().LockedUntil(gomock.Any(), "user:jdoe").Return(&until, nil)
+				s.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventLoginBlocked, e.Type)
+						assert.Equal(t, "10.0.0.1", e.IPAddress)
+						return nil
+					})
+			},
+			errorText: "too many failed login attempts",
+		},
+		{
+			name: "wrong password is counted",
+			setupMocks: func(s *mockSecurity.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().LockedUntil(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				s.EXPECT().GetFailures(gomock.Any(), "user:jdoe").Return(1, nil)
+				u.EXPECT().Login(gomock.Any(), input).
+					Return(nil, x.NewWithCode(http.StatusUnauthorized, "invalid username or password"))
+				s.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventLoginFailed, e.Type)
+						assert.Equal(t, "JDoe", e.Username)
+						return nil
+					})
+				s.EXPECT().RecordFailure(gomock.Any(), "user:jdoe", 15*time.Minute).Return(2, nil)
+				s.EXPECT().RecordFailure(gomock.Any(), "ip:10.0.0.1", 15*time.Minute).Return(7, nil)
+			},
+			errorText: "invalid username or password",
+		},
+		{
+			name: "username locked at the limit",
+			setupMocks: func(s *mockSecurity.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().LockedUntil(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+				s.EXPECT().GetFailures(gomock.Any(), "user:jdoe").Return(4, nil)
+				u.EXPECT().Login(gomock.Any(), input).
+					Return(nil, x.NewWithCode(http.StatusUnauthorized, "invalid username or password"))
+				s.EXPECT().RecordFailure(gomock.Any(), "user:jdoe", gomock.Any()).Return(5, nil)
+				s.EXPECT().RecordFailure(gomock.Any(), "ip:10.0.0.1", gomock.Any()).Return(7, nil)
+				s.EXPECT().Lock(gomock.Any(), "user:jdoe", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, subject string, until time.Time) error {
+						assert.WithinDuration(t, time.Now().Add(15*time.Minute), until, time.Minute)
+						return nil
+					})
+				s.EXPECT().ClearFailures(gomock.Any(), "user:jdoe").Return(nil)
+
+				var types []entity.SecurityEventType
+				s.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						types = append(types, e.Type)
+						if e.Type == entity.SecurityEventAccountLocked {
+							assert.Equal(t, []entity.SecurityEventType{entity.SecurityEventLoginFailed, entity.SecurityEventAccountLocked}, types)
+							assert.Contains(t, e.Detail, "5 failed attempts")
+						}
+						return nil
+					}).Times(2)
+			},
+			errorText: "invalid username or password",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(mockSecurityDom, mockUserDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:       mockUserDom,
+				SecurityDom:   mockSecurityDom,
+				LoginThrottle: policy,
+			})
+
+			result, err := usecase.Login(context.Background(), input)
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorText)
+			assert.Nil(t, result.Tokens)
+		})
+	}
+}
+
+func TestUser_UnlockAccount(t *testing.T) {
+	tests := []struct {
+		name      string
+		unlocked  bool
+		expectErr bool
+	}{
+		{name: "locked account", unlocked: true},
+		{name: "not locked", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+
+			mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).
+				Return([]entity.User{{ID: 3, Username: "JDoe"}}, nil)
+			mockSecurityDom.EXPECT().Unlock(gomock.Any(), "user:jdoe").Return(tt.unlocked, nil)
+			if tt.unlocked {
+				mockSecurityDom.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventAccountUnlocked, e.Type)
+						assert.Equal(t, uint(3), *e.UserID)
+						assert.Equal(t, uint(1), *e.ActorID)
+						return nil
+					})
+			}
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:     mockUserDom,
+				SecurityDom: mockSecurityDom,
+			})
+
+			err := usecase.UnlockAccount(context.Background(), 3, 1)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "not locked")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_VerifyLogin(t *testing.T) {
+	now := time.Now()
+	secret, err := pkg.GenerateTOTPSecret()
+	assert.NoError(t, err)
+
+	step := pkg.TOTPStep(now)
+	code, err := pkg.TOTPCode(secret, step)
+	assert.NoError(t, err)
+
+	challengeHash := pkg.HashRefreshToken("challenge")
+	openChallenge := &entity.LoginChallenge{ID: 5, UserID: 1, Attempts: 2, ExpiresAt: now.Add(time.Minute)}
+	enabled := &entity.TwoFactor{UserID: 1, Secret: secret, EnabledAt: &now}
+
+	expectSession := func(tf *mockTwoFactor.MockDomainItf, s *mockSession.MockDomainItf) {
+		tf.EXPECT().UpdateLoginChallenge(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, u entity.UpdateLoginChallenge) error {
+				assert.Equal(t, uint(5), u.ID)
+				assert.NotNil(t, u.CompletedAt)
+				return nil
+			})
+		s.EXPECT().CreateSession(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s entity.Session) (*entity.Session, error) {
+				s.ID = 9
+				return &s, nil
+			})
+	}
+
+	tests := []struct {
+		name        string
+		code        string
+		setupMocks  func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf)
+		expectErr   bool
+		errorText   string
+		expectCodes bool
+		expectEvent bool
+		failures    int
+		lockedUntil *time.Time
+	}{
+		{
+			name: "authenticator code",
+			code: code,
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 1}).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+				tf.EXPECT().GetTwoFactor(gomock.Any(), uint(1)).Return(enabled, nil)
+				tf.EXPECT().UseTOTPStep(gomock.Any(), uint(1), step).Return(nil)
+				expectSession(tf, s)
+			},
+		},
+		{
+			name: "recovery code",
+			code: "ABCDE-12345",
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+				tf.EXPECT().GetTwoFactor(gomock.Any(), uint(1)).Return(enabled, nil)
+				tf.EXPECT().GetRecoveryCodes(gomock.Any(), entity.GetRecoveryCodeFilter{
+					UserID:     1,
+					CodeHash:   pkg.HashRecoveryCode("abcde12345"),
+					UnusedOnly: true,
+				}).Return([]entity.RecoveryCode{{ID: 4, UserID: 1}}, nil)
+				tf.EXPECT().UseRecoveryCode(gomock.Any(), uint(4), gomock.Any()).Return(nil)
+				expectSession(tf, s)
+			},
+		},
+		{
+			name: "first code completes enrolment",
+			code: code,
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+				tf.EXPECT().GetTwoFactor(gomock.Any(), uint(1)).Return(&entity.TwoFactor{UserID: 1, Secret: secret}, nil)
+				tf.EXPECT().EnableTwoFactor(gomock.Any(), uint(1), step, gomock.Any()).Return(nil)
+				tf.EXPECT().DeleteRecoveryCodes(gomock.Any(), uint(1)).Return(nil)
+				tf.EXPECT().CreateRecoveryCodes(gomock.Any(), gomock.Len(10)).Return(nil)
+				expectSession(tf, s)
+			},
+			expectCodes: true,
+		},
+		{
+			name: "wrong code counts an attempt",
+			code: "000000",
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+				tf.EXPECT().GetTwoFactor(gomock.Any(), uint(1)).Return(&entity.TwoFactor{UserID: 1, Secret: secret, EnabledAt: &now, LastUsedStep: step + 1}, nil)
+				tf.EXPECT().GetRecoveryCodes(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := 3
+				tf.EXPECT().UpdateLoginChallenge(gomock.Any(), entity.UpdateLoginChallenge{ID: 5, Attempts: &attempts}).Return(nil)
+			},
+			expectErr:   true,
+			errorText:   "invalid verification code",
+			expectEvent: true,
+			failures:    2,
+		},
+		{
+			name: "wrong code locks the username",
+			code: "000000",
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+				tf.EXPECT().GetTwoFactor(gomock.Any(), uint(1)).Return(&entity.TwoFactor{UserID: 1, Secret: secret, EnabledAt: &now, LastUsedStep: step + 1}, nil)
+				tf.EXPECT().GetRecoveryCodes(gomock.Any(), gomock.Any()).Return(nil, nil)
+				tf.EXPECT().UpdateLoginChallenge(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectErr:   true,
+			errorText:   "invalid verification code",
+			expectEvent: true,
+			failures:    5,
+		},
+		{
+			name: "username locked out",
+			code: code,
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).Return(openChallenge, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 1, Username: "jdoe", IsActive: true}}, nil)
+			},
+			expectErr:   true,
+			errorText:   "too many failed login attempts",
+			lockedUntil: pkg.TimePtr(now.Add(10 * time.Minute)),
+		},
+		{
+			name: "expired challenge",
+			code: code,
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).
+					Return(&entity.LoginChallenge{ID: 5, UserID: 1, ExpiresAt: now.Add(-time.Second)}, nil)
+			},
+			expectErr: true,
+			errorText: "has expired",
+		},
+		{
+			name: "too many attempts",
+			code: code,
+			setupMocks: func(tf *mockTwoFactor.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tf.EXPECT().GetLoginChallenge(gomock.Any(), challengeHash).
+					Return(&entity.LoginChallenge{ID: 5, UserID: 1, Attempts: 5, ExpiresAt: now.Add(time.Minute)}, nil)
+			},
+			expectErr: true,
+			errorText: "too many invalid codes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockTwoFactorDom := mockTwoFactor.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockTwoFactorDom, mockUserDom, mockSessionDom)
+			mockSecurityDom.EXPECT().LockedUntil(gomock.Any(), "user:jdoe").Return(tt.lockedUntil, nil).AnyTimes()
+			if tt.expectEvent {
+				mockSecurityDom.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventTwoFactorFailed, e.Type)
+						assert.Equal(t, uint(1), *e.UserID)
+						return nil
+					})
+			}
+
+			// Wrong codes count against the username like wrong passwords,
+			// which are only forgotten once the second factor passes
+			if tt.failures > 0 {
+				mockSecurityDom.EXPECT().RecordFailure(gomock.Any(), "user:jdoe", 15*time.Minute).Return(tt.failures, nil)
+			}
+			if tt.failures >= 5 {
+				mockSecurityDom.EXPECT().Lock(gomock.Any(), "user:jdoe", gomock.Any()).Return(nil)
+				mockSecurityDom.EXPECT().ClearFailures(gomock.Any(), "user:jdoe").Return(nil)
+				mockSecurityDom.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventAccountLocked, e.Type)
+						return nil
+					})
+			}
+			if !tt.expectErr {
+				mockSecurityDom.EXPECT().ClearFailures(gomock.Any(), "user:jdoe").Return(nil)
+			}
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				TwoFactorDom:   mockTwoFactorDom,
+				SecurityDom:    mockSecurityDom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute, RefreshTTL: 24 * time.Hour},
+				LoginThrottle:  entity.LoginThrottlePolicy{Window: 15 * time.Minute, LockAfter: 5, LockDuration: 15 * time.Minute},
+				KeySet:         testKeySet(t),
+			})
+
+			tokens, err := usecase.VerifyLogin(context.Background(), entity.VerifyLoginRequest{
+				ChallengeToken: "challenge",
+				Code:           tt.code,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorText)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotEmpty(t, tokens.AccessToken)
+			assert.Equal(t, uint(9), tokens.SessionID)
+			if tt.expectCodes {
+				assert.Len(t, tokens.RecoveryCodes, 10)
+			} else {
+				assert.Empty(t, tokens.RecoveryCodes)
+			}
+		})
+	}
+}
+
+func TestUser_DisableTwoFactor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockTwoFactorDom := mockTwoFactor.NewMockDomainItf(ctrl)
+	mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+	mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 2}).
+		Return([]entity.User{{ID: 2, Role: entity.RoleAdmin}}, nil)
+
+	usecase := uc.InitUserUsecase(uc.Option{
+		UserDom:         mockUserDom,
+		TwoFactorDom:    mockTwoFactorDom,
+		TransactionDom:  mockTxDom,
+		TwoFactorPolicy: entity.TwoFactorPolicy{RequiredRoles: []entity.UserRole{entity.RoleAdmin}},
+	})
+
+	err := usecase.DisableTwoFactor(context.Background(), 2, "123456")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required for your role")
+}
+
+func TestUser_RefreshSession(t *testing.T) {
+	oldToken := "old-refresh-token"
+	oldHash := pkg.HashRefreshToken(oldToken)
+
+	tests := []struct {
+		name        string
+		setupMocks  func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "rotates refresh token",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{RefreshTokenHash: oldHash}).
+					Return([]entity.Session{{ID: 7, UserID: 3, ExpiresAt: time.Now().Add(time.Hour)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).
+					Return([]entity.User{{ID: 3, Username: "jdoe", Role: entity.RoleManager, IsActive: true}}, nil)
+				s.EXPECT().UpdateSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, data entity.UpdateSession) error {
+						assert.Equal(t, uint(7), data.ID)
+						assert.Equal(t, oldHash, *data.PreviousTokenHash)
+						assert.NotEqual(t, oldHash, *data.RefreshTokenHash)
+						return nil
+					})
+			},
+		},
+		{
+			name: "reused token revokes session",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{RefreshTokenHash: oldHash}).Return(nil, nil)
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{PreviousTokenHash: oldHash, ActiveOnly: true}).
+					Return([]entity.Session{{ID: 7}}, nil)
+				s.EXPECT().RevokeSessions(gomock.Any(), []uint{7}, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), []uint{7}, 15*time.Minute).Return(nil)
+			},
+			expectErr:   true,
+			errorString: "refresh token was already used",
+		},
+		{
+			name: "concurrent refresh rotated the token first",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{RefreshTokenHash: oldHash}).
+					Return([]entity.Session{{ID: 7, UserID: 3, ExpiresAt: time.Now().Add(time.Hour)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).
+					Return([]entity.User{{ID: 3, Username: "jdoe", Role: entity.RoleManager, IsActive: true}}, nil)
+				s.EXPECT().UpdateSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, data entity.UpdateSession) error {
+						assert.Equal(t, oldHash, *data.CurrentTokenHash)
+						return x.NewWithCode(http.StatusNotFound, "session not found")
+					})
+				s.EXPECT().RevokeSessions(gomock.Any(), []uint{7}, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), []uint{7}, 15*time.Minute).Return(nil)
+			},
+			expectErr:   true,
+			errorString: "refresh token was already used",
+		},
+		{
+			name: "unknown token",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
+			},
+			expectErr:   true,
+			errorString: "invalid refresh token",
+		},
+		{
+			name: "revoked session",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				revokedAt := time.Now().Add(-time.Minute)
+				s.EXPECT().GetSessions(gomock.Any(), gomock.Any()).
+					Return([]entity.Session{{ID: 7, UserID: 3, ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}}, nil)
+			},
+			expectErr:   true,
+			errorString: "session has expired",
+		},
+		{
+			name: "deactivated user",
+			setupMocks: func(s *mockSession.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), gomock.Any()).
+					Return([]entity.Session{{ID: 7, UserID: 3, ExpiresAt: time.Now().Add(time.Hour)}}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).
+					Return([]entity.User{{ID: 3, IsActive: false}}, nil)
+			},
+			expectErr:   true,
+			errorString: "account is deactivated",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockSessionDom, mockUserDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute},
+				KeySet:         testKeySet(t),
+			})
+
+			tokens, err := usecase.RefreshSession(context.Background(), entity.RefreshSessionRequest{RefreshToken: oldToken})
 
 			if tt.expectErr {
 				assert.Error(t, err)
-				assert.Empty(t, token)
+				assert.Contains(t, err.Error(), tt.errorString)
 			} else {
 				assert.NoError(t, err)
-				assert.NotEmpty(t, token)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.NotEmpty(t, tokens.RefreshToken)
+				assert.NotEqual(t, oldToken, tokens.RefreshToken)
+				assert.Equal(t, uint(7), tokens.SessionID)
+			}
+		})
+	}
+}
+
+func TestUser_RevokeSession(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMocks  func(s *mockSession.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "success",
+			setupMocks: func(s *mockSession.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{ID: 7, UserID: 3, ActiveOnly: true}).
+					Return([]entity.Session{{ID: 7}}, nil)
+				s.EXPECT().RevokeSessions(gomock.Any(), []uint{7}, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), []uint{7}, 15*time.Minute).Return(nil)
+			},
+		},
+		{
+			name: "someone else's session",
+			setupMocks: func(s *mockSession.MockDomainItf) {
+				s.EXPECT().GetSessions(gomock.Any(), gomock.Any()).Return(nil, nil)
+				s.EXPECT().RevokeSessions(gomock.Any(), nil, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), nil, gomock.Any()).Return(nil)
+			},
+			expectErr:   true,
+			errorString: "session not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockSessionDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				SessionDom:     mockSessionDom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute},
+			})
 
+			err := usecase.RevokeSession(context.Background(), 3, 7)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
 				assert.NoError(t, err)
 			}
 		})
 	}
 }
+
+func TestUser_SendPasswordResetEmail(t *testing.T) {
+	jdoe := entity.User{ID: 3, Username: "jdoe", FullName: "John Doe", Email: "jdoe@example.com", IsActive: true}
+
+	tests := []struct {
+		name       string
+		login      string
+		setupMocks func(u *mockUser.MockDomainItf)
+		expectSent bool
+	}{
+		{
+			name:  "by username",
+			login: "jdoe",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Username: "jdoe"}).Return([]entity.User{jdoe}, nil)
+			},
+			expectSent: true,
+		},
+		{
+			name:  "by email",
+			login: "jdoe@example.com",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Username: "jdoe@example.com"}).Return(nil, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Email: "jdoe@example.com"}).Return([]entity.User{jdoe}, nil)
+			},
+			expectSent: true,
+		},
+		{
+			name:  "unknown account",
+			login: "nobody",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Username: "nobody"}).Return(nil, nil)
+			},
+		},
+		{
+			name:  "deactivated account",
+			login: "jdoe",
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				inactive := jdoe
+				inactive.IsActive = false
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Username: "jdoe"}).Return([]entity.User{inactive}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockMailerDom := mockMailer.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			tt.setupMocks(mockUserDom)
+
+			if tt.expectSent {
+				var tokenHash string
+
+				mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				mockUserDom.EXPECT().InvalidatePasswordResetTokens(gomock.Any(), uint(3), gomock.Any()).Return(nil)
+				mockUserDom.EXPECT().CreatePasswordResetToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, token entity.PasswordResetToken) error {
+						assert.Equal(t, uint(3), token.UserID)
+						assert.WithinDuration(t, time.Now().Add(30*time.Minute), token.ExpiresAt, time.Minute)
+						tokenHash = token.TokenHash
+						return nil
+					})
+				mockMailerDom.EXPECT().Send(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, email entity.Email) error {
+						assert.Equal(t, "jdoe@example.com", email.To)
+
+						_, token, found := strings.Cut(email.Body, "https://hris.example.com/reset?lang=en&token=")
+						assert.True(t, found)
+						token, _, _ = strings.Cut(token, "\n")
+						assert.Equal(t, tokenHash, pkg.HashRefreshToken(token))
+						return nil
+					})
+			}
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				MailerDom:      mockMailerDom,
+				TransactionDom: mockTxDom,
+				PasswordReset:  entity.PasswordResetPolicy{TokenTTL: 30 * time.Minute, URL: "https://hris.example.com/reset?lang=en"},
+			})
+
+			err := usecase.SendPasswordResetEmail(context.Background(), tt.login)
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUser_RequestPasswordReset(t *testing.T) {
+	policy := entity.PasswordResetPolicy{RequestLimit: 3, IPRequestLimit: 20, RequestWindow: time.Hour}
+	input := entity.ForgotPasswordRequest{Login: "JDoe", IPAddress: "10.0.0.1"}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(s *mockSecurity.MockDomainItf, q *mockTask.MockEnqueuer)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name: "queued for the worker",
+			setupMocks: func(s *mockSecurity.MockDomainItf, q *mockTask.MockEnqueuer) {
+				s.EXPECT().RecordFailure(gomock.Any(), "reset:user:jdoe", time.Hour).Return(1, nil)
+				s.EXPECT().RecordFailure(gomock.Any(), "reset:ip:10.0.0.1", time.Hour).Return(1, nil)
+				q.EXPECT().Enqueue(gomock.Any()).
+					DoAndReturn(func(queued *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error) {
+						assert.Equal(t, task.TypeSendPasswordReset, queued.Type())
+						assert.JSONEq(t, `{"Login":"JDoe"}`, string(queued.Payload()))
+						return &asynq.TaskInfo{}, nil
+					})
+			},
+		},
+		{
+			name: "too many for the login",
+			setupMocks: func(s *mockSecurity.MockDomainItf, q *mockTask.MockEnqueuer) {
+				s.EXPECT().RecordFailure(gomock.Any(), "reset:user:jdoe", time.Hour).Return(4, nil)
+			},
+			expectErr:   true,
+			errorString: "too many password reset requests",
+		},
+		{
+			name: "too many from the address",
+			setupMocks: func(s *mockSecurity.MockDomainItf, q *mockTask.MockEnqueuer) {
+				s.EXPECT().RecordFailure(gomock.Any(), "reset:user:jdoe", time.Hour).Return(1, nil)
+				s.EXPECT().RecordFailure(gomock.Any(), "reset:ip:10.0.0.1", time.Hour).Return(21, nil)
+			},
+			expectErr:   true,
+			errorString: "too many password reset requests",
+		},
+		{
+			name: "queue unavailable",
+			setupMocks: func(s *mockSecurity.MockDomainItf, q *mockTask.MockEnqueuer) {
+				s.EXPECT().RecordFailure(gomock.Any(), gomock.Any(), time.Hour).Return(1, nil).Times(2)
+				q.EXPECT().Enqueue(gomock.Any()).Return(nil, errors.New("redis down"))
+			},
+			expectErr:   true,
+			errorString: "failed to queue password reset email",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+			mockEnqueuer := mockTask.NewMockEnqueuer(ctrl)
+			tt.setupMocks(mockSecurityDom, mockEnqueuer)
+
+			// No account is looked up while answering the request
+			usecase := uc.InitUserUsecase(uc.Option{
+				SecurityDom:   mockSecurityDom,
+				AsynqClient:   mockEnqueuer,
+				PasswordReset: policy,
+			})
+
+			err := usecase.RequestPasswordReset(context.Background(), input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_ResetPassword(t *testing.T) {
+	now := time.Now()
+	policy := entity.PasswordPolicy{MinLength: 8, RequireDigit: true}
+	tokenHash := pkg.HashRefreshToken("reset-token")
+
+	tests := []struct {
+		name        string
+		password    string
+		token       *entity.PasswordResetToken
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:     "valid token",
+			password: "N3wSecret",
+			token:    &entity.PasswordResetToken{ID: 4, UserID: 3, TokenHash: tokenHash, ExpiresAt: now.Add(time.Minute)},
+		},
+		{
+			name:        "expired token",
+			password:    "N3wSecret",
+			token:       &entity.PasswordResetToken{ID: 4, UserID: 3, TokenHash: tokenHash, ExpiresAt: now.Add(-time.Minute)},
+			expectErr:   true,
+			errorString: "invalid or has expired",
+		},
+		{
+			name:        "used token",
+			password:    "N3wSecret",
+			token:       &entity.PasswordResetToken{ID: 4, UserID: 3, TokenHash: tokenHash, ExpiresAt: now.Add(time.Minute), UsedAt: &now},
+			expectErr:   true,
+			errorString: "invalid or has expired",
+		},
+		{
+			name:        "unknown token",
+			password:    "N3wSecret",
+			expectErr:   true,
+			errorString: "invalid or has expired",
+		},
+		{
+			name:        "weak password",
+			password:    "secret",
+			expectErr:   true,
+			errorString: "password must contain at least 8 characters, a digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+
+			// Not reached when the password is turned down
+			mockUserDom.EXPECT().GetPasswordResetToken(gomock.Any(), tokenHash).Return(tt.token, nil).MaxTimes(1)
+
+			if !tt.expectErr {
+				mockUserDom.EXPECT().UsePasswordResetToken(gomock.Any(), uint(4), gomock.Any()).Return(nil)
+				mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).
+					Return([]entity.User{{ID: 3, Username: "JDoe", IsActive: true}}, nil)
+				mockUserDom.EXPECT().SetPassword(gomock.Any(), uint(3), tt.password, gomock.Any()).Return(nil)
+				mockSessionDom.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{UserID: 3, ActiveOnly: true}).
+					Return([]entity.Session{{ID: 7}, {ID: 8}}, nil)
+				mockSessionDom.EXPECT().RevokeSessions(gomock.Any(), []uint{7, 8}, gomock.Any()).Return(nil)
+				mockSessionDom.EXPECT().MarkRevoked(gomock.Any(), []uint{7, 8}, gomock.Any()).Return(nil)
+				mockSecurityDom.EXPECT().Unlock(gomock.Any(), "user:jdoe").Return(false, nil)
+			}
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				SecurityDom:    mockSecurityDom,
+				TransactionDom: mockTxDom,
+				PasswordPolicy: policy,
+			})
+
+			err := usecase.ResetPassword(context.Background(), entity.ResetPasswordRequest{
+				Token:       "reset-token",
+				NewPassword: tt.password,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+				assert.EqualValues(t, http.StatusBadRequest, x.ErrCode(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_ChangePassword(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     string
+		password    string
+		setupMocks  func(u *mockUser.MockDomainItf, s *mockSession.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:     "success keeps the current session",
+			current:  "Secret123",
+			password: "N3wSecret",
+			setupMocks: func(u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				u.EXPECT().CheckPassword(gomock.Any(), uint(3), "Secret123").Return(nil)
+				u.EXPECT().SetPassword(gomock.Any(), uint(3), "N3wSecret", gomock.Any()).Return(nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).
+					Return([]entity.User{{ID: 3, Username: "jdoe", Role: entity.RoleEmployee, IsActive: true}}, nil)
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{UserID: 3, ActiveOnly: true}).
+					Return([]entity.Session{{ID: 7}, {ID: 9}}, nil)
+				s.EXPECT().RevokeSessions(gomock.Any(), []uint{7}, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), []uint{7}, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "wrong current password",
+			current:  "guess",
+			password: "N3wSecret",
+			setupMocks: func(u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				u.EXPECT().CheckPassword(gomock.Any(), uint(3), "guess").
+					Return(x.NewWithCode(http.StatusBadRequest, "current password is incorrect"))
+			},
+			expectErr:   true,
+			errorString: "current password is incorrect",
+		},
+		{
+			name:        "same password",
+			current:     "Secret123",
+			password:    "Secret123",
+			setupMocks:  func(u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "must be different",
+		},
+		{
+			name:        "weak password",
+			current:     "Secret123",
+			password:    "password",
+			setupMocks:  func(u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "password must contain an uppercase letter, a digit",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			tt.setupMocks(mockUserDom, mockSessionDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute},
+				PasswordPolicy: entity.PasswordPolicy{MinLength: 8, RequireUpper: true, RequireLower: true, RequireDigit: true},
+				KeySet:         testKeySet(t),
+			})
+
+			tokens, err := usecase.ChangePassword(context.Background(), entity.ChangePasswordRequest{
+				UserID:          3,
+				SessionID:       9,
+				CurrentPassword: tt.current,
+				NewPassword:     tt.password,
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.Equal(t, uint(9), tokens.SessionID)
+				assert.False(t, tokens.MustChangePassword)
+				assert.Empty(t, tokens.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestUser_RegisterDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	usecase := uc.InitUserUsecase(uc.Option{
+		UserDom:                 mockUser.NewMockDomainItf(ctrl),
+		DisableSelfRegistration: true,
+	})
+
+	err := usecase.Register(context.Background(), entity.RegisterRequest{Username: "Umam", Password: "secure123"})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "self-registration is disabled")
+}
+
+func TestUser_CreateEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+	mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	input := entity.RegisterRequest{Username: "jane", Password: "secure123", Role: "admin"}
+	mockUserDom.EXPECT().CreateUser(gomock.Any(), input).
+		Return(&entity.User{ID: 5, Username: "jane", Role: entity.RoleAdmin, IsActive: true}, nil)
+
+	usecase := uc.InitUserUsecase(uc.Option{
+		UserDom:        mockUserDom,
+		TransactionDom: mockTxDom,
+	})
+
+	created, err := usecase.CreateEmployee(context.Background(), input)
+
+	assert.NoError(t, err)
+	assert.Equal(t, uint(5), created.ID)
+}
+
+func TestUser_GetEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		users       []entity.User
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "found",
+			users: []entity.User{{ID: 3, Username: "jane", Password: "hash"}},
+		},
+		{
+			name:        "not found",
+			expectErr:   true,
+			errorString: "employee not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockUserDom.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).Return(tt.users, nil)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom: mockUserDom,
+			})
+
+			employee, err := usecase.GetEmployee(context.Background(), 3)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, employee.Password)
+			}
+		})
+	}
+}
+
+func TestUser_UpdateEmployee(t *testing.T) {
+	email := "jane@example.com"
+	salary := 7000000.0
+	role := entity.RoleHR
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateEmployeeRequest
+		setupMocks  func(u *mockUser.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "success keeping own email",
+			input: entity.UpdateEmployeeRequest{ID: 3, Email: &email, Salary: &salary},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Email: email}).
+					Return([]entity.User{{ID: 3}}, nil)
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{ID: 3, Email: &email, Salary: &salary}).
+					Return(nil)
+			},
+		},
+		{
+			name:  "email used by another employee",
+			input: entity.UpdateEmployeeRequest{ID: 3, Email: &email},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).
+					Return([]entity.User{{ID: 4}}, nil)
+			},
+			expectErr:   true,
+			errorString: "email already taken",
+		},
+		{
+			name:  "not found",
+			input: entity.UpdateEmployeeRequest{ID: 3, Salary: &salary},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).
+					Return(errors.New("employee not found"))
+			},
+			expectErr:   true,
+			errorString: "employee not found",
+		},
+		{
+			name:  "own email can be changed",
+			input: entity.UpdateEmployeeRequest{ID: 3, ActorID: 3, Email: &email},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil)
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{ID: 3, Email: &email}).Return(nil)
+			},
+		},
+		{
+			name:        "own salary",
+			input:       entity.UpdateEmployeeRequest{ID: 3, ActorID: 3, Salary: &salary},
+			setupMocks:  func(u *mockUser.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "you cannot change your own role or salary",
+		},
+		{
+			name:        "own role",
+			input:       entity.UpdateEmployeeRequest{ID: 3, ActorID: 3, Role: &role},
+			setupMocks:  func(u *mockUser.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "you cannot change your own role or salary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			tt.setupMocks(mockUserDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				TransactionDom: mockTxDom,
+			})
+
+			err := usecase.UpdateEmployee(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_SetEmployeeActive(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          uint
+		active      bool
+		setupMocks  func(tx *mockTx.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:   "deactivate revokes sessions",
+			id:     3,
+			active: false,
+			setupMocks: func(tx *mockTx.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					}).Times(2)
+				inactive := false
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{ID: 3, IsActive: &inactive}).Return(nil)
+				s.EXPECT().GetSessions(gomock.Any(), entity.GetSessionFilter{UserID: 3, ActiveOnly: true}).
+					Return([]entity.Session{{ID: 10}, {ID: 11}}, nil)
+				s.EXPECT().RevokeSessions(gomock.Any(), []uint{10, 11}, gomock.Any()).Return(nil)
+				s.EXPECT().MarkRevoked(gomock.Any(), []uint{10, 11}, 15*time.Minute).Return(nil)
+			},
+		},
+		{
+			name:   "reactivate",
+			id:     3,
+			active: true,
+			setupMocks: func(tx *mockTx.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {
+				tx.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						return fn(ctx)
+					})
+				active := true
+				u.EXPECT().UpdateUser(gomock.Any(), entity.UpdateUser{ID: 3, IsActive: &active}).Return(nil)
+			},
+		},
+		{
+			name:        "deactivate self",
+			id:          1,
+			active:      false,
+			setupMocks:  func(tx *mockTx.MockDomainItf, u *mockUser.MockDomainItf, s *mockSession.MockDomainItf) {},
+			expectErr:   true,
+			errorString: "you cannot deactivate your own account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			tt.setupMocks(mockTxDom, mockUserDom, mockSessionDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute},
+			})
+
+			err := usecase.SetEmployeeActive(context.Background(), tt.id, 1, tt.active)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_UpdateEmployeeProfile(t *testing.T) {
+	number := "EMP0003"
+	phone := "+6281234567890"
+	permanent := entity.EmploymentPermanent
+
+	tests := []struct {
+		name        string
+		input       entity.UpdateEmployeeProfile
+		setupMocks  func(u *mockUser.MockDomainItf)
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "creates profile on first save",
+			input: entity.UpdateEmployeeProfile{UserID: 3, EmployeeNumber: &number, EmploymentType: &permanent},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetEmployeeProfiles(gomock.Any(), entity.GetEmployeeProfileFilter{EmployeeNumber: number}).Return(nil, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 3}).Return([]entity.User{{ID: 3}}, nil)
+				u.EXPECT().GetEmployeeProfiles(gomock.Any(), entity.GetEmployeeProfileFilter{UserID: 3}).Return(nil, nil)
+				u.EXPECT().CreateEmployeeProfile(gomock.Any(), entity.EmployeeProfile{
+					UserID:         3,
+					EmployeeNumber: number,
+					EmploymentType: permanent,
+				}).Return(nil)
+			},
+		},
+		{
+			name:  "updates existing profile",
+			input: entity.UpdateEmployeeProfile{UserID: 3, Phone: &phone},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 3}}, nil)
+				u.EXPECT().GetEmployeeProfiles(gomock.Any(), gomock.Any()).Return([]entity.EmployeeProfile{{ID: 1, UserID: 3}}, nil)
+				u.EXPECT().UpdateEmployeeProfile(gomock.Any(), entity.UpdateEmployeeProfile{UserID: 3, Phone: &phone}).Return(nil)
+			},
+		},
+		{
+			name:  "employee number taken",
+			input: entity.UpdateEmployeeProfile{UserID: 3, EmployeeNumber: &number},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetEmployeeProfiles(gomock.Any(), gomock.Any()).Return([]entity.EmployeeProfile{{ID: 2, UserID: 4}}, nil)
+			},
+			expectErr:   true,
+			errorString: "employee number already taken",
+		},
+		{
+			name:  "employee not found",
+			input: entity.UpdateEmployeeProfile{UserID: 3, Phone: &phone},
+			setupMocks: func(u *mockUser.MockDomainItf) {
+				u.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectErr:   true,
+			errorString: "employee not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				})
+			tt.setupMocks(mockUserDom)
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				TransactionDom: mockTxDom,
+			})
+
+			err := usecase.UpdateEmployeeProfile(context.Background(), tt.input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUser_UpdateOwnProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserDom := mockUser.NewMockDomainItf(ctrl)
+	mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+	mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	phone := "+6281234567890"
+	contact := "Jane Doe"
+
+	mockUserDom.EXPECT().GetUsers(gomock.Any(), gomock.Any()).Return([]entity.User{{ID: 3}}, nil)
+	mockUserDom.EXPECT().GetEmployeeProfiles(gomock.Any(), gomock.Any()).Return([]entity.EmployeeProfile{{ID: 1, UserID: 3}}, nil)
+	// Only the self-service fields reach the domain
+	mockUserDom.EXPECT().UpdateEmployeeProfile(gomock.Any(), entity.UpdateEmployeeProfile{
+		UserID:               3,
+		Phone:                &phone,
+		EmergencyContactName: &contact,
+	}).Return(nil)
+
+	usecase := uc.InitUserUsecase(uc.Option{
+		UserDom:        mockUserDom,
+		TransactionDom: mockTxDom,
+	})
+
+	err := usecase.UpdateOwnProfile(context.Background(), entity.UpdateOwnProfileRequest{
+		UserID:               3,
+		Phone:                &phone,
+		EmergencyContactName: &contact,
+	})
+
+	assert.NoError(t, err)
+}
+
+func TestUser_StartSSO(t *testing.T) {
+	oidc := entity.OIDCConfig{IssuerURL: "https://idp.example.com", StateTTL: 10 * time.Minute}
+
+	t.Run("not configured", func(t *testing.T) {
+		usecase := uc.InitUserUsecase(uc.Option{})
+
+		_, err := usecase.StartSSO(context.Background())
+		assert.Error(t, err)
+		assert.EqualValues(t, http.StatusNotFound, x.ErrCode(err))
+	})
+
+	t.Run("stores the state behind the sign-in page", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockSSODom := mockSSO.NewMockDomainItf(ctrl)
+
+		var state, nonce, challenge, binding string
+		mockSSODom.EXPECT().AuthCodeURL(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s, n, c string) (string, error) {
+				state, nonce, challenge = s, n, c
+				return "https://idp.example.com/authorize?state=" + s, nil
+			})
+		mockSSODom.EXPECT().CreateSSOState(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, st entity.SSOState) error {
+				assert.Equal(t, pkg.HashRefreshToken(state), st.StateHash)
+				assert.Len(t, st.BindingHash, 64)
+				binding = st.BindingHash
+				assert.Equal(t, nonce, st.Nonce)
+				assert.Equal(t, challenge, pkg.PKCEChallenge(st.CodeVerifier))
+				assert.WithinDuration(t, time.Now().Add(10*time.Minute), st.ExpiresAt, time.Second)
+				return nil
+			})
+
+		usecase := uc.InitUserUsecase(uc.Option{SSODom: mockSSODom, OIDC: oidc})
+
+		start, err := usecase.StartSSO(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, "https://idp.example.com/authorize?state="+state, start.AuthURL)
+		assert.Equal(t, binding, pkg.HashRefreshToken(start.Binding))
+		assert.NotEqual(t, state, start.Binding)
+		assert.NotEqual(t, state, nonce)
+	})
+}
+
+func TestUser_CompleteSSO(t *testing.T) {
+	now := time.Now()
+	stateHash := pkg.HashRefreshToken("state")
+	openState := &entity.SSOState{
+		ID:           3,
+		BindingHash:  pkg.HashRefreshToken("binding"),
+		Nonce:        "nonce",
+		CodeVerifier: "verifier",
+		ExpiresAt:    now.Add(time.Minute),
+	}
+	claims := entity.OIDCClaims{
+		Issuer:        "https://idp.example.com",
+		Subject:       "sub-1",
+		Email:         "jane@example.com",
+		EmailVerified: true,
+		Nonce:         "nonce",
+	}
+	jane := entity.User{ID: 7, Username: "jane", Email: "jane@example.com", IsActive: true}
+
+	unverified := claims
+	unverified.EmailVerified = false
+
+	otherNonce := claims
+	otherNonce.Nonce = "other"
+
+	expectState := func(s *mockSSO.MockDomainItf) {
+		s.EXPECT().GetSSOState(gomock.Any(), stateHash).Return(openState, nil)
+		s.EXPECT().UseSSOState(gomock.Any(), uint(3), gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name        string
+		binding     *string // the browser's cookie, "binding" when nil
+		setupMocks  func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf)
+		expectErr   bool
+		errCode     int
+		expectEvent bool
+	}{
+		{
+			name: "linked identity",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				expectState(s)
+				s.EXPECT().Exchange(gomock.Any(), "code", "verifier").Return(claims, nil)
+				s.EXPECT().GetUserIdentity(gomock.Any(), claims.Issuer, "sub-1").Return(&entity.UserIdentity{UserID: 7}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 7}).Return([]entity.User{jane}, nil)
+			},
+		},
+		{
+			name: "first sign-on links by email",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				expectState(s)
+				s.EXPECT().Exchange(gomock.Any(), "code", "verifier").Return(claims, nil)
+				s.EXPECT().GetUserIdentity(gomock.Any(), claims.Issuer, "sub-1").Return(nil, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{Email: "jane@example.com"}).Return([]entity.User{jane}, nil)
+				s.EXPECT().CreateUserIdentity(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, i entity.UserIdentity) error {
+						assert.Equal(t, uint(7), i.UserID)
+						assert.Equal(t, claims.Issuer, i.Issuer)
+						assert.Equal(t, "sub-1", i.Subject)
+						return nil
+					})
+			},
+		},
+		{
+			name: "unverified email is not matched",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				expectState(s)
+				s.EXPECT().Exchange(gomock.Any(), "code", "verifier").Return(unverified, nil)
+				s.EXPECT().GetUserIdentity(gomock.Any(), claims.Issuer, "sub-1").Return(nil, nil)
+			},
+			expectErr:   true,
+			errCode:     http.StatusForbidden,
+			expectEvent: true,
+		},
+		{
+			name: "deactivated user",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				expectState(s)
+				s.EXPECT().Exchange(gomock.Any(), "code", "verifier").Return(claims, nil)
+				s.EXPECT().GetUserIdentity(gomock.Any(), claims.Issuer, "sub-1").Return(&entity.UserIdentity{UserID: 7}, nil)
+				u.EXPECT().GetUsers(gomock.Any(), entity.GetUserFilter{ID: 7}).Return([]entity.User{{ID: 7}}, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusForbidden,
+		},
+		{
+			name: "nonce mismatch",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				expectState(s)
+				s.EXPECT().Exchange(gomock.Any(), "code", "verifier").Return(otherNonce, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusUnauthorized,
+		},
+		{
+			name: "expired state",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSSOState(gomock.Any(), stateHash).
+					Return(&entity.SSOState{ID: 3, ExpiresAt: now.Add(-time.Second)}, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusBadRequest,
+		},
+		{
+			name: "used state",
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSSOState(gomock.Any(), stateHash).
+					Return(&entity.SSOState{ID: 3, ExpiresAt: now.Add(time.Minute), UsedAt: &now}, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusBadRequest,
+		},
+		{
+			name:    "started in another browser",
+			binding: pkg.StringPtr("attacker"),
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSSOState(gomock.Any(), stateHash).Return(openState, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusBadRequest,
+		},
+		{
+			name:    "no binding cookie",
+			binding: pkg.StringPtr(""),
+			setupMocks: func(s *mockSSO.MockDomainItf, u *mockUser.MockDomainItf) {
+				s.EXPECT().GetSSOState(gomock.Any(), stateHash).Return(openState, nil)
+			},
+			expectErr: true,
+			errCode:   http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserDom := mockUser.NewMockDomainItf(ctrl)
+			mockSessionDom := mockSession.NewMockDomainItf(ctrl)
+			mockTwoFactorDom := mockTwoFactor.NewMockDomainItf(ctrl)
+			mockSecurityDom := mockSecurity.NewMockDomainItf(ctrl)
+			mockSSODom := mockSSO.NewMockDomainItf(ctrl)
+			mockTxDom := mockTx.NewMockDomainItf(ctrl)
+
+			mockTxDom.EXPECT().RunInTx(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				}).AnyTimes()
+			tt.setupMocks(mockSSODom, mockUserDom)
+			if tt.expectEvent {
+				mockSecurityDom.EXPECT().CreateSecurityEvent(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, e entity.SecurityEvent) error {
+						assert.Equal(t, entity.SecurityEventSSOFailed, e.Type)
+						assert.Equal(t, "10.0.0.1", e.IPAddress)
+						return nil
+					})
+			}
+			if !tt.expectErr {
+				mockTwoFactorDom.EXPECT().GetTwoFactor(gomock.Any(), uint(7)).Return(nil, nil)
+				mockSessionDom.EXPECT().CreateSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, s entity.Session) (*entity.Session, error) {
+						assert.Equal(t, uint(7), s.UserID)
+						s.ID = 9
+						return &s, nil
+					})
+			}
+
+			usecase := uc.InitUserUsecase(uc.Option{
+				UserDom:        mockUserDom,
+				SessionDom:     mockSessionDom,
+				TwoFactorDom:   mockTwoFactorDom,
+				SecurityDom:    mockSecurityDom,
+				SSODom:         mockSSODom,
+				TransactionDom: mockTxDom,
+				TokenPolicy:    entity.TokenPolicy{AccessTTL: 15 * time.Minute, RefreshTTL: 24 * time.Hour},
+				OIDC:           entity.OIDCConfig{IssuerURL: claims.Issuer, RequireVerifiedEmail: true},
+				KeySet:         testKeySet(t),
+			})
+
+			binding := "binding"
+			if tt.binding != nil {
+				binding = *tt.binding
+			}
+
+			result, err := usecase.CompleteSSO(context.Background(), entity.SSOCallbackRequest{
+				Code:      "code",
+				State:     "state",
+				Binding:   binding,
+				IPAddress: "10.0.0.1",
+			})
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.EqualValues(t, tt.errCode, x.ErrCode(err))
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.NotNil(t, result.Tokens) {
+				assert.NotEmpty(t, result.Tokens.AccessToken)
+				assert.Equal(t, uint(9), result.Tokens.SessionID)
+			}
+		})
+	}
+}
+
+func testKeySet(t *testing.T) *pkg.KeySet {
+	kid, privatePEM, _, err := pkg.GenerateSigningKey(pkg.AlgEdDSA)
+	assert.NoError(t, err)
+
+	key, err := pkg.ParseSigningKey(kid, pkg.AlgEdDSA, privatePEM)
+	assert.NoError(t, err)
+
+	keySet := pkg.NewKeySet()
+	keySet.Replace([]pkg.SigningKey{key})
+
+	return keySet
+}
+
+// allowLogins stubs the brute-force guard for tests that are not about it.
+func allowLogins(s *mockSecurity.MockDomainItf) {
+	s.EXPECT().LockedUntil(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	s.EXPECT().GetFailures(gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+	s.EXPECT().ClearFailures(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
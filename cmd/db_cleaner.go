@@ -23,11 +23,41 @@ func clean(db *gorm.DB) {
 	// migrate db
 	if err := db.Migrator().DropTable(
 		&User{},
+		&Session{},
+		&SigningKey{},
+		&TwoFactor{},
+		&RecoveryCode{},
+		&LoginChallenge{},
+		&SecurityEvent{},
+		&PasswordResetToken{},
+		&SSOState{},
+		&UserIdentity{},
+		&RolePermission{},
+		&Role{},
+		&EmployeeProfile{},
+		&Position{},
+		&Department{},
+		&AttendancePeriodTransition{},
 		&AttendancePeriod{},
+		&AttendanceBreak{},
 		&Attendance{},
 		&Overtime{},
 		&Reimbursement{},
 		&Payslip{},
+		&AttendanceCorrection{},
+		&TravelRequest{},
+		&Holiday{},
+		&Notification{},
+		&ShiftRoster{},
+		&Shift{},
+		&KioskScan{},
+		&Kiosk{},
+		&TimeClockPunch{},
+		&TimeClockEmployee{},
+		&TimeClock{},
+		&SyncEvent{},
+		&MobileDevice{},
+		&OfficeLocation{},
 	); err != nil {
 		log.Fatalf("failed to migrate tables: %v", err)
 	}
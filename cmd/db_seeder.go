@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"go.opentelemetry.io/otel"
 	"golang.org/x/crypto/bcrypt"
 
@@ -24,11 +25,22 @@ const (
 )
 
 type User struct {
-	ID        uint     `gorm:"primaryKey"`
-	Username  string   `gorm:"unique;not null"` // Unique index
-	Password  string   `gorm:"not null"`
-	Role      UserRole `gorm:"type:varchar(20);index"` // Optional index
-	Salary    float64  `gorm:"default:0"`
+	ID       uint   `gorm:"primaryKey"`
+	Username string `gorm:"unique;not null"` // Unique index
+	Password string `gorm:"not null"`
+	FullName string
+	Email    string   `gorm:"index"`
+	Role     UserRole `gorm:"type:varchar(20);index"` // Optional index
+	Salary   float64  `gorm:"default:0"`
+	IsActive bool     `gorm:"default:true;index"`
+
+	DepartmentID *uint `gorm:"index"`
+	PositionID   *uint `gorm:"index"`
+	ManagerID    *uint `gorm:"index"` // reporting line
+
+	MustChangePassword bool `gorm:"default:false"`
+	PasswordChangedAt  *time.Time
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -37,26 +49,181 @@ type AttendancePeriod struct {
 	ID        uint `gorm:"primaryKey"`
 	StartDate time.Time
 	EndDate   time.Time
-	Status    string
+	Status    string `gorm:"type:varchar(20);default:open;index"` // open, locked, payroll_processing, closed, reopened
 	ClosedAt  *time.Time
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
 
+type AttendancePeriodTransition struct {
+	ID                 uint `gorm:"primaryKey"`
+	AttendancePeriodID uint `gorm:"index"`
+	AttendancePeriod   AttendancePeriod
+	FromStatus         string `gorm:"type:varchar(20)"`
+	ToStatus           string `gorm:"type:varchar(20)"`
+	ChangedBy          *uint  // nil when the scheduler moved it
+	Note               string
+	CreatedAt          time.Time
+}
+
+type OfficeLocation struct {
+	ID           uint   `gorm:"primaryKey"`
+	Name         string `gorm:"not null"`
+	Latitude     float64
+	Longitude    float64
+	RadiusMeters float64
+	Active       bool `gorm:"default:true;index"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type Kiosk struct {
+	ID               uint   `gorm:"primaryKey"`
+	Name             string `gorm:"not null"`
+	OfficeLocationID uint   `gorm:"index"`
+	OfficeLocation   OfficeLocation
+	Secret           string `gorm:"not null"` // HMAC key for the kiosk's rotating QR tokens
+	Active           bool   `gorm:"default:true"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type KioskScan struct {
+	ID        uint   `gorm:"primaryKey"`
+	KioskID   uint   `gorm:"uniqueIndex:idx_kiosk_scan_nonce"` // A token can be redeemed once per employee
+	UserID    uint   `gorm:"uniqueIndex:idx_kiosk_scan_nonce"`
+	Nonce     string `gorm:"uniqueIndex:idx_kiosk_scan_nonce"`
+	ScannedAt time.Time
+}
+
+type TimeClock struct {
+	ID               uint   `gorm:"primaryKey"`
+	Name             string `gorm:"not null"`
+	OfficeLocationID *uint  `gorm:"index"`
+	OfficeLocation   *OfficeLocation
+	Secret           string `gorm:"not null"` // Shared secret the device pushes punches with
+	Active           bool   `gorm:"default:true"`
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+type TimeClockEmployee struct {
+	ID           uint   `gorm:"primaryKey"`
+	EmployeeCode string `gorm:"unique;not null"` // Code the employee is enrolled under on the devices
+	UserID       uint   `gorm:"index"`
+	User         User
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type TimeClockPunch struct {
+	ID           uint      `gorm:"primaryKey"`
+	TimeClockID  uint      `gorm:"uniqueIndex:idx_punch_dedup"` // Resent punches are skipped
+	EmployeeCode string    `gorm:"uniqueIndex:idx_punch_dedup"`
+	PunchedAt    time.Time `gorm:"uniqueIndex:idx_punch_dedup"`
+	UserID       *uint     `gorm:"index"`
+	Status       string    `gorm:"type:varchar(20);index"` // For the reconciliation view
+	Note         string
+	CreatedAt    time.Time
+}
+
+type MobileDevice struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"index"`
+	User      User
+	Name      string `gorm:"not null"`
+	Secret    string `gorm:"not null"` // HMAC key the app signs offline events with
+	Active    bool   `gorm:"default:true"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type SyncEvent struct {
+	ID         uint   `gorm:"primaryKey"`
+	UserID     uint   `gorm:"index"`
+	DeviceID   uint   `gorm:"uniqueIndex:idx_sync_event_client"` // An event is applied once per device
+	ClientID   string `gorm:"type:varchar(64);uniqueIndex:idx_sync_event_client"`
+	Type       string `gorm:"type:varchar(20)"`
+	RecordedAt time.Time
+	CreatedAt  time.Time
+}
+
+type Notification struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"index"`
+	User      User
+	Type      string `gorm:"type:varchar(50)"`
+	Message   string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
+
+type Shift struct {
+	ID              uint   `gorm:"primaryKey"`
+	Name            string `gorm:"not null"`
+	StartTime       string `gorm:"type:varchar(5);not null"` // HH:MM
+	EndTime         string `gorm:"type:varchar(5);not null"`
+	BreakMinutes    int    `gorm:"default:0"`
+	CrossesMidnight bool   `gorm:"default:false"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type ShiftRoster struct {
+	ID        uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"uniqueIndex:idx_roster_user_date"` // One shift per employee per day
+	User      User
+	ShiftID   uint `gorm:"index"`
+	Shift     Shift
+	Date      time.Time `gorm:"type:date;uniqueIndex:idx_roster_user_date"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
 type Attendance struct {
 	ID                 uint `gorm:"primaryKey"`
 	UserID             uint `gorm:"index"` // For per-user lookup
 	User               User
 	AttendancePeriodID uint `gorm:"index"` // For payroll filtering
 	AttendancePeriod   AttendancePeriod
+	ShiftID            *uint `gorm:"index"` // Rostered shift, nil for the default day shift
+	Shift              *Shift
+	WorkMode           string `gorm:"type:varchar(20);not null;default:office;index"` // For the per-mode report
 	CheckedInAt        time.Time
 	CheckedOutAt       time.Time
+	WorkedMinutes      int `gorm:"default:0"` // Set on check-out against the scheduled shift
+	BreakMinutes       int `gorm:"default:0"`
+	LateMinutes        int `gorm:"default:0"`
+	EarlyLeaveMinutes  int `gorm:"default:0"`
+	CheckInLatitude    *float64
+	CheckInLongitude   *float64
+	CheckInAccuracy    *float64
+	CheckInLocationID  *uint
+	CheckInKioskID     *uint
+	CheckOutLatitude   *float64
+	CheckOutLongitude  *float64
+	CheckOutAccuracy   *float64
+	CheckOutLocationID *uint
+	CheckOutKioskID    *uint
+	OutsideGeofence    bool `gorm:"default:false;index"` // For reviewing flagged punches
+	AutoClosed         bool `gorm:"default:false"`       // Check-out filled in by the scheduler
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 	Date               time.Time `gorm:"index"`     // For filtering by date
 	Version            uint      `gorm:"default:1"` // 👈 For optimistic locking
 }
 
+type AttendanceBreak struct {
+	ID           uint `gorm:"primaryKey"`
+	AttendanceID uint `gorm:"uniqueIndex:idx_attendance_open_break,where:ended_at IS NULL;index"` // One break in progress at a time
+	Attendance   Attendance
+	UserID       uint `gorm:"index"`
+	StartedAt    time.Time
+	EndedAt      *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
 type Overtime struct {
 	ID                 uint `gorm:"primaryKey"`
 	UserID             uint `gorm:"index"` // For per-user filtering
@@ -97,11 +264,205 @@ type Payslip struct {
 	AttendanceAmount   float64
 	ProratedSalary     float64
 	OvertimePay        float64
+	LateMinutes        int
+	EarlyLeaveMinutes  int
+	LatenessDeduction  float64
 	TotalPay           float64
+	Status             string `gorm:"type:varchar(20);default:issued;index"`
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
 }
 
+type AttendanceCorrection struct {
+	ID                  uint `gorm:"primaryKey"`
+	UserID              uint `gorm:"index"`
+	User                User
+	AttendanceID        *uint     `gorm:"index"`
+	Date                time.Time `gorm:"index"`
+	Type                string    `gorm:"type:varchar(30)"`
+	RequestedCheckInAt  *time.Time
+	RequestedCheckOutAt *time.Time
+	OriginalCheckInAt   *time.Time // kept for audit after approval
+	OriginalCheckOutAt  *time.Time
+	Reason              string `gorm:"not null"`
+	Status              string `gorm:"type:varchar(20);index"`
+	ReviewedBy          *uint
+	ReviewedAt          *time.Time
+	ReviewNote          string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+type TravelRequest struct {
+	ID          uint `gorm:"primaryKey"`
+	UserID      uint `gorm:"index"`
+	User        User
+	Destination string    `gorm:"not null"`
+	Purpose     string    `gorm:"not null"`
+	StartDate   time.Time `gorm:"type:date;index"` // For matching business trip check-ins
+	EndDate     time.Time `gorm:"type:date;index"`
+	Status      string    `gorm:"type:varchar(20);index"`
+	ReviewedBy  *uint
+	ReviewedAt  *time.Time
+	ReviewNote  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Department struct {
+	ID        uint   `gorm:"primaryKey"`
+	Name      string `gorm:"not null;uniqueIndex"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Position struct {
+	ID           uint   `gorm:"primaryKey"`
+	Name         string `gorm:"not null"`
+	DepartmentID uint   `gorm:"index"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type Session struct {
+	ID                uint   `gorm:"primaryKey"`
+	UserID            uint   `gorm:"index"`
+	RefreshTokenHash  string `gorm:"type:char(64);uniqueIndex"`
+	PreviousTokenHash string `gorm:"type:char(64);index"`
+	UserAgent         string
+	IPAddress         string `gorm:"type:varchar(45)"`
+	ExpiresAt         time.Time
+	LastUsedAt        time.Time
+	RevokedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+type SigningKey struct {
+	ID          uint   `gorm:"primaryKey"`
+	KeyID       string `gorm:"type:varchar(32);uniqueIndex"` // kid
+	Algorithm   string `gorm:"type:varchar(10)"`
+	PrivateKey  string `gorm:"not null"`
+	PublicKey   string `gorm:"not null"`
+	CreatedAt   time.Time
+	ActivatesAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP"` // Published before it signs
+	RetiredAt   *time.Time `gorm:"index"`
+}
+
+type TwoFactor struct {
+	ID           uint   `gorm:"primaryKey"`
+	UserID       uint   `gorm:"uniqueIndex"`
+	Secret       string `gorm:"type:varchar(64);not null"`
+	LastUsedStep int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type RecoveryCode struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	CodeHash  string `gorm:"type:char(64);index"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type LoginChallenge struct {
+	ID          uint   `gorm:"primaryKey"`
+	UserID      uint   `gorm:"index"`
+	TokenHash   string `gorm:"type:char(64);uniqueIndex"`
+	UserAgent   string
+	IPAddress   string `gorm:"type:varchar(45)"`
+	Attempts    int
+	ExpiresAt   time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+}
+
+type PasswordResetToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	TokenHash string `gorm:"type:char(64);uniqueIndex"`
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type SSOState struct {
+	ID           uint   `gorm:"primaryKey"`
+	StateHash    string `gorm:"type:char(64);uniqueIndex"`
+	BindingHash  string `gorm:"type:char(64)"`
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+	UsedAt       *time.Time
+	CreatedAt    time.Time
+}
+
+type UserIdentity struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index"`
+	Issuer    string `gorm:"uniqueIndex:idx_user_identity_subject"`
+	Subject   string `gorm:"uniqueIndex:idx_user_identity_subject"`
+	Email     string
+	CreatedAt time.Time
+}
+
+type SecurityEvent struct {
+	ID        uint   `gorm:"primaryKey"`
+	Type      string `gorm:"type:varchar(30);index"`
+	UserID    *uint  `gorm:"index"`
+	Username  string `gorm:"index"`
+	IPAddress string `gorm:"type:varchar(45);index"`
+	UserAgent string
+	ActorID   *uint
+	Detail    string
+	CreatedAt time.Time `gorm:"index"`
+}
+
+type Role struct {
+	ID          uint     `gorm:"primaryKey"`
+	Name        UserRole `gorm:"type:varchar(20);not null;uniqueIndex"`
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type RolePermission struct {
+	ID         uint     `gorm:"primaryKey"`
+	Role       UserRole `gorm:"type:varchar(20);not null;uniqueIndex:idx_role_permission"`
+	Permission string   `gorm:"type:varchar(50);not null;uniqueIndex:idx_role_permission"`
+	CreatedAt  time.Time
+}
+
+type EmployeeProfile struct {
+	ID                       uint       `gorm:"primaryKey"`
+	UserID                   uint       `gorm:"uniqueIndex"`
+	EmployeeNumber           string     `gorm:"type:varchar(30);index"`
+	Phone                    string     `gorm:"type:varchar(20)"`
+	HireDate                 *time.Time `gorm:"type:date"`
+	EmploymentType           string     `gorm:"type:varchar(20)"` // permanent, contract, intern
+	JobTitle                 string
+	NIK                      string `gorm:"type:varchar(16)"`
+	NPWP                     string `gorm:"type:varchar(16)"`
+	PTKPStatus               string `gorm:"type:varchar(10)"`
+	BankName                 string
+	BankAccountNumber        string `gorm:"type:varchar(20)"`
+	BankAccountName          string
+	EmergencyContactName     string
+	EmergencyContactPhone    string `gorm:"type:varchar(20)"`
+	EmergencyContactRelation string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+type Holiday struct {
+	ID        uint      `gorm:"primaryKey"`
+	Date      time.Time `gorm:"type:date;uniqueIndex"`
+	Name      string    `gorm:"not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
 type PayrollJob struct {
 	ID                 uint
 	AttendancePeriodID uint
@@ -128,12 +489,42 @@ func seed(db *gorm.DB) {
 	// migrate db
 	if err := db.AutoMigrate(
 		&User{},
+		&Session{},
+		&SigningKey{},
+		&TwoFactor{},
+		&RecoveryCode{},
+		&LoginChallenge{},
+		&SecurityEvent{},
+		&PasswordResetToken{},
+		&SSOState{},
+		&UserIdentity{},
+		&Role{},
+		&RolePermission{},
+		&EmployeeProfile{},
+		&Department{},
+		&Position{},
 		&AttendancePeriod{},
+		&AttendancePeriodTransition{},
+		&Shift{},
+		&ShiftRoster{},
+		&OfficeLocation{},
+		&Kiosk{},
+		&KioskScan{},
+		&TimeClock{},
+		&TimeClockEmployee{},
+		&TimeClockPunch{},
+		&MobileDevice{},
+		&SyncEvent{},
 		&Attendance{},
+		&AttendanceBreak{},
 		&Overtime{},
 		&Reimbursement{},
 		&Payslip{},
 		&PayrollJob{},
+		&AttendanceCorrection{},
+		&Notification{},
+		&TravelRequest{},
+		&Holiday{},
 	); err != nil {
 		log.Fatalf("failed to migrate tables: %v", err)
 	}
@@ -170,6 +561,7 @@ func seed(db *gorm.DB) {
 		log.Fatalln(err)
 	}
 
+	seedRoles(db)
 	seedAdmin(db)
 	seedEmployees(db, 100)
 	seedAttendancePeriods(db)
@@ -202,13 +594,51 @@ func connectDB() (*gorm.DB, error) {
 	return db, nil
 }
 
+func seedRoles(db *gorm.DB) {
+	roles := []Role{
+		{Name: RoleAdmin, Description: "Full access to everything"},
+		{Name: RoleEmployee, Description: "Own attendance, requests and payslips"},
+		{Name: UserRole(entity.RoleManager), Description: "Reviews and reports for their reporting line"},
+		{Name: UserRole(entity.RoleHR), Description: "Employee records, organization, schedules and attendance"},
+		{Name: UserRole(entity.RolePayrollAdmin), Description: "Attendance periods and payroll runs"},
+		{Name: UserRole(entity.RoleAuditor), Description: "Read-only access to employees, attendance and payroll"},
+	}
+
+	for _, r := range roles {
+		role := r
+		if err := db.FirstOrCreate(&role, Role{Name: r.Name}).Error; err != nil {
+			log.Fatalf("failed to seed role %s: %v", r.Name, err)
+		}
+
+		// Only fill in permissions for roles that have none, so edits made
+		// through the API survive a reseed
+		var count int64
+		db.Model(&RolePermission{}).Where("role = ?", r.Name).Count(&count)
+		if count > 0 {
+			continue
+		}
+
+		for _, p := range entity.DefaultRolePermissions[entity.UserRole(r.Name)] {
+			if err := db.Create(&RolePermission{Role: r.Name, Permission: string(p), CreatedAt: time.Now()}).Error; err != nil {
+				log.Printf("⚠️  Failed to insert permission %s for %s: %v", p, r.Name, err)
+			}
+		}
+	}
+
+	log.Println("✅ Roles created")
+}
+
 func seedAdmin(db *gorm.DB) {
 	hashedPassword := hashPassword("admin123") // Implement a real hash function!
 	admin := User{
 		Username: "admin",
 		Password: hashedPassword,
+		FullName: "Administrator",
 		Role:     RoleAdmin,
 		Salary:   0, // Admin doesn't need salary
+
+		// Everyone knows the seeded passwords
+		MustChangePassword: true,
 	}
 
 	if err := db.FirstOrCreate(&admin, User{Username: "admin"}).Error; err != nil {
@@ -229,8 +659,11 @@ func seedEmployees(db *gorm.DB, count int) {
 		employee := User{
 			Username: username,
 			Password: hashedPassword,
+			FullName: fmt.Sprintf("Employee %d", i+1),
 			Role:     RoleEmployee,
 			Salary:   salary,
+
+			MustChangePassword: true,
 		}
 
 		if err := db.Create(&employee).Error; err != nil {
@@ -250,26 +683,28 @@ func hashPassword(pw string) string {
 }
 
 func seedAttendancePeriods(db *gorm.DB) {
-	periods := []AttendancePeriod{
-		{
-			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-			EndDate:   time.Date(2025, 6, 15, 23, 59, 59, 0, time.UTC),
-			Status:    "open",
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		{
-			StartDate: time.Date(2025, 6, 16, 0, 0, 0, 0, time.UTC),
-			EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 0, time.UTC),
-			Status:    "open",
+	// The current and next period of the payroll calendar, semi-monthly when none is configured
+	cal := payrollCalendarFromEnv()
+	if cal.Frequency == "" {
+		cal.Frequency = entity.PayrollSemiMonthly
+	}
+
+	var periods []AttendancePeriod
+	start, end := cal.PeriodContaining(time.Now())
+	for i := 0; i < 2; i++ {
+		periods = append(periods, AttendancePeriod{
+			StartDate: start,
+			EndDate:   end.AddDate(0, 0, 1).Add(-time.Second),
+			Status:    entity.AttendancePeriodOpen,
 			CreatedAt: time.Now(),
 			UpdatedAt: time.Now(),
-		},
+		})
+		start, end = cal.PeriodContaining(end.AddDate(0, 0, 1))
 	}
 
 	for _, p := range periods {
 		var exists AttendancePeriod
-		err := db.Where("start_date = ? AND end_date = ?", p.StartDate, p.EndDate).First(&exists).Error
+		err := db.Where("start_date <= ? AND end_date >= ?", p.EndDate, p.StartDate).First(&exists).Error
 		if err == gorm.ErrRecordNotFound {
 			if err := db.Create(&p).Error; err != nil {
 				log.Printf("⚠️  Failed to insert attendance period: %v", err)
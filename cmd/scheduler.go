@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/zuhrulumam/go-hris/business/domain"
 	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
 	"github.com/zuhrulumam/go-hris/task"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -33,9 +37,48 @@ func runScheduler() {
 	// init asynq client
 	aClient = NewAsynqClient()
 
+	// init domain
+	dom = domain.Init(domain.Option{
+		DB: db,
+	})
+
+	// init usecase
+	uc = usecase.Init(dom, usecase.Option{
+		PayrollCalendar: payrollCalendarFromEnv(),
+		KeyRotation:     keyRotationPolicyFromEnv(),
+	})
+
+	autoCheckOutCutoff := autoCheckOutCutoffFromEnv()
+
+	// Upcoming periods only change when a new one is due, so check hourly
+	if err := generateAttendancePeriods(); err != nil {
+		log.Println("Scheduler error (periods):", err)
+	}
+	if err := uc.SigningKey.RotateKeys(context.Background()); err != nil {
+		log.Println("Scheduler error (signing keys):", err)
+	}
+	periodTicker := time.NewTicker(time.Hour)
+
+	// Each open attendance needs a shift lookup, and a few minutes late is
+	// soon enough for a cutoff measured in hours
+	checkOutTicker := time.NewTicker(5 * time.Minute)
+
 	ticker := time.NewTicker(10 * time.Second)
 	for {
 		select {
+		case <-periodTicker.C:
+			if err := generateAttendancePeriods(); err != nil {
+				log.Println("Scheduler error (periods):", err)
+			}
+			if err := uc.SigningKey.RotateKeys(context.Background()); err != nil {
+				log.Println("Scheduler error (signing keys):", err)
+			}
+		case <-checkOutTicker.C:
+			if autoCheckOutCutoff > 0 {
+				if err := closeMissingCheckOuts(autoCheckOutCutoff); err != nil {
+					log.Println("Scheduler error (auto check-out):", err)
+				}
+			}
 		case <-ticker.C:
 			if err := processPendingPayrollJobs(); err != nil {
 				log.Println("Scheduler error:", err)
@@ -47,6 +90,68 @@ func runScheduler() {
 	}
 }
 
+// autoCheckOutCutoffFromEnv reads how long after a shift ends an attendance
+// without a check-out is closed automatically. Defaults to 4 hours; zero or a
+// negative value turns auto check-out off.
+func autoCheckOutCutoffFromEnv() time.Duration {
+	minutes, err := strconv.Atoi(os.Getenv("AUTO_CHECKOUT_CUTOFF_MINUTES"))
+	if err != nil {
+		return 4 * time.Hour
+	}
+
+	return time.Duration(minutes) * time.Minute
+}
+
+// payrollCalendarFromEnv reads the calendar attendance periods are generated
+// from. PAYROLL_CALENDAR is monthly, semi_monthly or biweekly; unset or
+// anything else turns generation off. Monthly periods end on
+// PAYROLL_CUTOFF_DAY (1-28) or at month end, and biweekly periods are
+// counted from PAYROLL_BIWEEKLY_ANCHOR, a date one of them starts on.
+func payrollCalendarFromEnv() entity.PayrollCalendar {
+	cal := entity.PayrollCalendar{PeriodsAhead: 1}
+
+	switch freq := entity.PayrollFrequency(os.Getenv("PAYROLL_CALENDAR")); freq {
+	case entity.PayrollMonthly, entity.PayrollSemiMonthly, entity.PayrollBiweekly:
+		cal.Frequency = freq
+	default:
+		return cal
+	}
+
+	if day, err := strconv.Atoi(os.Getenv("PAYROLL_CUTOFF_DAY")); err == nil && day >= 1 && day <= 28 {
+		cal.CutoffDay = day
+	}
+
+	// Defaults to a Monday
+	cal.Anchor = time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	if anchor, err := time.ParseInLocation("2006-01-02", os.Getenv("PAYROLL_BIWEEKLY_ANCHOR"), time.Local); err == nil {
+		cal.Anchor = anchor
+	}
+
+	if ahead, err := strconv.Atoi(os.Getenv("ATTENDANCE_PERIODS_AHEAD")); err == nil && ahead >= 0 {
+		cal.PeriodsAhead = ahead
+	}
+
+	return cal
+}
+
+func generateAttendancePeriods() error {
+	created, err := uc.Attendance.GenerateAttendancePeriods(context.Background())
+	if created > 0 {
+		log.Printf("Created %d attendance periods from the payroll calendar", created)
+	}
+
+	return err
+}
+
+func closeMissingCheckOuts(cutoff time.Duration) error {
+	closed, err := uc.Attendance.AutoCloseAttendances(context.Background(), cutoff)
+	if closed > 0 {
+		log.Printf("Auto-closed %d attendances without check-out", closed)
+	}
+
+	return err
+}
+
 const maxJobsPerTick = 100 // Limit per scheduler cycle
 
 func processPendingPayrollJobs() error {
@@ -107,7 +212,7 @@ func closeFinishedAttendancePeriods() error {
 	var periods []entity.AttendancePeriod
 
 	if err := db.WithContext(ctx).
-		Where("status = ?", "open").
+		Where("status = ?", entity.AttendancePeriodPayrollProcessing).
 		Find(&periods).Error; err != nil {
 		return err
 	}
@@ -129,12 +234,11 @@ func closeFinishedAttendancePeriods() error {
 
 		if total > 0 && total == completed {
 			log.Printf("Closing period ID %d", period.ID)
-			if err := db.WithContext(ctx).Model(&entity.AttendancePeriod{}).
-				Where("id = ?", period.ID).
-				Updates(map[string]interface{}{
-					"status":    "closed",
-					"closed_at": time.Now(),
-				}).Error; err != nil {
+			if err := uc.Attendance.TransitionAttendancePeriod(ctx, entity.TransitionAttendancePeriodRequest{
+				ID:     period.ID,
+				Status: entity.AttendancePeriodClosed,
+				Note:   "all payroll jobs completed",
+			}); err != nil {
 				log.Printf("Failed to close period %d: %v", period.ID, err)
 			}
 		}
@@ -1,15 +1,22 @@
 package cmd
 
 import (
+	"context"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
 	"github.com/spf13/cobra"
 	"github.com/zuhrulumam/go-hris/business/domain"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/business/usecase"
 	"github.com/zuhrulumam/go-hris/handler"
+	"github.com/zuhrulumam/go-hris/pkg"
 	"github.com/zuhrulumam/go-hris/pkg/logger"
 	"github.com/zuhrulumam/go-hris/pkg/metrics"
 	"github.com/zuhrulumam/go-hris/pkg/middlewares"
@@ -60,9 +67,14 @@ func run() {
 
 	db = g
 
+	oidc := oidcConfigFromEnv()
+
 	// init domain
 	dom = domain.Init(domain.Option{
-		DB: db,
+		DB:    db,
+		Redis: NewRedisClient(),
+		SMTP:  smtpConfigFromEnv(),
+		OIDC:  oidc,
 	})
 
 	// init asynq client
@@ -70,9 +82,30 @@ func run() {
 
 	// init usecase
 	uc = usecase.Init(dom, usecase.Option{
-		AsynqClient: aClient,
+		AsynqClient:   aClient,
+		GeofenceMode:  geofenceModeFromEnv(),
+		WFHQuota:      wfhQuotaFromEnv(),
+		TokenPolicy:   tokenPolicyFromEnv(),
+		KeyRotation:   keyRotationPolicyFromEnv(),
+		TwoFactor:     twoFactorPolicyFromEnv(),
+		LoginThrottle: loginThrottlePolicyFromEnv(),
+		Password:      passwordPolicyFromEnv(),
+		PasswordReset: passwordResetPolicyFromEnv(),
+		OIDC:          oidc,
+
+		DisableSelfRegistration: os.Getenv("SELF_REGISTRATION") == "false",
 	})
 
+	// Make sure a signing key exists, then keep picking up the keys the
+	// scheduler rotates in
+	if err := uc.SigningKey.RotateKeys(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	if err := uc.SigningKey.LoadKeys(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	go reloadSigningKeys(signingKeyReloadInterval)
+
 	// init rest
 	handler.Init(handler.Option{
 		Uc:  uc,
@@ -83,6 +116,264 @@ func run() {
 	log.Println(app.Run(":8080"))
 }
 
+// geofenceModeFromEnv reads GEOFENCE_MODE. Punches outside every office are
+// flagged unless the mode is set to enforce or off.
+func geofenceModeFromEnv() entity.GeofenceMode {
+	switch mode := entity.GeofenceMode(os.Getenv("GEOFENCE_MODE")); mode {
+	case entity.GeofenceOff, entity.GeofenceEnforce:
+		return mode
+	default:
+		return entity.GeofenceFlag
+	}
+}
+
+// wfhQuotaFromEnv reads WFH_MONTHLY_QUOTA, the work-from-home days allowed
+// per month. Unset, invalid or zero means no limit.
+func wfhQuotaFromEnv() int {
+	quota, err := strconv.Atoi(os.Getenv("WFH_MONTHLY_QUOTA"))
+	if err != nil || quota < 0 {
+		return 0
+	}
+	return quota
+}
+
+// tokenPolicyFromEnv reads ACCESS_TOKEN_TTL_MINUTES (default 15) and
+// REFRESH_TOKEN_TTL_HOURS (default 720, 30 days).
+func tokenPolicyFromEnv() entity.TokenPolicy {
+	policy := entity.TokenPolicy{
+		AccessTTL:  15 * time.Minute,
+		RefreshTTL: 30 * 24 * time.Hour,
+	}
+
+	if minutes, err := strconv.Atoi(os.Getenv("ACCESS_TOKEN_TTL_MINUTES")); err == nil && minutes > 0 {
+		policy.AccessTTL = time.Duration(minutes) * time.Minute
+	}
+
+	if hours, err := strconv.Atoi(os.Getenv("REFRESH_TOKEN_TTL_HOURS")); err == nil && hours > 0 {
+		policy.RefreshTTL = time.Duration(hours) * time.Hour
+	}
+
+	return policy
+}
+
+// twoFactorPolicyFromEnv reads TWO_FACTOR_ISSUER (default go-hris), the
+// name authenticator apps show, TWO_FACTOR_REQUIRED_ROLES, a comma
+// separated list of roles that must use two-factor authentication
+// (default admin), and TWO_FACTOR_REQUIRED_PERMISSIONS, a comma separated
+// list of permissions whose holders must use it (default payroll:run), so
+// any role granted payroll later needs it too. Set both empty to make
+// two-factor authentication optional for everyone.
+func twoFactorPolicyFromEnv() entity.TwoFactorPolicy {
+	policy := entity.TwoFactorPolicy{
+		Issuer:              "go-hris",
+		RequiredRoles:       []entity.UserRole{entity.RoleAdmin},
+		RequiredPermissions: []entity.Permission{entity.PermPayrollRun},
+		ChallengeTTL:        5 * time.Minute,
+	}
+
+	if issuer := os.Getenv("TWO_FACTOR_ISSUER"); issuer != "" {
+		policy.Issuer = issuer
+	}
+
+	if roles, ok := os.LookupEnv("TWO_FACTOR_REQUIRED_ROLES"); ok {
+		policy.RequiredRoles = nil
+		for _, r := range strings.Split(roles, ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				policy.RequiredRoles = append(policy.RequiredRoles, entity.UserRole(r))
+			}
+		}
+	}
+
+	if permissions, ok := os.LookupEnv("TWO_FACTOR_REQUIRED_PERMISSIONS"); ok {
+		policy.RequiredPermissions = nil
+		for _, p := range strings.Split(permissions, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				policy.RequiredPermissions = append(policy.RequiredPermissions, entity.Permission(p))
+			}
+		}
+	}
+
+	return policy
+}
+
+// loginThrottlePolicyFromEnv reads LOGIN_LOCK_AFTER (default 5) and
+// LOGIN_IP_LOCK_AFTER (default 50), the failed logins that lock out a
+// username or an IP address, and LOGIN_LOCK_MINUTES (default 15), which is
+// both how long failures are remembered and how long a lockout lasts.
+func loginThrottlePolicyFromEnv() entity.LoginThrottlePolicy {
+	policy := entity.LoginThrottlePolicy{
+		Window:       15 * time.Minute,
+		DelayAfter:   3,
+		BaseDelay:    time.Second,
+		MaxDelay:     8 * time.Second,
+		LockAfter:    5,
+		IPLockAfter:  50,
+		LockDuration: 15 * time.Minute,
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("LOGIN_LOCK_AFTER")); err == nil && n > 0 {
+		policy.LockAfter = n
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("LOGIN_IP_LOCK_AFTER")); err == nil && n > 0 {
+		policy.IPLockAfter = n
+	}
+
+	if minutes, err := strconv.Atoi(os.Getenv("LOGIN_LOCK_MINUTES")); err == nil && minutes > 0 {
+		policy.Window = time.Duration(minutes) * time.Minute
+		policy.LockDuration = policy.Window
+	}
+
+	return policy
+}
+
+// passwordPolicyFromEnv reads PASSWORD_MIN_LENGTH (default 8) and
+// PASSWORD_REQUIRE_UPPER, PASSWORD_REQUIRE_LOWER, PASSWORD_REQUIRE_DIGIT and
+// PASSWORD_REQUIRE_SYMBOL. Upper and lowercase letters and a digit are
+// required unless turned off with "false"; symbols only when set to "true".
+func passwordPolicyFromEnv() entity.PasswordPolicy {
+	policy := entity.PasswordPolicy{
+		MinLength:     8,
+		RequireUpper:  os.Getenv("PASSWORD_REQUIRE_UPPER") != "false",
+		RequireLower:  os.Getenv("PASSWORD_REQUIRE_LOWER") != "false",
+		RequireDigit:  os.Getenv("PASSWORD_REQUIRE_DIGIT") != "false",
+		RequireSymbol: os.Getenv("PASSWORD_REQUIRE_SYMBOL") == "true",
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("PASSWORD_MIN_LENGTH")); err == nil && n > 0 {
+		policy.MinLength = n
+	}
+
+	return policy
+}
+
+// passwordResetPolicyFromEnv reads PASSWORD_RESET_URL, the page of the web
+// app that takes the reset token, PASSWORD_RESET_TTL_MINUTES (default 30),
+// how long a reset link works, and PASSWORD_RESET_LIMIT (default 3) and
+// PASSWORD_RESET_IP_LIMIT (default 20), how many links can be asked for per
+// username or email and per IP address in an hour.
+func passwordResetPolicyFromEnv() entity.PasswordResetPolicy {
+	policy := entity.PasswordResetPolicy{
+		TokenTTL:       30 * time.Minute,
+		URL:            os.Getenv("PASSWORD_RESET_URL"),
+		RequestLimit:   3,
+		IPRequestLimit: 20,
+		RequestWindow:  time.Hour,
+	}
+
+	if policy.URL == "" {
+		policy.URL = "http://localhost:8080/reset-password"
+	}
+
+	if minutes, err := strconv.Atoi(os.Getenv("PASSWORD_RESET_TTL_MINUTES")); err == nil && minutes > 0 {
+		policy.TokenTTL = time.Duration(minutes) * time.Minute
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("PASSWORD_RESET_LIMIT")); err == nil && n >= 0 {
+		policy.RequestLimit = n
+	}
+
+	if n, err := strconv.Atoi(os.Getenv("PASSWORD_RESET_IP_LIMIT")); err == nil && n >= 0 {
+		policy.IPRequestLimit = n
+	}
+
+	return policy
+}
+
+// smtpConfigFromEnv reads SMTP_HOST, SMTP_PORT (default 587),
+// SMTP_USERNAME, SMTP_PASSWORD and SMTP_FROM. Without SMTP_HOST emails are
+// only logged.
+func smtpConfigFromEnv() entity.SMTPConfig {
+	cfg := entity.SMTPConfig{
+		Host:     os.Getenv("SMTP_HOST"),
+		Port:     587,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("SMTP_FROM"),
+	}
+
+	if port, err := strconv.Atoi(os.Getenv("SMTP_PORT")); err == nil && port > 0 {
+		cfg.Port = port
+	}
+
+	if cfg.From == "" {
+		cfg.From = "no-reply@go-hris.local"
+	}
+
+	return cfg
+}
+
+// oidcConfigFromEnv reads OIDC_ISSUER_URL, OIDC_CLIENT_ID,
+// OIDC_CLIENT_SECRET, OIDC_REDIRECT_URL and OIDC_SCOPES (default
+// "openid email profile"). Single sign-on is off without OIDC_ISSUER_URL,
+// which must match the provider's issuer exactly, trailing slash included.
+// OIDC_REQUIRE_VERIFIED_EMAIL=false also links provider accounts whose
+// email address the provider has not verified.
+func oidcConfigFromEnv() entity.OIDCConfig {
+	cfg := entity.OIDCConfig{
+		IssuerURL:            os.Getenv("OIDC_ISSUER_URL"),
+		ClientID:             os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret:         os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:          os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:               strings.Fields(os.Getenv("OIDC_SCOPES")),
+		RequireVerifiedEmail: os.Getenv("OIDC_REQUIRE_VERIFIED_EMAIL") != "false",
+		StateTTL:             10 * time.Minute,
+	}
+
+	if cfg.RedirectURL == "" {
+		cfg.RedirectURL = "http://localhost:8080/sso/callback"
+	}
+
+	if len(cfg.Scopes) == 0 {
+		cfg.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return cfg
+}
+
+// signingKeyReloadInterval is how often each API instance reloads the
+// signing keys the scheduler rotates in.
+const signingKeyReloadInterval = time.Minute
+
+// keyRotationPolicyFromEnv reads JWT_SIGNING_ALG (RS256, the default, or
+// EdDSA) and JWT_KEY_ROTATION_DAYS (default 30). A new key is published two
+// reload intervals before it signs, so every instance has loaded it by
+// then. Old keys are retired once every access token they signed has
+// expired, with some slack for instances still reloading keys.
+func keyRotationPolicyFromEnv() entity.KeyRotationPolicy {
+	policy := entity.KeyRotationPolicy{
+		Algorithm:    pkg.AlgRS256,
+		Interval:     30 * 24 * time.Hour,
+		PublishAhead: 2 * signingKeyReloadInterval,
+		RetireAfter:  tokenPolicyFromEnv().AccessTTL + 10*time.Minute,
+	}
+
+	if os.Getenv("JWT_SIGNING_ALG") == pkg.AlgEdDSA {
+		policy.Algorithm = pkg.AlgEdDSA
+	}
+
+	if days, err := strconv.Atoi(os.Getenv("JWT_KEY_ROTATION_DAYS")); err == nil && days > 0 {
+		policy.Interval = time.Duration(days) * 24 * time.Hour
+	}
+
+	return policy
+}
+
+func reloadSigningKeys(every time.Duration) {
+	ticker := time.NewTicker(every)
+	for range ticker.C {
+		if err := uc.SigningKey.LoadKeys(context.Background()); err != nil {
+			log.Println("Failed to reload signing keys:", err)
+		}
+	}
+}
+
+func NewRedisClient() *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr: os.Getenv("REDIS_HOST"),
+	})
+}
+
 func NewAsynqClient() *asynq.Client {
 	return asynq.NewClient(asynq.RedisClientOpt{
 		Addr: os.Getenv("REDIS_HOST"),
@@ -3,10 +3,12 @@ package cmd
 import (
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/hibiken/asynq"
 	"github.com/spf13/cobra"
 	"github.com/zuhrulumam/go-hris/business/domain"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/business/usecase"
 	"github.com/zuhrulumam/go-hris/handler/worker"
 	"github.com/zuhrulumam/go-hris/task"
@@ -39,19 +41,41 @@ func runWorker() {
 
 	// init domain
 	dom = domain.Init(domain.Option{
-		DB: db,
+		DB:   db,
+		SMTP: smtpConfigFromEnv(),
 	})
 
 	// init usecase
-	uc = usecase.Init(dom, usecase.Option{})
+	uc = usecase.Init(dom, usecase.Option{
+		LatenessPolicy: latenessPolicyFromEnv(),
+		PasswordReset:  passwordResetPolicyFromEnv(),
+	})
 
 	handler := &worker.Handler{
 		Payslip: uc.Payslip,
+		User:    uc.User,
 	}
 
 	mux.HandleFunc(task.TypeCreatePayroll, handler.HandleCreatePayrollTask)
+	mux.HandleFunc(task.TypeSendPasswordReset, handler.HandleSendPasswordResetTask)
 
 	if err := srv.Run(mux); err != nil {
 		log.Fatalf("😢 Could not run Asynq worker: %v", err)
 	}
 }
+
+// latenessPolicyFromEnv reads the payroll lateness policy. Deductions are
+// off unless LATENESS_DEDUCTION_ENABLED is true.
+func latenessPolicyFromEnv() entity.LatenessPolicy {
+	enabled, _ := strconv.ParseBool(os.Getenv("LATENESS_DEDUCTION_ENABLED"))
+	includeEarlyLeave, _ := strconv.ParseBool(os.Getenv("LATENESS_INCLUDE_EARLY_LEAVE"))
+	graceMinutes, _ := strconv.Atoi(os.Getenv("LATENESS_GRACE_MINUTES"))
+	dailyMinutes, _ := strconv.Atoi(os.Getenv("LATENESS_DAILY_MINUTES"))
+
+	return entity.LatenessPolicy{
+		Enabled:           enabled,
+		GraceMinutes:      graceMinutes,
+		IncludeEarlyLeave: includeEarlyLeave,
+		DailyMinutes:      dailyMinutes,
+	}
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// GetRoles godoc
+// @Summary      List roles
+// @Description  Lists every role with the permissions it grants. Admins always hold every permission.
+// @Tags         Access
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.RoleListResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/role [get]
+func (e *rest) GetRoles(c *gin.Context) {
+	roles, err := e.uc.Access.GetRoles(c.Request.Context())
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]RoleResp, 0, len(roles))
+	for _, r := range roles {
+		permissions := make([]string, 0, len(r.Permissions))
+		for _, p := range r.Permissions {
+			permissions = append(permissions, string(p))
+		}
+
+		data = append(data, RoleResp{
+			Name:        string(r.Role.Name),
+			Description: r.Role.Description,
+			Permissions: permissions,
+		})
+	}
+
+	c.JSON(http.StatusOK, RoleListResponse{Data: data})
+}
+
+// SetRolePermissions godoc
+// @Summary      Set a role's permissions
+// @Description  Replaces the permissions a role grants. The admin role cannot be edited. Other API instances pick up the change within a minute.
+// @Tags         Access
+// @Accept       json
+// @Produce      json
+// @Param        name path string true "Role name"
+// @Param        body body handler.SetRolePermissionsRequest true "Permissions"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/role/{name}/permissions [put]
+func (e *rest) SetRolePermissions(c *gin.Context) {
+	var input SetRolePermissionsRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	permissions := make([]entity.Permission, 0, len(input.Permissions))
+	for _, p := range input.Permissions {
+		permissions = append(permissions, entity.Permission(p))
+	}
+
+	err := e.uc.Access.SetRolePermissions(c.Request.Context(), entity.SetRolePermissionsRequest{
+		Role:        entity.UserRole(c.Param("name")),
+		Permissions: permissions,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Role permissions updated successfully!",
+	})
+}
+
+// accessScope works out whose data the caller may see given the permission
+// for everyone and the one for their reporting line.
+func (e *rest) accessScope(c *gin.Context, all, team entity.Permission) (entity.AccessScope, error) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		return entity.AccessScope{}, x.NewWithCode(http.StatusUnauthorized, "missing user context")
+	}
+
+	return e.uc.Access.Scope(c.Request.Context(), userID.(uint), c.GetString("role"), all, team)
+}
+
+// scopedUserID parses the optional user_id query and checks the caller may
+// see that user. It returns 0 when user_id is not given.
+func scopedUserID(c *gin.Context, scope entity.AccessScope) (uint, error) {
+	userIDStr := c.Query("user_id")
+	if userIDStr == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(userIDStr)
+	if err != nil || id <= 0 {
+		return 0, x.NewWithCode(http.StatusBadRequest, "invalid user_id")
+	}
+
+	if !scope.Allows(uint(id)) {
+		return 0, x.NewWithCode(http.StatusForbidden, "user is outside your access scope")
+	}
+
+	return uint(id), nil
+}
+
+// checkPermission answers 403 unless the caller's role grants p, for checks
+// that depend on the request body rather than the route.
+func (e *rest) checkPermission(c *gin.Context, p entity.Permission) error {
+	allowed, err := e.uc.Access.HasPermission(c.Request.Context(), c.GetString("role"), string(p))
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return x.NewWithCode(http.StatusForbidden, "missing permission "+string(p))
+	}
+
+	return nil
+}
+
+// checkEmployeeScope checks the caller may see employee id's account and HR
+// records, which hold salary, tax and bank details.
+func (e *rest) checkEmployeeScope(c *gin.Context, id uint) error {
+	scope, err := e.accessScope(c, entity.PermEmployeeReadAll, entity.PermEmployeeRead)
+	if err != nil {
+		return err
+	}
+
+	if !scope.Allows(id) {
+		return x.NewWithCode(http.StatusForbidden, "employee is outside your access scope")
+	}
+
+	return nil
+}
+
+// checkTargetAccount answers 403 unless the caller may change employee
+// id's account: only admins change admins, and an account whose role
+// grants permissions the caller lacks needs role:manage. Otherwise a
+// caller could change its email, reset its password and take it over.
+func (e *rest) checkTargetAccount(c *gin.Context, id uint) error {
+	role := c.GetString("role")
+	if role == string(entity.RoleAdmin) {
+		return nil
+	}
+
+	target, err := e.uc.User.GetEmployee(c.Request.Context(), id)
+	if err != nil {
+		return err
+	}
+
+	if target.Role == entity.RoleAdmin {
+		return x.NewWithCode(http.StatusForbidden, "only admins can change admin accounts")
+	}
+
+	allowed, err := e.uc.Access.CanManageRole(c.Request.Context(), role, target.Role)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return x.NewWithCode(http.StatusForbidden, "changing an account with permissions you do not hold needs role:manage")
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"math"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -17,10 +19,11 @@ var (
 
 // CheckIn godoc
 // @Summary      Employee check-in
-// @Description  Records employee check-in attendance
+// @Description  Records employee check-in attendance in a work mode, office by default. Office check-ins are checked against the office geofences, or a scanned kiosk QR token is verified instead. Work from home is limited per month, a client site visit needs the device location and a business trip needs an approved travel request for the day.
 // @Tags         Attendance
 // @Accept       json
 // @Produce      json
+// @Param        body body handler.CheckInRequest false "Work mode with device location or kiosk token"
 // @Success      200 {object} handler.CheckInResponse
 // @Failure      400 {object} handler.ErrorResponse
 // @Router       /api/attendance/checkin [post]
@@ -33,9 +36,18 @@ func (e *rest) CheckIn(c *gin.Context) {
 		return
 	}
 
+	var input CheckInRequest
+	if err := bindPunch(c, &input); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
 	err := e.uc.Attendance.CheckIn(ctx, entity.CheckIn{
-		UserID: userID.(uint),
-		Date:   time.Now(),
+		UserID:     userID.(uint),
+		Date:       time.Now(),
+		WorkMode:   entity.WorkMode(input.WorkMode),
+		Location:   input.geoPoint(),
+		KioskToken: input.KioskToken,
 	})
 	if err != nil {
 		e.compileError(c, err)
@@ -50,10 +62,11 @@ func (e *rest) CheckIn(c *gin.Context) {
 
 // CheckOut godoc
 // @Summary      Employee check-out
-// @Description  Records employee check-out attendance
+// @Description  Records employee check-out attendance, verified the same way as the day's check-in for its work mode.
 // @Tags         Attendance
 // @Accept       json
 // @Produce      json
+// @Param        body body handler.PunchRequest false "Device location or kiosk token"
 // @Success      200 {object} handler.CheckOutResponse
 // @Failure      400 {object} handler.ErrorResponse
 // @Router       /api/attendance/checkout [post]
@@ -66,9 +79,17 @@ func (e *rest) CheckOut(c *gin.Context) {
 		return
 	}
 
+	var input PunchRequest
+	if err := bindPunch(c, &input); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
 	err := e.uc.Attendance.CheckOut(ctx, entity.CheckOut{
-		UserID: userID.(uint),
-		Date:   time.Now(),
+		UserID:     userID.(uint),
+		Date:       time.Now(),
+		Location:   input.geoPoint(),
+		KioskToken: input.KioskToken,
 	})
 	if err != nil {
 		e.compileError(c, err)
@@ -81,6 +102,143 @@ func (e *rest) CheckOut(c *gin.Context) {
 	})
 }
 
+// StartBreak godoc
+// @Summary      Start a break
+// @Description  Starts a break on the attendance the employee is checked in to. Several breaks can be taken in a day, one at a time.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Router       /api/attendance/break/start [post]
+func (e *rest) StartBreak(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	err := e.uc.Attendance.StartBreak(c.Request.Context(), entity.StartBreak{
+		UserID: userID.(uint),
+		Date:   time.Now(),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Break started",
+	})
+}
+
+// EndBreak godoc
+// @Summary      End a break
+// @Description  Ends the break in progress. On check-out worked time loses the recorded break time, or the shift's scheduled break if that is longer.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/attendance/break/end [post]
+func (e *rest) EndBreak(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	err := e.uc.Attendance.EndBreak(c.Request.Context(), entity.EndBreak{
+		UserID: userID.(uint),
+		Date:   time.Now(),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Break ended",
+	})
+}
+
+// SyncAttendance godoc
+// @Summary      Sync offline check-ins and check-outs
+// @Description  Uploads check-in/check-out events the mobile app recorded without a connection. Each event carries a client-generated id, so resending a batch is safe, and a hex HMAC-SHA256 signature made with the device secret over "client_id|type|recorded_at unix seconds|latitude|longitude" (coordinates with six decimals, empty when absent). The device clock must be within five minutes of the server. Events are applied in recorded order and each gets its own result.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.SyncAttendanceRequest true "Offline events"
+// @Success      200 {object} handler.SyncAttendanceResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/attendance/sync [post]
+func (e *rest) SyncAttendance(c *gin.Context) {
+	var input SyncAttendanceRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	events := make([]entity.OfflineEvent, 0, len(input.Events))
+	for _, ev := range input.Events {
+		event := entity.OfflineEvent{
+			ClientID:   ev.ClientID,
+			Type:       entity.SyncEventType(ev.Type),
+			RecordedAt: ev.RecordedAt,
+			Signature:  ev.Signature,
+		}
+		if ev.Latitude != nil {
+			event.Location = &entity.GeoPoint{
+				Latitude:       *ev.Latitude,
+				Longitude:      *ev.Longitude,
+				AccuracyMeters: ev.Accuracy,
+			}
+		}
+		events = append(events, event)
+	}
+
+	results, err := e.uc.Attendance.SyncOfflineEvents(c.Request.Context(), entity.SyncAttendanceRequest{
+		UserID:     userID.(uint),
+		DeviceID:   input.DeviceID,
+		DeviceTime: input.DeviceTime,
+		Events:     events,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]SyncEventResultResp, 0, len(results))
+	for _, r := range results {
+		data = append(data, SyncEventResultResp{
+			ClientID: r.ClientID,
+			Status:   string(r.Status),
+			Message:  r.Message,
+		})
+	}
+
+	c.JSON(http.StatusOK, SyncAttendanceResponse{
+		Success: true,
+		Results: data,
+	})
+}
+
 // CreateOvertime godoc
 // @Summary      Submit overtime request
 // @Description  Allows an employee to submit an overtime record
@@ -138,14 +296,17 @@ func (e *rest) CreateOvertime(c *gin.Context) {
 
 // CreateAttendancePeriod godoc
 // @Summary      Create a new attendance period
-// @Description  Creates a new attendance period with start and end date
-// @Tags         attendance
+// @Description  Admin creates an open attendance period. Periods cannot share a day with another period.
+// @Tags         Attendance
 // @Accept       json
 // @Produce      json
 // @Param        request body CreateAttendancePeriodRequest true "Attendance Period Data"
-// @Success      201 {object} GenericResponse
-// @Failure      400,500 {object} ErrorResponse
-// @Router       /attendance-periods [post]
+// @Success      200 {object} GenericResponse
+// @Failure      400 {object} ErrorResponse
+// @Failure      401 {object} ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      409 {object} ErrorResponse
+// @Router       /api/attendance/period [post]
 // @Security     BearerAuth
 func (e *rest) CreateAttendancePeriod(c *gin.Context) {
 	var input CreateAttendancePeriodRequest
@@ -157,24 +318,176 @@ func (e *rest) CreateAttendancePeriod(c *gin.Context) {
 
 	startdate, err := time.Parse("2006-01-02", input.StartDate)
 	if err != nil {
-		e.compileError(c, err)
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid start_date"))
 		return
 	}
 
 	enddate, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid end_date"))
+		return
+	}
+
+	err = e.uc.Attendance.CreateAttendancePeriod(c.Request.Context(), entity.CreateAttendancePeriodRequest{
+		StartDate: startdate,
+		EndDate:   enddate,
+	})
 	if err != nil {
 		e.compileError(c, err)
 		return
 	}
 
-	if startdate.After(enddate) {
-		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date"))
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Attendance period created successfully!",
+	})
+}
+
+// GetAttendancePeriods godoc
+// @Summary      List attendance periods
+// @Description  Lists attendance periods, newest first, optionally by status
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "open, locked, payroll_processing, closed or reopened"
+// @Success      200 {object} handler.AttendancePeriodListResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/attendance/period [get]
+// @Security     BearerAuth
+func (e *rest) GetAttendancePeriods(c *gin.Context) {
+	periods, err := e.uc.Attendance.GetAttendancePeriods(c.Request.Context(), entity.GetAttendancePeriodFilter{
+		Status: c.Query("status"),
+	})
+	if err != nil {
+		e.compileError(c, err)
 		return
 	}
 
-	err = e.uc.Attendance.CreateAttendancePeriod(c.Request.Context(), entity.CreateAttendancePeriodRequest{
-		StartDate: startdate,
-		EndDate:   enddate,
+	data := make([]AttendancePeriodResp, 0, len(periods))
+	for _, p := range periods {
+		data = append(data, AttendancePeriodResp{
+			ID:        p.ID,
+			StartDate: p.StartDate.Format("2006-01-02"),
+			EndDate:   p.EndDate.Format("2006-01-02"),
+			Status:    p.Status,
+			ClosedAt:  p.ClosedAt,
+			CreatedAt: p.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, AttendancePeriodListResponse{Data: data})
+}
+
+// UpdateAttendancePeriod godoc
+// @Summary      Change an attendance period's dates
+// @Description  Admin moves the dates of an open or reopened period. The new dates cannot overlap another period or leave recorded attendance outside.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Attendance Period ID"
+// @Param        body body handler.UpdateAttendancePeriodRequest true "New dates"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Failure      409 {object} handler.ErrorResponse
+// @Router       /api/attendance/period/{id} [put]
+// @Security     BearerAuth
+func (e *rest) UpdateAttendancePeriod(c *gin.Context) {
+	var input UpdateAttendancePeriodRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid attendance period id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid start_date"))
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid end_date"))
+		return
+	}
+
+	err = e.uc.Attendance.UpdateAttendancePeriod(c.Request.Context(), entity.UpdateAttendancePeriodRequest{
+		ID:        uint(id),
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Attendance period updated successfully!",
+	})
+}
+
+// TransitionAttendancePeriod godoc
+// @Summary      Move an attendance period to another status
+// @Description  Admin moves a period along its lifecycle: open -> locked -> payroll_processing -> closed -> reopened. A locked period can be unlocked, payroll processing can fall back to locked and a reopened period is locked again before payroll reruns. Each change is kept in the period's history.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Attendance Period ID"
+// @Param        body body handler.TransitionAttendancePeriodRequest true "Target status"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Failure      409 {object} handler.ErrorResponse
+// @Router       /api/attendance/period/{id}/transition [post]
+// @Security     BearerAuth
+func (e *rest) TransitionAttendancePeriod(c *gin.Context) {
+	var input TransitionAttendancePeriodRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid attendance period id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	changedBy := userID.(uint)
+	err = e.uc.Attendance.TransitionAttendancePeriod(c.Request.Context(), entity.TransitionAttendancePeriodRequest{
+		ID:        uint(id),
+		Status:    input.Status,
+		ChangedBy: &changedBy,
+		Note:      input.Note,
 	})
 	if err != nil {
 		e.compileError(c, err)
@@ -183,6 +496,522 @@ func (e *rest) CreateAttendancePeriod(c *gin.Context) {
 
 	c.JSON(http.StatusOK, GenericResponse{
 		Success: true,
-		Message: "Attendance submitted successfully!",
+		Message: "Attendance period is now " + input.Status,
 	})
 }
+
+// GetAttendancePeriodHistory godoc
+// @Summary      Attendance period status history
+// @Description  Admin views every status change of a period, oldest first
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Attendance Period ID"
+// @Success      200 {object} handler.AttendancePeriodTransitionListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/attendance/period/{id}/history [get]
+// @Security     BearerAuth
+func (e *rest) GetAttendancePeriodHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid attendance period id"))
+		return
+	}
+
+	transitions, err := e.uc.Attendance.GetAttendancePeriodTransitions(c.Request.Context(), uint(id))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]AttendancePeriodTransitionResp, 0, len(transitions))
+	for _, t := range transitions {
+		data = append(data, AttendancePeriodTransitionResp{
+			ID:         t.ID,
+			FromStatus: t.FromStatus,
+			ToStatus:   t.ToStatus,
+			ChangedBy:  t.ChangedBy,
+			Note:       t.Note,
+			CreatedAt:  t.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, AttendancePeriodTransitionListResponse{
+		AttendancePeriodID: uint(id),
+		Data:               data,
+	})
+}
+
+// SubmitAttendanceCorrection godoc
+// @Summary      Submit attendance correction
+// @Description  Employee requests a fix for a missing check-in, missing check-out or wrong time
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.AttendanceCorrectionRequest true "Correction Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/attendance/correction [post]
+func (e *rest) SubmitAttendanceCorrection(c *gin.Context) {
+	var (
+		input AttendanceCorrectionRequest
+		ctx   = c.Request.Context()
+	)
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid date"))
+		return
+	}
+
+	checkIn, err := parseOptionalTime(input.CheckInAt)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid check_in_at"))
+		return
+	}
+
+	checkOut, err := parseOptionalTime(input.CheckOutAt)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid check_out_at"))
+		return
+	}
+
+	err = e.uc.Attendance.SubmitCorrection(ctx, entity.SubmitAttendanceCorrection{
+		UserID:     userID.(uint),
+		Date:       date,
+		Type:       entity.AttendanceCorrectionType(input.Type),
+		CheckInAt:  checkIn,
+		CheckOutAt: checkOut,
+		Reason:     input.Reason,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Attendance correction submitted successfully!",
+	})
+}
+
+// GetAttendanceCorrections godoc
+// @Summary      List attendance corrections
+// @Description  Employees see their own correction requests, managers also see their reports', and attendance:read_all holders see everyone
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "pending, approved or rejected"
+// @Param        user_id query int false "User ID, must be within your access scope"
+// @Success      200 {object} handler.AttendanceCorrectionListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/attendance/correction [get]
+func (e *rest) GetAttendanceCorrections(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	targetID, err := scopedUserID(c, scope)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	filter := entity.GetAttendanceCorrectionFilter{
+		UserID:  targetID,
+		UserIDs: scope.UserIDs,
+		Status:  c.Query("status"),
+	}
+
+	corrections, err := e.uc.Attendance.GetCorrections(ctx, filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]AttendanceCorrectionResp, 0, len(corrections))
+	for _, cr := range corrections {
+		data = append(data, AttendanceCorrectionResp{
+			ID:                  cr.ID,
+			UserID:              cr.UserID,
+			AttendanceID:        cr.AttendanceID,
+			Date:                cr.Date.Format("2006-01-02"),
+			Type:                string(cr.Type),
+			RequestedCheckInAt:  cr.RequestedCheckInAt,
+			RequestedCheckOutAt: cr.RequestedCheckOutAt,
+			OriginalCheckInAt:   cr.OriginalCheckInAt,
+			OriginalCheckOutAt:  cr.OriginalCheckOutAt,
+			Reason:              cr.Reason,
+			Status:              cr.Status,
+			ReviewedBy:          cr.ReviewedBy,
+			ReviewedAt:          cr.ReviewedAt,
+			ReviewNote:          cr.ReviewNote,
+			CreatedAt:           cr.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, AttendanceCorrectionListResponse{Data: data})
+}
+
+// ReviewAttendanceCorrection godoc
+// @Summary      Approve or reject attendance correction
+// @Description  Reviews a pending correction; approval updates the attendance record. Nobody can review their own correction, and managers can only review their reports' corrections.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Correction ID"
+// @Param        body body handler.ReviewAttendanceCorrectionRequest true "Review Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/attendance/correction/{id}/review [post]
+func (e *rest) ReviewAttendanceCorrection(c *gin.Context) {
+	var (
+		input ReviewAttendanceCorrectionRequest
+		ctx   = c.Request.Context()
+	)
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid correction id"))
+		return
+	}
+
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	corrections, err := e.uc.Attendance.GetCorrections(ctx, entity.GetAttendanceCorrectionFilter{ID: uint(id)})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	if len(corrections) == 0 {
+		e.compileError(c, x.NewWithCode(http.StatusNotFound, "attendance correction not found"))
+		return
+	}
+
+	// Nobody reviews their own correction, whatever their scope
+	if corrections[0].UserID == userID.(uint) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "you cannot review your own request"))
+		return
+	}
+
+	// Managers may only review their reports' corrections
+	if !scope.All && !scope.Allows(corrections[0].UserID) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "you can only review requests from your reports"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Attendance.ReviewCorrection(ctx, entity.ReviewAttendanceCorrection{
+		ID:         uint(id),
+		ReviewerID: userID.(uint),
+		Approve:    input.Action == "approve",
+		Note:       input.Note,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Attendance correction reviewed successfully!",
+	})
+}
+
+// GetAttendanceReport godoc
+// @Summary      Attendance report for an employee
+// @Description  Returns each attendance day with worked, late and early-leave minutes plus totals and a breakdown by work mode, for a range of at most 366 days. Employees see their own report; managers and attendance:read_all holders can pass user_id.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        start_date query string true "Start date (YYYY-MM-DD)"
+// @Param        end_date query string true "End date (YYYY-MM-DD)"
+// @Param        user_id query int false "User ID, must be within your access scope"
+// @Success      200 {object} handler.AttendanceReportResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/attendance/report [get]
+func (e *rest) GetAttendanceReport(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", c.Query("start_date"))
+	if err != nil {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", c.Query("end_date"))
+	if err != nil {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+		return
+	}
+
+	targetID, err := scopedUserID(c, scope)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+	if targetID == 0 {
+		targetID = userID.(uint)
+	}
+
+	report, err := e.uc.Attendance.GetAttendanceReport(c.Request.Context(), entity.GetAttendanceReportRequest{
+		UserID:    targetID,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]AttendanceReportItem, 0, len(report.Attendances))
+	for _, att := range report.Attendances {
+		data = append(data, toAttendanceReportItem(att))
+	}
+
+	c.JSON(http.StatusOK, AttendanceReportResponse{
+		UserID:                 report.UserID,
+		StartDate:              report.StartDate.Format("2006-01-02"),
+		EndDate:                report.EndDate.Format("2006-01-02"),
+		AttendedDays:           report.AttendedDays,
+		TotalWorkedMinutes:     report.TotalWorkedMinutes,
+		LateDays:               report.LateDays,
+		TotalLateMinutes:       report.TotalLateMinutes,
+		EarlyLeaveDays:         report.EarlyLeaveDays,
+		TotalEarlyLeaveMinutes: report.TotalEarlyLeaveMinutes,
+		ByWorkMode:             toWorkModeSummaryResp(report.ByWorkMode),
+		Data:                   data,
+	})
+}
+
+func toAttendanceReportItem(att entity.Attendance) AttendanceReportItem {
+	return AttendanceReportItem{
+		ID:                 att.ID,
+		Date:               att.Date.Format("2006-01-02"),
+		ShiftID:            att.ShiftID,
+		WorkMode:           string(att.WorkMode),
+		CheckedInAt:        att.CheckedInAt,
+		CheckedOutAt:       att.CheckedOutAt,
+		WorkedMinutes:      att.WorkedMinutes,
+		BreakMinutes:       att.BreakMinutes,
+		LateMinutes:        att.LateMinutes,
+		EarlyLeaveMinutes:  att.EarlyLeaveMinutes,
+		CheckInLocationID:  att.CheckInLocationID,
+		CheckOutLocationID: att.CheckOutLocationID,
+		OutsideGeofence:    att.OutsideGeofence,
+		AutoClosed:         att.AutoClosed,
+	}
+}
+
+// GetAttendanceHistory godoc
+// @Summary      My attendance history
+// @Description  Lists the caller's days for an attendance period or a date range, newest first, up to today. Each day carries its check-in and check-out, worked hours, overtime and whether it was a weekend, a holiday or an absence. Leave is not tracked yet, so a day on leave is reported as absent; the leave status is reserved for it, and clients should not treat absent as final.
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        attendance_period_id query int false "Attendance Period ID, instead of a date range"
+// @Param        start_date query string false "Start date (YYYY-MM-DD)"
+// @Param        end_date query string false "End date (YYYY-MM-DD)"
+// @Param        page query int false "Page number"
+// @Param        limit query int false "Page limit"
+// @Success      200 {object} handler.AttendanceHistoryResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/attendance [get]
+func (e *rest) GetAttendanceHistory(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	page, limit, err := getPagination(c)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	req := entity.GetAttendanceHistoryRequest{
+		UserID: userID.(uint),
+		Page:   page,
+		Limit:  limit,
+	}
+
+	if v := c.Query("attendance_period_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid attendance_period_id"))
+			return
+		}
+		req.AttendancePeriodID = uint(id)
+	}
+
+	if v := c.Query("start_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+			return
+		}
+		req.StartDate = &t
+	}
+
+	if v := c.Query("end_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+			return
+		}
+		req.EndDate = &t
+	}
+
+	history, err := e.uc.Attendance.GetAttendanceHistory(c.Request.Context(), req)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]AttendanceDayResp, 0, len(history.Days))
+	for _, day := range history.Days {
+		resp := AttendanceDayResp{
+			Date:          day.Date.Format("2006-01-02"),
+			Status:        string(day.Status),
+			Weekend:       day.Weekend,
+			Holiday:       day.Holiday,
+			HolidayName:   day.HolidayName,
+			Absent:        day.Status == entity.AttendanceDayAbsent,
+			OvertimeHours: day.OvertimeHours,
+		}
+
+		if day.Attendance != nil {
+			item := toAttendanceReportItem(*day.Attendance)
+			resp.Attendance = &item
+			resp.WorkedHours = math.Round(float64(day.Attendance.WorkedMinutes)/60*100) / 100
+		}
+
+		data = append(data, resp)
+	}
+
+	c.JSON(http.StatusOK, AttendanceHistoryResponse{
+		Data:       data,
+		TotalData:  int(history.TotalData),
+		TotalPages: history.TotalPages,
+	})
+}
+
+// GetWorkModeReport godoc
+// @Summary      Attendance by work mode for a period
+// @Description  Admin breaks a period's attendance days and worked minutes down by work mode for each employee
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        attendance_period_id query int true "Attendance Period ID"
+// @Param        user_id query int false "User ID"
+// @Success      200 {object} handler.WorkModeReportResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/attendance/report/work-mode [get]
+func (e *rest) GetWorkModeReport(c *gin.Context) {
+	periodID, err := strconv.Atoi(c.Query("attendance_period_id"))
+	if err != nil || periodID <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid attendance_period_id"))
+		return
+	}
+
+	filter := entity.GetWorkModeSummaryFilter{AttendancePeriodID: uint(periodID)}
+
+	if userIDStr := c.Query("user_id"); userIDStr != "" {
+		id, err := strconv.Atoi(userIDStr)
+		if err != nil || id <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid user_id"))
+			return
+		}
+		filter.UserID = uint(id)
+	}
+
+	summary, err := e.uc.Attendance.GetWorkModeSummary(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, WorkModeReportResponse{
+		AttendancePeriodID: filter.AttendancePeriodID,
+		Data:               toWorkModeSummaryResp(summary),
+	})
+}
+
+func toWorkModeSummaryResp(summary []entity.WorkModeSummary) []WorkModeSummaryResp {
+	data := make([]WorkModeSummaryResp, 0, len(summary))
+	for _, s := range summary {
+		data = append(data, WorkModeSummaryResp{
+			UserID:        s.UserID,
+			WorkMode:      string(s.WorkMode),
+			Days:          s.Days,
+			WorkedMinutes: s.WorkedMinutes,
+		})
+	}
+	return data
+}
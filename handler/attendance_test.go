@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/usecase/access"
+	mockAttendance "github.com/zuhrulumam/go-hris/mocks/usecase/attendance"
+	"go.uber.org/mock/gomock"
+)
+
+func TestGetAttendanceReport_Scope(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		setupMocks   func(att *mockAttendance.MockUsecaseItf)
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name:  "report of a direct report",
+			query: "start_date=2025-06-01&end_date=2025-06-30&user_id=5",
+			setupMocks: func(att *mockAttendance.MockUsecaseItf) {
+				att.EXPECT().GetAttendanceReport(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req entity.GetAttendanceReportRequest) (*entity.AttendanceReport, error) {
+						assert.Equal(t, uint(5), req.UserID)
+						return &entity.AttendanceReport{UserID: 5}, nil
+					})
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "user outside the reporting line",
+			query:        "start_date=2025-06-01&end_date=2025-06-30&user_id=9",
+			setupMocks:   func(att *mockAttendance.MockUsecaseItf) {},
+			expectStatus: http.StatusForbidden,
+			expectError:  "user is outside your access scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+			mockAttendanceUc := mockAttendance.NewMockUsecaseItf(ctrl)
+
+			mockAccessUc.EXPECT().Scope(gomock.Any(), uint(3), string(entity.RoleManager), entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam).
+				Return(entity.AccessScope{UserIDs: []uint{3, 5}}, nil)
+			tt.setupMocks(mockAttendanceUc)
+
+			r, e := newTestRouter(&usecase.Usecase{Access: mockAccessUc, Attendance: mockAttendanceUc}, 3, string(entity.RoleManager))
+			r.GET("/api/attendance/report", e.GetAttendanceReport)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/attendance/report?"+tt.query, nil))
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, decodeError(t, w).DebugError, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestReviewAttendanceCorrection_Scope(t *testing.T) {
+	tests := []struct {
+		name         string
+		scope        entity.AccessScope
+		correction   entity.AttendanceCorrection
+		expectReview bool
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name:         "read_all reviewer approves someone else's correction",
+			scope:        entity.AccessScope{All: true},
+			correction:   entity.AttendanceCorrection{ID: 4, UserID: 9},
+			expectReview: true,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "read_all reviewer approves their own correction",
+			scope:        entity.AccessScope{All: true},
+			correction:   entity.AttendanceCorrection{ID: 4, UserID: 3},
+			expectStatus: http.StatusForbidden,
+			expectError:  "you cannot review your own request",
+		},
+		{
+			name:         "manager reviews a correction outside their reporting line",
+			scope:        entity.AccessScope{UserIDs: []uint{3, 5}},
+			correction:   entity.AttendanceCorrection{ID: 4, UserID: 9},
+			expectStatus: http.StatusForbidden,
+			expectError:  "you can only review requests from your reports",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+			mockAttendanceUc := mockAttendance.NewMockUsecaseItf(ctrl)
+
+			mockAccessUc.EXPECT().Scope(gomock.Any(), uint(3), string(entity.RoleHR), entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam).
+				Return(tt.scope, nil)
+			mockAttendanceUc.EXPECT().GetCorrections(gomock.Any(), entity.GetAttendanceCorrectionFilter{ID: 4}).
+				Return([]entity.AttendanceCorrection{tt.correction}, nil)
+			if tt.expectReview {
+				mockAttendanceUc.EXPECT().ReviewCorrection(gomock.Any(), entity.ReviewAttendanceCorrection{ID: 4, ReviewerID: 3, Approve: true}).
+					Return(nil)
+			}
+
+			r, e := newTestRouter(&usecase.Usecase{Access: mockAccessUc, Attendance: mockAttendanceUc}, 3, string(entity.RoleHR))
+			r.POST("/api/attendance/correction/:id/review", e.ReviewAttendanceCorrection)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/attendance/correction/4/review", strings.NewReader(`{"action":"approve"}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, decodeError(t, w).DebugError, tt.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// RegisterDevice godoc
+// @Summary      Register a mobile device
+// @Description  Registers the employee's phone for offline attendance. The returned secret is shown only once and is used by the app to sign offline events.
+// @Tags         Device
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.RegisterDeviceRequest true "Device Info"
+// @Success      200 {object} handler.RegisterDeviceResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Router       /api/device [post]
+func (e *rest) RegisterDevice(c *gin.Context) {
+	var input RegisterDeviceRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	device, err := e.uc.Device.RegisterDevice(c.Request.Context(), entity.RegisterDeviceRequest{
+		UserID: userID.(uint),
+		Name:   input.Name,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RegisterDeviceResponse{
+		Success: true,
+		Message: "Device registered successfully!",
+		ID:      device.ID,
+		Secret:  device.Secret,
+	})
+}
+
+// UpdateDevice godoc
+// @Summary      Revoke or restore a mobile device
+// @Description  Employee deactivates one of their devices, e.g. after losing it. Offline events from an inactive device are refused.
+// @Tags         Device
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Device ID"
+// @Param        body body handler.UpdateDeviceRequest true "Device Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/device/{id} [put]
+func (e *rest) UpdateDevice(c *gin.Context) {
+	var input UpdateDeviceRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid device id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Device.UpdateDevice(c.Request.Context(), entity.UpdateDevice{
+		ID:     uint(id),
+		UserID: userID.(uint),
+		Active: input.Active,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Device updated successfully!",
+	})
+}
+
+// GetDevices godoc
+// @Summary      List my mobile devices
+// @Description  Returns the devices the employee registered. Secrets are never returned.
+// @Tags         Device
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.DeviceListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Router       /api/device [get]
+func (e *rest) GetDevices(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	devices, err := e.uc.Device.GetDevices(c.Request.Context(), entity.GetDeviceFilter{
+		UserID: userID.(uint),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]DeviceResp, 0, len(devices))
+	for _, d := range devices {
+		data = append(data, DeviceResp{
+			ID:        d.ID,
+			Name:      d.Name,
+			Active:    d.Active,
+			CreatedAt: d.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, DeviceListResponse{Data: data})
+}
@@ -0,0 +1,544 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// CreateEmployee godoc
+// @Summary      Create an employee account
+// @Description  Creates an employee account with any role. Role defaults to employee. Any other role needs role:manage, and only admins can create admin accounts. The employee has to change the password at first login unless must_change_password is false. The password has to meet the password policy.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.CreateEmployeeRequest true "Employee Info"
+// @Success      200 {object} handler.EmployeeResp
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/employee [post]
+func (e *rest) CreateEmployee(c *gin.Context) {
+	var input CreateEmployeeRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	// Handing out a role is managing access, not just employee records
+	if input.Role != "" && input.Role != string(entity.RoleEmployee) {
+		if err := e.checkPermission(c, entity.PermRoleManage); err != nil {
+			e.compileError(c, err)
+			return
+		}
+	}
+
+	if input.Role == string(entity.RoleAdmin) && c.GetString("role") != string(entity.RoleAdmin) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "only admins can create admin accounts"))
+		return
+	}
+
+	// The admin chose the password, so the employee picks their own at
+	// first login unless told otherwise
+	mustChange := true
+	if input.MustChangePassword != nil {
+		mustChange = *input.MustChangePassword
+	}
+
+	employee, err := e.uc.User.CreateEmployee(c.Request.Context(), entity.RegisterRequest{
+		Username: input.Username,
+		Password: input.Password,
+		FullName: input.FullName,
+		Email:    input.Email,
+		Role:     input.Role,
+		Salary:   input.Salary,
+
+		MustChangePassword: mustChange,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toEmployeeResp(*employee))
+}
+
+// ListEmployees godoc
+// @Summary      List employees
+// @Description  Admin searches the employee directory by username, full name or email. Without employee:read_all only the caller and their reporting line are listed.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        search query string false "Matches username, full name or email"
+// @Param        role query string false "admin, employee, manager, hr, payroll_admin or auditor"
+// @Param        active query bool false "Only active (true) or deactivated (false) accounts"
+// @Param        page query int false "Page number"
+// @Param        limit query int false "Page limit"
+// @Success      200 {object} handler.EmployeeListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/employee [get]
+func (e *rest) ListEmployees(c *gin.Context) {
+	scope, err := e.accessScope(c, entity.PermEmployeeReadAll, entity.PermEmployeeRead)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	page, limit, err := getPagination(c)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	// Managers only find themselves and their reports
+	filter := entity.ListUserFilter{
+		Search:  c.Query("search"),
+		UserIDs: scope.UserIDs,
+		Page:    page,
+		Limit:   limit,
+	}
+
+	switch role := entity.UserRole(c.Query("role")); role {
+	case "":
+	case entity.RoleAdmin, entity.RoleEmployee, entity.RoleManager, entity.RoleHR, entity.RolePayrollAdmin, entity.RoleAuditor:
+		filter.Role = string(role)
+	default:
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid role"))
+		return
+	}
+
+	if v := c.Query("active"); v != "" {
+		active, err := strconv.ParseBool(v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid active"))
+			return
+		}
+		filter.IsActive = &active
+	}
+
+	employees, totalData, totalPages, err := e.uc.User.ListEmployees(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]EmployeeResp, 0, len(employees))
+	for _, emp := range employees {
+		data = append(data, toEmployeeResp(emp))
+	}
+
+	c.JSON(http.StatusOK, EmployeeListResponse{
+		Data:       data,
+		TotalData:  int(totalData),
+		TotalPages: totalPages,
+	})
+}
+
+// GetEmployee godoc
+// @Summary      Get an employee
+// @Description  Admin views one employee account. Without employee:read_all only the caller and their reporting line can be viewed.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.EmployeeResp
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id} [get]
+func (e *rest) GetEmployee(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.checkEmployeeScope(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	employee, err := e.uc.User.GetEmployee(c.Request.Context(), uint(id))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toEmployeeResp(*employee))
+}
+
+// UpdateEmployee godoc
+// @Summary      Update an employee
+// @Description  Changes an employee's name, email, role or salary. Omitted fields are left unchanged. Changing a role needs role:manage, only admins can change admin accounts, accounts whose role grants permissions you lack need role:manage, and nobody can change their own role or salary.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Param        body body handler.UpdateEmployeeRequest true "Fields to change"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id} [put]
+func (e *rest) UpdateEmployee(c *gin.Context) {
+	var input UpdateEmployeeRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	// Handing out a role is managing access, not just employee records
+	if input.Role != nil {
+		if err := e.checkPermission(c, entity.PermRoleManage); err != nil {
+			e.compileError(c, err)
+			return
+		}
+	}
+
+	// Only admins can hand out or take away the admin role
+	if input.Role != nil && *input.Role == string(entity.RoleAdmin) && c.GetString("role") != string(entity.RoleAdmin) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "only admins can change admin accounts"))
+		return
+	}
+
+	if err := e.checkTargetAccount(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	req := entity.UpdateEmployeeRequest{
+		ID:       uint(id),
+		ActorID:  userID.(uint),
+		FullName: input.FullName,
+		Email:    input.Email,
+		Salary:   input.Salary,
+	}
+	if input.Role != nil {
+		role := entity.UserRole(*input.Role)
+		req.Role = &role
+	}
+
+	if err := e.uc.User.UpdateEmployee(c.Request.Context(), req); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee updated successfully!",
+	})
+}
+
+// UpdateEmployeeStatus godoc
+// @Summary      Deactivate or reactivate an employee
+// @Description  Admin deactivates an account so it can no longer log in and is left out of payroll, or reactivates it. History is kept. Only admins can change admin accounts, and accounts whose role grants permissions you lack need role:manage.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Param        body body handler.UpdateEmployeeStatusRequest true "Account status"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/status [put]
+func (e *rest) UpdateEmployeeStatus(c *gin.Context) {
+	var input UpdateEmployeeStatusRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	// Otherwise HR could lock every admin out
+	if err := e.checkTargetAccount(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	err = e.uc.User.SetEmployeeActive(c.Request.Context(), uint(id), userID.(uint), *input.Active)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee status updated successfully!",
+	})
+}
+
+func toEmployeeResp(u entity.User) EmployeeResp {
+	return EmployeeResp{
+		ID:           u.ID,
+		Username:     u.Username,
+		FullName:     u.FullName,
+		Email:        u.Email,
+		Role:         string(u.Role),
+		Salary:       u.Salary,
+		Active:       u.IsActive,
+		DepartmentID: u.DepartmentID,
+		PositionID:   u.PositionID,
+		ManagerID:    u.ManagerID,
+		CreatedAt:    u.CreatedAt,
+
+		MustChangePassword: u.MustChangePassword,
+		PasswordChangedAt:  u.PasswordChangedAt,
+	}
+}
+
+// GetEmployeeProfile godoc
+// @Summary      Get an employee's HR profile
+// @Description  Admin views an employee's HR master data. Employees nobody has filled a profile for get empty fields. Without employee:read_all only the caller and their reporting line can be viewed.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.EmployeeProfileResp
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/profile [get]
+func (e *rest) GetEmployeeProfile(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.checkEmployeeScope(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	profile, err := e.uc.User.GetEmployeeProfile(c.Request.Context(), uint(id))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toEmployeeProfileResp(*profile))
+}
+
+// UpdateEmployeeProfile godoc
+// @Summary      Update an employee's HR profile
+// @Description  Admin edits HR master data: employee number, phone, hire date, employment type, job title, NIK, NPWP, PTKP status, bank account and emergency contact. Omitted fields are left unchanged.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Param        body body handler.UpdateEmployeeProfileRequest true "Fields to change"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/profile [put]
+func (e *rest) UpdateEmployeeProfile(c *gin.Context) {
+	var input UpdateEmployeeProfileRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	req := entity.UpdateEmployeeProfile{
+		UserID:                   uint(id),
+		EmployeeNumber:           input.EmployeeNumber,
+		Phone:                    input.Phone,
+		JobTitle:                 input.JobTitle,
+		NIK:                      input.NIK,
+		NPWP:                     input.NPWP,
+		PTKPStatus:               input.PTKPStatus,
+		BankName:                 input.BankName,
+		BankAccountNumber:        input.BankAccountNumber,
+		BankAccountName:          input.BankAccountName,
+		EmergencyContactName:     input.EmergencyContactName,
+		EmergencyContactPhone:    input.EmergencyContactPhone,
+		EmergencyContactRelation: input.EmergencyContactRelation,
+	}
+
+	if input.HireDate != nil {
+		hireDate, err := time.Parse("2006-01-02", *input.HireDate)
+		if err != nil {
+			e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid hire_date"))
+			return
+		}
+		req.HireDate = &hireDate
+	}
+
+	if input.EmploymentType != nil {
+		employmentType := entity.EmploymentType(*input.EmploymentType)
+		req.EmploymentType = &employmentType
+	}
+
+	if err := e.uc.User.UpdateEmployeeProfile(c.Request.Context(), req); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee profile updated successfully!",
+	})
+}
+
+// GetOwnProfile godoc
+// @Summary      Get my HR profile
+// @Description  Returns the logged-in employee's HR master data
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.EmployeeProfileResp
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/profile [get]
+func (e *rest) GetOwnProfile(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	profile, err := e.uc.User.GetEmployeeProfile(c.Request.Context(), userID.(uint))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toEmployeeProfileResp(*profile))
+}
+
+// UpdateOwnProfile godoc
+// @Summary      Update my HR profile
+// @Description  Employees keep their phone number and emergency contact up to date. Tax, bank and employment data can only be changed by an admin.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.UpdateOwnProfileRequest true "Fields to change"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/profile [put]
+func (e *rest) UpdateOwnProfile(c *gin.Context) {
+	var input UpdateOwnProfileRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.User.UpdateOwnProfile(c.Request.Context(), entity.UpdateOwnProfileRequest{
+		UserID:                   userID.(uint),
+		Phone:                    input.Phone,
+		EmergencyContactName:     input.EmergencyContactName,
+		EmergencyContactPhone:    input.EmergencyContactPhone,
+		EmergencyContactRelation: input.EmergencyContactRelation,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Profile updated successfully!",
+	})
+}
+
+func toEmployeeProfileResp(p entity.EmployeeProfile) EmployeeProfileResp {
+	resp := EmployeeProfileResp{
+		UserID:                   p.UserID,
+		EmployeeNumber:           p.EmployeeNumber,
+		Phone:                    p.Phone,
+		EmploymentType:           string(p.EmploymentType),
+		JobTitle:                 p.JobTitle,
+		NIK:                      p.NIK,
+		NPWP:                     p.NPWP,
+		PTKPStatus:               p.PTKPStatus,
+		BankName:                 p.BankName,
+		BankAccountNumber:        p.BankAccountNumber,
+		BankAccountName:          p.BankAccountName,
+		EmergencyContactName:     p.EmergencyContactName,
+		EmergencyContactPhone:    p.EmergencyContactPhone,
+		EmergencyContactRelation: p.EmergencyContactRelation,
+	}
+
+	if p.HireDate != nil {
+		resp.HireDate = p.HireDate.Format("2006-01-02")
+	}
+
+	return resp
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/usecase/access"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/usecase/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUpdateEmployeeStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		role         entity.UserRole
+		setupMocks   func(u *mockUser.MockUsecaseItf, a *mockAccess.MockUsecaseItf)
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name: "hr deactivates an employee",
+			role: entity.RoleHR,
+			setupMocks: func(u *mockUser.MockUsecaseItf, a *mockAccess.MockUsecaseItf) {
+				u.EXPECT().GetEmployee(gomock.Any(), uint(9)).Return(&entity.User{ID: 9, Role: entity.RoleEmployee}, nil)
+				a.EXPECT().CanManageRole(gomock.Any(), string(entity.RoleHR), entity.RoleEmployee).Return(true, nil)
+				u.EXPECT().SetEmployeeActive(gomock.Any(), uint(9), uint(3), false).Return(nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "hr deactivates an admin",
+			role: entity.RoleHR,
+			setupMocks: func(u *mockUser.MockUsecaseItf, a *mockAccess.MockUsecaseItf) {
+				u.EXPECT().GetEmployee(gomock.Any(), uint(9)).Return(&entity.User{ID: 9, Role: entity.RoleAdmin}, nil)
+			},
+			expectStatus: http.StatusForbidden,
+			expectError:  "only admins can change admin accounts",
+		},
+		{
+			name: "hr deactivates a payroll admin",
+			role: entity.RoleHR,
+			setupMocks: func(u *mockUser.MockUsecaseItf, a *mockAccess.MockUsecaseItf) {
+				u.EXPECT().GetEmployee(gomock.Any(), uint(9)).Return(&entity.User{ID: 9, Role: entity.RolePayrollAdmin}, nil)
+				a.EXPECT().CanManageRole(gomock.Any(), string(entity.RoleHR), entity.RolePayrollAdmin).Return(false, nil)
+			},
+			expectStatus: http.StatusForbidden,
+			expectError:  "needs role:manage",
+		},
+		{
+			name: "admin deactivates an admin",
+			role: entity.RoleAdmin,
+			setupMocks: func(u *mockUser.MockUsecaseItf, a *mockAccess.MockUsecaseItf) {
+				u.EXPECT().SetEmployeeActive(gomock.Any(), uint(9), uint(3), false).Return(nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserUc := mockUser.NewMockUsecaseItf(ctrl)
+			mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+			tt.setupMocks(mockUserUc, mockAccessUc)
+
+			r, e := newTestRouter(&usecase.Usecase{User: mockUserUc, Access: mockAccessUc}, 3, string(tt.role))
+			r.PUT("/api/employee/:id/status", e.UpdateEmployeeStatus)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/employee/9/status", strings.NewReader(`{"active":false}`))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, decodeError(t, w).DebugError, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestUpdateEmployee_PrivilegedAccount(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserUc := mockUser.NewMockUsecaseItf(ctrl)
+	mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+
+	// HR must not redirect a payroll admin's email to take the account over
+	mockUserUc.EXPECT().GetEmployee(gomock.Any(), uint(9)).Return(&entity.User{ID: 9, Role: entity.RolePayrollAdmin}, nil)
+	mockAccessUc.EXPECT().CanManageRole(gomock.Any(), string(entity.RoleHR), entity.RolePayrollAdmin).Return(false, nil)
+
+	r, e := newTestRouter(&usecase.Usecase{User: mockUserUc, Access: mockAccessUc}, 3, string(entity.RoleHR))
+	r.PUT("/api/employee/:id", e.UpdateEmployee)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/employee/9", strings.NewReader(`{"email":"hr@example.com"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, decodeError(t, w).DebugError, "needs role:manage")
+}
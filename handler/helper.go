@@ -2,12 +2,17 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/pkg/errors"
 	"github.com/zuhrulumam/go-hris/pkg/logger"
 )
 
+const maxPageLimit = 100
+
 func (e *rest) compileError(c *gin.Context, err error) {
 	var (
 		httpStatus int
@@ -19,9 +24,24 @@ func (e *rest) compileError(c *gin.Context, err error) {
 	case 400:
 		httpStatus = http.StatusBadRequest
 		he = errors.EM.Message("EN", "badrequest")
+	case 401:
+		httpStatus = http.StatusUnauthorized
+		he = errors.EM.Message("EN", "unauthorized")
+	case 403:
+		httpStatus = http.StatusForbidden
+		he = errors.EM.Message("EN", "forbidden")
 	case 404:
 		httpStatus = http.StatusNotFound
 		he = errors.EM.Message("EN", "notfound")
+	case 409:
+		httpStatus = http.StatusConflict
+		he = errors.EM.Message("EN", "conflict")
+	case 429:
+		httpStatus = http.StatusTooManyRequests
+		he = errors.EM.Message("EN", "toomanyrequests")
+	case 502:
+		httpStatus = http.StatusBadGateway
+		he = errors.EM.Message("EN", "badgateway")
 	default:
 		httpStatus = http.StatusInternalServerError
 		he = errors.EM.Message("EN", "internal")
@@ -35,3 +55,68 @@ func (e *rest) compileError(c *gin.Context, err error) {
 		Success:    false,
 	})
 }
+
+// getPagination reads optional page and limit query params, leaving zero
+// values for the domain layer to apply its defaults.
+func getPagination(c *gin.Context) (page int, limit int, err error) {
+	if v := c.Query("page"); v != "" {
+		page, err = strconv.Atoi(v)
+		if err != nil || page < 1 {
+			return 0, 0, errors.NewWithCode(http.StatusBadRequest, "invalid page")
+		}
+	}
+
+	if v := c.Query("limit"); v != "" {
+		limit, err = strconv.Atoi(v)
+		if err != nil || limit < 1 || limit > maxPageLimit {
+			return 0, 0, errors.NewWithCode(http.StatusBadRequest, "invalid limit")
+		}
+	}
+
+	return page, limit, nil
+}
+
+// parseOptionalTime parses an RFC3339 timestamp, returning nil for empty input.
+func parseOptionalTime(v string) (*time.Time, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// bindPunch reads the optional body sent with a check-in or check-out into
+// input. An empty body means nothing was shared.
+func bindPunch(c *gin.Context, input interface{}) error {
+	if c.Request.ContentLength == 0 {
+		return nil
+	}
+
+	if err := c.ShouldBindJSON(input); err != nil {
+		return errors.WrapWithCode(err, http.StatusBadRequest, "invalid input")
+	}
+
+	if err := validate.Struct(input); err != nil {
+		return errors.WrapWithCode(err, http.StatusBadRequest, "failed validation")
+	}
+
+	return nil
+}
+
+// geoPoint returns the device location, or nil when none was shared.
+func (r PunchRequest) geoPoint() *entity.GeoPoint {
+	if r.Latitude == nil {
+		return nil
+	}
+
+	return &entity.GeoPoint{
+		Latitude:       *r.Latitude,
+		Longitude:      *r.Longitude,
+		AccuracyMeters: r.Accuracy,
+	}
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+	"github.com/zuhrulumam/go-hris/pkg/middlewares"
+	"go.uber.org/zap"
+)
+
+// newTestRouter returns a router whose requests carry the claims
+// JWTMiddleware would set for userID and role.
+func newTestRouter(uc *usecase.Usecase, userID uint, role string) (*gin.Engine, *rest) {
+	gin.SetMode(gin.TestMode)
+
+	e := &rest{uc: uc, log: zap.NewNop()}
+
+	r := gin.New()
+	r.Use(middlewares.RequestContextMiddleware(e.log))
+	r.Use(func(c *gin.Context) {
+		if userID > 0 {
+			c.Set("userID", userID)
+			c.Set("role", role)
+		}
+	})
+
+	return r, e
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) ErrorResponse {
+	t.Helper()
+
+	var resp ErrorResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	return resp
+}
+
+func TestCompileError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectStatus int
+		expectHuman  string
+	}{
+		{
+			name:         "bad request",
+			err:          x.NewWithCode(http.StatusBadRequest, "invalid input"),
+			expectStatus: http.StatusBadRequest,
+			expectHuman:  x.EM.Message("EN", "badrequest"),
+		},
+		{
+			name:         "unauthorized",
+			err:          x.NewWithCode(http.StatusUnauthorized, "invalid username or password"),
+			expectStatus: http.StatusUnauthorized,
+			expectHuman:  x.EM.Message("EN", "unauthorized"),
+		},
+		{
+			name:         "forbidden",
+			err:          x.NewWithCode(http.StatusForbidden, "account is deactivated"),
+			expectStatus: http.StatusForbidden,
+			expectHuman:  x.EM.Message("EN", "forbidden"),
+		},
+		{
+			name:         "not found",
+			err:          x.NewWithCode(http.StatusNotFound, "user not found"),
+			expectStatus: http.StatusNotFound,
+			expectHuman:  x.EM.Message("EN", "notfound"),
+		},
+		{
+			name:         "conflict",
+			err:          x.NewWithCode(http.StatusConflict, "attendance was updated by someone else, please retry"),
+			expectStatus: http.StatusConflict,
+			expectHuman:  x.EM.Message("EN", "conflict"),
+		},
+		{
+			name:         "too many requests",
+			err:          x.NewWithCode(http.StatusTooManyRequests, "too many failed login attempts"),
+			expectStatus: http.StatusTooManyRequests,
+			expectHuman:  x.EM.Message("EN", "toomanyrequests"),
+		},
+		{
+			name:         "identity provider unavailable",
+			err:          x.NewWithCode(http.StatusBadGateway, "identity provider discovery failed"),
+			expectStatus: http.StatusBadGateway,
+			expectHuman:  x.EM.Message("EN", "badgateway"),
+		},
+		{
+			name:         "internal",
+			err:          x.NewWithCode(http.StatusInternalServerError, "failed to get users"),
+			expectStatus: http.StatusInternalServerError,
+			expectHuman:  x.EM.Message("EN", "internal"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, e := newTestRouter(&usecase.Usecase{}, 0, "")
+			r.GET("/", func(c *gin.Context) { e.compileError(c, tt.err) })
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			resp := decodeError(t, w)
+			assert.Equal(t, tt.expectHuman, resp.HumanError)
+			assert.Contains(t, resp.DebugError, tt.err.Error())
+		})
+	}
+}
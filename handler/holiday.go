@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// CreateHoliday godoc
+// @Summary      Add a company holiday
+// @Description  Admin adds a day off for everyone. Holidays show up in employees' attendance history.
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.CreateHolidayRequest true "Holiday Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/holiday [post]
+func (e *rest) CreateHoliday(c *gin.Context) {
+	var input CreateHolidayRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", input.Date)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid date"))
+		return
+	}
+
+	err = e.uc.Holiday.CreateHoliday(c.Request.Context(), entity.CreateHolidayRequest{
+		Date: date,
+		Name: input.Name,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Holiday created successfully!",
+	})
+}
+
+// GetHolidays godoc
+// @Summary      List company holidays
+// @Description  Lists holidays, optionally limited to a date range
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param        start_date query string false "Start date (YYYY-MM-DD)"
+// @Param        end_date query string false "End date (YYYY-MM-DD)"
+// @Success      200 {object} handler.HolidayListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/holiday [get]
+func (e *rest) GetHolidays(c *gin.Context) {
+	var filter entity.GetHolidayFilter
+
+	if v := c.Query("start_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+			return
+		}
+		filter.StartDate = &t
+	}
+
+	if v := c.Query("end_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+			return
+		}
+		filter.EndDate = &t
+	}
+
+	holidays, err := e.uc.Holiday.GetHolidays(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]HolidayResp, 0, len(holidays))
+	for _, h := range holidays {
+		data = append(data, HolidayResp{
+			ID:   h.ID,
+			Date: h.Date.Format("2006-01-02"),
+			Name: h.Name,
+		})
+	}
+
+	c.JSON(http.StatusOK, HolidayListResponse{Data: data})
+}
+
+// DeleteHoliday godoc
+// @Summary      Remove a company holiday
+// @Description  Admin removes a holiday, turning it back into a regular day
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Holiday ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/holiday/{id} [delete]
+func (e *rest) DeleteHoliday(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid holiday id"))
+		return
+	}
+
+	if err := e.uc.Holiday.DeleteHoliday(c.Request.Context(), uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Holiday deleted successfully!",
+	})
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// RegisterKiosk godoc
+// @Summary      Register a kiosk
+// @Description  Admin registers a kiosk device at an office. The returned secret is shown only once and must be configured on the device.
+// @Tags         Kiosk
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.RegisterKioskRequest true "Kiosk Info"
+// @Success      200 {object} handler.RegisterKioskResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/kiosk [post]
+func (e *rest) RegisterKiosk(c *gin.Context) {
+	var input RegisterKioskRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	kiosk, err := e.uc.Kiosk.RegisterKiosk(c.Request.Context(), entity.RegisterKioskRequest{
+		Name:             input.Name,
+		OfficeLocationID: input.OfficeLocationID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RegisterKioskResponse{
+		Success: true,
+		Message: "Kiosk registered successfully!",
+		ID:      kiosk.ID,
+		Secret:  kiosk.Secret,
+	})
+}
+
+// UpdateKiosk godoc
+// @Summary      Update a kiosk
+// @Description  Admin renames or deactivates a kiosk. Tokens from a deactivated kiosk are rejected.
+// @Tags         Kiosk
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Kiosk ID"
+// @Param        body body handler.UpdateKioskRequest true "Kiosk Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/kiosk/{id} [put]
+func (e *rest) UpdateKiosk(c *gin.Context) {
+	var input UpdateKioskRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid kiosk id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	err = e.uc.Kiosk.UpdateKiosk(c.Request.Context(), entity.UpdateKiosk{
+		ID:     uint(id),
+		Name:   input.Name,
+		Active: input.Active,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Kiosk updated successfully!",
+	})
+}
+
+// GetKiosks godoc
+// @Summary      List kiosks
+// @Description  Admin lists the registered kiosks. Secrets are never returned.
+// @Tags         Kiosk
+// @Accept       json
+// @Produce      json
+// @Param        active query bool false "Filter by active flag"
+// @Success      200 {object} handler.KioskListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/kiosk [get]
+func (e *rest) GetKiosks(c *gin.Context) {
+	var filter entity.GetKioskFilter
+
+	if activeStr := c.Query("active"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid active"))
+			return
+		}
+		filter.Active = &active
+	}
+
+	kiosks, err := e.uc.Kiosk.GetKiosks(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]KioskResp, 0, len(kiosks))
+	for _, k := range kiosks {
+		data = append(data, KioskResp{
+			ID:               k.ID,
+			Name:             k.Name,
+			OfficeLocationID: k.OfficeLocationID,
+			Active:           k.Active,
+		})
+	}
+
+	c.JSON(http.StatusOK, KioskListResponse{Data: data})
+}
+
+// GetKioskToken godoc
+// @Summary      Issue a kiosk QR token
+// @Description  Called by a kiosk device to fetch the QR token it should display. The device authenticates with its id and secret and should refresh before the token expires.
+// @Tags         Kiosk
+// @Produce      json
+// @Param        X-Kiosk-ID header int true "Kiosk ID"
+// @Param        X-Kiosk-Secret header string true "Kiosk secret"
+// @Success      200 {object} handler.KioskTokenResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /kiosk/token [get]
+func (e *rest) GetKioskToken(c *gin.Context) {
+	kioskID, err := strconv.Atoi(c.GetHeader("X-Kiosk-ID"))
+	if err != nil || kioskID <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "invalid kiosk credentials"))
+		return
+	}
+
+	token, err := e.uc.Kiosk.IssueToken(c.Request.Context(), entity.IssueKioskTokenRequest{
+		KioskID: uint(kioskID),
+		Secret:  c.GetHeader("X-Kiosk-Secret"),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, KioskTokenResponse{
+		Token:          token.Token,
+		ExpiresAt:      token.ExpiresAt,
+		RefreshSeconds: int(pkg.KioskTokenPeriod.Seconds()),
+	})
+}
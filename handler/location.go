@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// CreateOfficeLocation godoc
+// @Summary      Create an office location
+// @Description  Admin defines an office with coordinates and a radius that check-ins must fall within
+// @Tags         Location
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.CreateOfficeLocationRequest true "Office Location Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/location [post]
+func (e *rest) CreateOfficeLocation(c *gin.Context) {
+	var input CreateOfficeLocationRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.Location.CreateOfficeLocation(c.Request.Context(), entity.CreateOfficeLocationRequest{
+		Name:         input.Name,
+		Latitude:     input.Latitude,
+		Longitude:    input.Longitude,
+		RadiusMeters: input.RadiusMeters,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Office location created successfully!",
+	})
+}
+
+// UpdateOfficeLocation godoc
+// @Summary      Update an office location
+// @Description  Admin updates an office's coordinates, radius or deactivates it
+// @Tags         Location
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Office Location ID"
+// @Param        body body handler.UpdateOfficeLocationRequest true "Office Location Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/location/{id} [put]
+func (e *rest) UpdateOfficeLocation(c *gin.Context) {
+	var input UpdateOfficeLocationRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid office location id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Location.UpdateOfficeLocation(c.Request.Context(), entity.UpdateOfficeLocation{
+		ID:           uint(id),
+		Name:         input.Name,
+		Latitude:     input.Latitude,
+		Longitude:    input.Longitude,
+		RadiusMeters: input.RadiusMeters,
+		Active:       input.Active,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Office location updated successfully!",
+	})
+}
+
+// GetOfficeLocations godoc
+// @Summary      List office locations
+// @Description  Returns the office locations. Pass active=true to list only those used for geofencing.
+// @Tags         Location
+// @Accept       json
+// @Produce      json
+// @Param        active query bool false "Filter by active flag"
+// @Success      200 {object} handler.OfficeLocationListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Router       /api/location [get]
+func (e *rest) GetOfficeLocations(c *gin.Context) {
+	var filter entity.GetOfficeLocationFilter
+
+	if activeStr := c.Query("active"); activeStr != "" {
+		active, err := strconv.ParseBool(activeStr)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid active"))
+			return
+		}
+		filter.Active = &active
+	}
+
+	locations, err := e.uc.Location.GetOfficeLocations(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]OfficeLocationResp, 0, len(locations))
+	for _, l := range locations {
+		data = append(data, OfficeLocationResp{
+			ID:           l.ID,
+			Name:         l.Name,
+			Latitude:     l.Latitude,
+			Longitude:    l.Longitude,
+			RadiusMeters: l.RadiusMeters,
+			Active:       l.Active,
+		})
+	}
+
+	c.JSON(http.StatusOK, OfficeLocationListResponse{Data: data})
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// GetNotifications godoc
+// @Summary      List my notifications
+// @Description  Returns the employee's notifications, newest first, e.g. attendances closed automatically for a missing check-out
+// @Tags         Notification
+// @Accept       json
+// @Produce      json
+// @Param        unread query bool false "Only unread notifications"
+// @Success      200 {object} handler.NotificationListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Router       /api/notification [get]
+func (e *rest) GetNotifications(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	filter := entity.GetNotificationFilter{UserID: userID.(uint)}
+
+	if unreadStr := c.Query("unread"); unreadStr != "" {
+		unread, err := strconv.ParseBool(unreadStr)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid unread"))
+			return
+		}
+		filter.Unread = unread
+	}
+
+	notifications, err := e.uc.Notification.GetNotifications(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]NotificationResp, 0, len(notifications))
+	for _, n := range notifications {
+		data = append(data, NotificationResp{
+			ID:        n.ID,
+			Type:      string(n.Type),
+			Message:   n.Message,
+			ReadAt:    n.ReadAt,
+			CreatedAt: n.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, NotificationListResponse{Data: data})
+}
+
+// MarkNotificationRead godoc
+// @Summary      Mark a notification as read
+// @Description  Marks one of the employee's notifications as read
+// @Tags         Notification
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Notification ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/notification/{id}/read [put]
+func (e *rest) MarkNotificationRead(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid notification id"))
+		return
+	}
+
+	err = e.uc.Notification.MarkRead(c.Request.Context(), entity.MarkNotificationRead{
+		ID:     uint(id),
+		UserID: userID.(uint),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Notification marked as read",
+	})
+}
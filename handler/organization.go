@@ -0,0 +1,374 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// CreateDepartment godoc
+// @Summary      Create a department
+// @Description  Admin adds a department. Names are unique, ignoring case.
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.DepartmentRequest true "Department Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/department [post]
+func (e *rest) CreateDepartment(c *gin.Context) {
+	var input DepartmentRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	if err := e.uc.Organization.CreateDepartment(c.Request.Context(), input.Name); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Department created successfully!",
+	})
+}
+
+// GetDepartments godoc
+// @Summary      List departments
+// @Description  Lists all departments by name
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.DepartmentListResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/department [get]
+func (e *rest) GetDepartments(c *gin.Context) {
+	departments, err := e.uc.Organization.GetDepartments(c.Request.Context(), entity.GetDepartmentFilter{})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]DepartmentResp, 0, len(departments))
+	for _, d := range departments {
+		data = append(data, DepartmentResp{
+			ID:   d.ID,
+			Name: d.Name,
+		})
+	}
+
+	c.JSON(http.StatusOK, DepartmentListResponse{Data: data})
+}
+
+// UpdateDepartment godoc
+// @Summary      Rename a department
+// @Description  Admin renames a department
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Department ID"
+// @Param        body body handler.DepartmentRequest true "Department Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/department/{id} [put]
+func (e *rest) UpdateDepartment(c *gin.Context) {
+	var input DepartmentRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid department id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Organization.UpdateDepartment(c.Request.Context(), entity.UpdateDepartment{
+		ID:   uint(id),
+		Name: &input.Name,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Department updated successfully!",
+	})
+}
+
+// CreatePosition godoc
+// @Summary      Create a position
+// @Description  Admin adds a position to a department. Names are unique within a department.
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.CreatePositionRequest true "Position Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/position [post]
+func (e *rest) CreatePosition(c *gin.Context) {
+	var input CreatePositionRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.Organization.CreatePosition(c.Request.Context(), entity.Position{
+		Name:         input.Name,
+		DepartmentID: input.DepartmentID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Position created successfully!",
+	})
+}
+
+// GetPositions godoc
+// @Summary      List positions
+// @Description  Lists positions, optionally for one department
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        department_id query int false "Department ID"
+// @Success      200 {object} handler.PositionListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/position [get]
+func (e *rest) GetPositions(c *gin.Context) {
+	var filter entity.GetPositionFilter
+
+	if v := c.Query("department_id"); v != "" {
+		departmentID, err := strconv.Atoi(v)
+		if err != nil || departmentID <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid department_id"))
+			return
+		}
+		filter.DepartmentID = uint(departmentID)
+	}
+
+	positions, err := e.uc.Organization.GetPositions(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]PositionResp, 0, len(positions))
+	for _, p := range positions {
+		data = append(data, PositionResp{
+			ID:           p.ID,
+			Name:         p.Name,
+			DepartmentID: p.DepartmentID,
+		})
+	}
+
+	c.JSON(http.StatusOK, PositionListResponse{Data: data})
+}
+
+// UpdatePosition godoc
+// @Summary      Update a position
+// @Description  Admin renames a position or moves it to another department
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Position ID"
+// @Param        body body handler.UpdatePositionRequest true "Fields to change"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/position/{id} [put]
+func (e *rest) UpdatePosition(c *gin.Context) {
+	var input UpdatePositionRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid position id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Organization.UpdatePosition(c.Request.Context(), entity.UpdatePosition{
+		ID:           uint(id),
+		Name:         input.Name,
+		DepartmentID: input.DepartmentID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Position updated successfully!",
+	})
+}
+
+// AssignEmployee godoc
+// @Summary      Place an employee in the organisation
+// @Description  Admin sets an employee's department, position and manager. Omitted fields are left unchanged and 0 clears one. The position must belong to the department, and the manager must be active and not report to the employee.
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Param        body body handler.AssignEmployeeRequest true "Assignment"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/assignment [put]
+func (e *rest) AssignEmployee(c *gin.Context) {
+	var input AssignEmployeeRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	err = e.uc.Organization.AssignEmployee(c.Request.Context(), entity.AssignEmployeeRequest{
+		UserID:       uint(id),
+		DepartmentID: input.DepartmentID,
+		PositionID:   input.PositionID,
+		ManagerID:    input.ManagerID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee assignment updated successfully!",
+	})
+}
+
+// GetApproverChain godoc
+// @Summary      Get an employee's approvers
+// @Description  Admin checks the managers above an employee, nearest first, skipping deactivated managers
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Param        levels query int false "Most approvers to return (default all)"
+// @Success      200 {object} handler.ApproverChainResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/approvers [get]
+func (e *rest) GetApproverChain(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	levels := 0
+	if v := c.Query("levels"); v != "" {
+		levels, err = strconv.Atoi(v)
+		if err != nil || levels < 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid levels"))
+			return
+		}
+	}
+
+	approvers, err := e.uc.Organization.GetApproverChain(c.Request.Context(), uint(id), levels)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]EmployeeResp, 0, len(approvers))
+	for _, a := range approvers {
+		data = append(data, toEmployeeResp(a))
+	}
+
+	c.JSON(http.StatusOK, ApproverChainResponse{Data: data})
+}
+
+// GetOrgChart godoc
+// @Summary      Get the org chart
+// @Description  Reporting tree of active employees. Employees without an active manager are at the top.
+// @Tags         Organization
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.OrgChartResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/org-chart [get]
+func (e *rest) GetOrgChart(c *gin.Context) {
+	chart, err := e.uc.Organization.GetOrgChart(c.Request.Context())
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, OrgChartResponse{Data: toOrgChartNodesResp(chart)})
+}
+
+func toOrgChartNodesResp(nodes []entity.OrgChartNode) []OrgChartNodeResp {
+	resp := make([]OrgChartNodeResp, 0, len(nodes))
+	for _, n := range nodes {
+		resp = append(resp, OrgChartNodeResp{
+			ID:           n.User.ID,
+			FullName:     n.User.FullName,
+			DepartmentID: n.User.DepartmentID,
+			PositionID:   n.User.PositionID,
+			Reports:      toOrgChartNodesResp(n.Reports),
+		})
+	}
+	return resp
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// ForgotPassword godoc
+// @Summary      Ask for a password reset link
+// @Description  Emails a single-use reset link to the account with the username or email address. The email is sent in the background and the response is the same whether or not the account exists. Requests are limited per username or email and per IP address.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.ForgotPasswordRequest true "Username or email"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      429 {object} handler.ErrorResponse
+// @Router       /password/forgot [post]
+func (e *rest) ForgotPassword(c *gin.Context) {
+	var input ForgotPasswordRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.User.RequestPasswordReset(c.Request.Context(), entity.ForgotPasswordRequest{
+		Login:     input.Login,
+		IPAddress: c.ClientIP(),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "If the account exists and has an email address, a reset link is on its way.",
+	})
+}
+
+// ResetPassword godoc
+// @Summary      Reset a password
+// @Description  Sets a new password with the token from a reset email. The token works once. The user is logged out everywhere and a lockout from failed logins is lifted.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.ResetPasswordRequest true "Token and new password"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /password/reset [post]
+func (e *rest) ResetPassword(c *gin.Context) {
+	var input ResetPasswordRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.User.ResetPassword(c.Request.Context(), entity.ResetPasswordRequest{
+		Token:       input.Token,
+		NewPassword: input.NewPassword,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Password reset successfully, please log in with your new password.",
+	})
+}
+
+// ChangePassword godoc
+// @Summary      Change my password
+// @Description  Replaces the current user's password. Other sessions are logged out. Returns a new access token for this session, which also ends a required password change.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.ChangePasswordRequest true "Current and new password"
+// @Success      200 {object} handler.AuthResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/password [put]
+func (e *rest) ChangePassword(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var input ChangePasswordRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	tokens, err := e.uc.User.ChangePassword(c.Request.Context(), entity.ChangePasswordRequest{
+		UserID:          userID.(uint),
+		SessionID:       c.GetUint("sessionID"),
+		CurrentPassword: input.CurrentPassword,
+		NewPassword:     input.NewPassword,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAuthResponse(tokens))
+}
+
+// SendEmployeePasswordReset godoc
+// @Summary      Send an employee a password reset link
+// @Description  Emails the employee a single-use reset link. Only admins can do this for admin accounts, and accounts whose role grants permissions you lack need role:manage.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/password-reset [post]
+func (e *rest) SendEmployeePasswordReset(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.checkTargetAccount(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	if err := e.uc.User.SendPasswordReset(c.Request.Context(), uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Password reset link sent successfully!",
+	})
+}
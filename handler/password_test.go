@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/usecase/access"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/usecase/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSendEmployeePasswordReset(t *testing.T) {
+	tests := []struct {
+		name         string
+		target       entity.UserRole
+		canManage    bool
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name:         "account with no extra permissions",
+			target:       entity.RoleEmployee,
+			canManage:    true,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "account with permissions the caller lacks",
+			target:       entity.RolePayrollAdmin,
+			expectStatus: http.StatusForbidden,
+			expectError:  "needs role:manage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserUc := mockUser.NewMockUsecaseItf(ctrl)
+			mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+
+			mockUserUc.EXPECT().GetEmployee(gomock.Any(), uint(9)).Return(&entity.User{ID: 9, Role: tt.target}, nil)
+			mockAccessUc.EXPECT().CanManageRole(gomock.Any(), string(entity.RoleHR), tt.target).Return(tt.canManage, nil)
+			if tt.canManage {
+				mockUserUc.EXPECT().SendPasswordReset(gomock.Any(), uint(9)).Return(nil)
+			}
+
+			r, e := newTestRouter(&usecase.Usecase{User: mockUserUc, Access: mockAccessUc}, 3, string(entity.RoleHR))
+			r.POST("/api/employee/:id/password-reset", e.SendEmployeePasswordReset)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/employee/9/password-reset", nil))
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, decodeError(t, w).DebugError, tt.expectError)
+			}
+		})
+	}
+}
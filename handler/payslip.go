@@ -63,10 +63,104 @@ func (e *rest) GetPayslip(c *gin.Context) {
 		return
 	}
 
-	pay := payslip[0]
+	c.JSON(http.StatusOK, toPayslipDataResp(payslip[0]))
+}
+
+// ListPayslip godoc
+// @Summary      List payslip history
+// @Description  Paginated payslip history. Employees only see their own payslips, managers with payroll:read_team also see their reports', and payroll:read_all holders see everyone.
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        period_id query int false "Attendance Period ID"
+// @Param        user_id query int false "User ID, must be within your access scope"
+// @Param        status query string false "Payslip status: issued (default) or void, for payslips replaced by a payroll rerun"
+// @Param        sort_by query string false "Sort field: attendance_period_id, total_pay, created_at"
+// @Param        order query string false "Sort order: asc or desc (default desc)"
+// @Param        page query int false "Page number"
+// @Param        limit query int false "Page limit"
+// @Success      200 {object} handler.PayslipListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      500 {object} handler.ErrorResponse
+// @Router       /api/payslips [get]
+func (e *rest) ListPayslip(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	scope, err := e.accessScope(c, entity.PermPayrollReadAll, entity.PermPayrollReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	page, limit, err := getPagination(c)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	order := c.DefaultQuery("order", "desc")
+	if order != "asc" && order != "desc" {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid order"))
+		return
+	}
+
+	filter := entity.GetPayslipRequest{
+		SortBy:   c.Query("sort_by"),
+		SortDesc: order == "desc",
+		Page:     page,
+		Limit:    limit,
+	}
+
+	if periodIDStr := c.Query("period_id"); periodIDStr != "" {
+		periodID, err := strconv.Atoi(periodIDStr)
+		if err != nil || periodID <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid period_id"))
+			return
+		}
+		filter.AttendancePeriodID = pkg.UintPtr(uint(periodID))
+	}
+
+	if status := c.Query("status"); status != "" {
+		filter.Status = pkg.StringPtr(status)
+	}
+
+	// Employees are always scoped to their own history, managers to their reports
+	filter.UserIDs = scope.UserIDs
+	targetID, err := scopedUserID(c, scope)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+	if targetID > 0 {
+		filter.UserID = pkg.UintPtr(targetID)
+	}
+
+	payslips, totalData, totalPages, err := e.uc.Payslip.ListPayslip(ctx, filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]PayslipDataResp, 0, len(payslips))
+	for _, pay := range payslips {
+		data = append(data, toPayslipDataResp(pay))
+	}
+
+	c.JSON(http.StatusOK, PayslipListResponse{
+		Data:       data,
+		TotalData:  int(totalData),
+		TotalPages: totalPages,
+	})
+}
+
+func toPayslipDataResp(pay entity.Payslip) PayslipDataResp {
 	p := message.NewPrinter(language.Indonesian)
 
-	c.JSON(http.StatusOK, PayslipDataResp{
+	return PayslipDataResp{
+		ID:                 pay.ID,
+		UserID:             pay.UserID,
 		AttendancePeriodID: pay.AttendancePeriodID,
 		BaseSalary:         p.Sprintf("Rp %d", int(pay.BaseSalary)),
 		WorkingDays:        pay.WorkingDays,
@@ -75,9 +169,13 @@ func (e *rest) GetPayslip(c *gin.Context) {
 		OvertimeHours:      pay.OvertimeHours,
 		OvertimePay:        p.Sprintf("Rp %d", int(pay.OvertimePay)),
 		ReimbursementTotal: p.Sprintf("Rp %d", int(pay.ReimbursementTotal)),
+		LateMinutes:        pay.LateMinutes,
+		EarlyLeaveMinutes:  pay.EarlyLeaveMinutes,
+		LatenessDeduction:  p.Sprintf("Rp %d", int(pay.LatenessDeduction)),
 		TotalPay:           p.Sprintf("Rp %d", int(pay.TotalPay)),
+		Status:             pay.Status,
 		CreatedAt:          pay.CreatedAt,
-	})
+	}
 }
 
 // CreatePayroll godoc
@@ -91,6 +189,7 @@ func (e *rest) GetPayslip(c *gin.Context) {
 // @Failure      400      {object}  map[string]string      "Bad Request"
 // @Failure      409      {object}  map[string]string      "Payroll already exists"
 // @Failure      500      {object}  map[string]string      "Internal Server Error"
+// @Failure      403 {object} handler.ErrorResponse
 // @Router       /api/payroll/create [post]
 func (e *rest) CreatePayroll(c *gin.Context) {
 	var req CreatePayrollRequest
@@ -99,18 +198,13 @@ func (e *rest) CreatePayroll(c *gin.Context) {
 		return
 	}
 
-	isAdmin, ok := c.Get("isAdmin")
+	userID, ok := c.Get("userID")
 	if !ok {
 		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
 		return
 	}
 
-	if !isAdmin.(bool) {
-		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "only admin"))
-		return
-	}
-
-	err := e.uc.Payslip.CreatePayroll(c.Request.Context(), req.PeriodID)
+	err := e.uc.Payslip.CreatePayroll(c.Request.Context(), req.PeriodID, userID.(uint))
 	if err != nil {
 		e.compileError(c, err)
 		return
@@ -129,22 +223,12 @@ func (e *rest) CreatePayroll(c *gin.Context) {
 // @Success      200 {object} GetPayrollSummaryResponse
 // @Failure      400 {object} handler.ErrorResponse
 // @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
 // @Failure      500 {object} handler.ErrorResponse
 // @Router       /api/payroll/summary [get]
 func (e *rest) GetPayrollSummary(c *gin.Context) {
 	ctx := c.Request.Context()
 
-	isAdmin, ok := c.Get("isAdmin")
-	if !ok {
-		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
-		return
-	}
-
-	if !isAdmin.(bool) {
-		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "only admin"))
-		return
-	}
-
 	periodIDsParam := c.Query("period_ids")
 	if periodIDsParam == "" {
 		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "missing period_ids"))
@@ -176,3 +260,62 @@ func (e *rest) GetPayrollSummary(c *gin.Context) {
 		GrandTotal: summary.GrandTotal,
 	})
 }
+
+// GetPayrollVariance godoc
+// @Summary      Get period-over-period payroll variance
+// @Description  Compare each employee's payslip components between two attendance periods and flag changes over a threshold
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        previous_period_id query int true "Previous Attendance Period ID"
+// @Param        current_period_id query int true "Current Attendance Period ID"
+// @Param        threshold query number false "Percentage change that flags a component (default 10)"
+// @Success      200 {object} GetPayrollVarianceResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Failure      500 {object} handler.ErrorResponse
+// @Router       /api/payroll/variance [get]
+func (e *rest) GetPayrollVariance(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	previousID, err := strconv.Atoi(c.Query("previous_period_id"))
+	if err != nil || previousID <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid previous_period_id"))
+		return
+	}
+
+	currentID, err := strconv.Atoi(c.Query("current_period_id"))
+	if err != nil || currentID <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid current_period_id"))
+		return
+	}
+
+	var threshold float64
+	if thresholdStr := c.Query("threshold"); thresholdStr != "" {
+		threshold, err = strconv.ParseFloat(thresholdStr, 64)
+		if err != nil || threshold < 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid threshold"))
+			return
+		}
+	}
+
+	variance, err := e.uc.Payslip.GetPayrollVariance(ctx, entity.GetPayrollVarianceRequest{
+		PreviousPeriodID: uint(previousID),
+		CurrentPeriodID:  uint(currentID),
+		ThresholdPercent: threshold,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GetPayrollVarianceResponse{
+		PreviousPeriodID: variance.PreviousPeriodID,
+		CurrentPeriodID:  variance.CurrentPeriodID,
+		ThresholdPercent: variance.ThresholdPercent,
+		FlaggedCount:     variance.FlaggedCount,
+		Items:            variance.Items,
+	})
+}
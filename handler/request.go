@@ -1,5 +1,7 @@
 package handler
 
+import "time"
+
 type OvertimeRequest struct {
 	Date        string  `json:"date" validate:"required"`
 	Hours       float64 `json:"hours" validate:"required,max=3"`
@@ -19,7 +21,7 @@ type CreatePayrollRequest struct {
 type RegisterRequest struct {
 	Username string  `json:"username" binding:"required"`
 	Email    string  `json:"email" binding:"required,email"`
-	Password string  `json:"password" binding:"required,min=6"`
+	Password string  `json:"password" binding:"required"`
 	Fullname string  `json:"fullname" binding:"required"`
 	Salary   float64 `json:"salary" binding:"required"`
 }
@@ -29,7 +31,258 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type VerifyLoginRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	Code           string `json:"code" validate:"required" example:"123456"` // TOTP or recovery code
+}
+
+type LoginChallengeRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required" example:"123456"`
+}
+
+type ForgotPasswordRequest struct {
+	Login string `json:"login" validate:"required" example:"jdoe"` // username or email
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" validate:"required"`
+	NewPassword string `json:"new_password" validate:"required" example:"N3wSecret"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password" validate:"required" example:"Secret123"`
+	NewPassword     string `json:"new_password" validate:"required" example:"N3wSecret"`
+}
+
+type UnlockIPRequest struct {
+	IPAddress string `json:"ip_address" validate:"required,ip" example:"203.0.113.7"`
+}
+
+type CreateEmployeeRequest struct {
+	Username string  `json:"username" validate:"required" example:"jdoe"`
+	Email    string  `json:"email" validate:"omitempty,email" example:"jdoe@example.com"`
+	Password string  `json:"password" validate:"required" example:"Secret123"`
+	FullName string  `json:"full_name" validate:"required" example:"John Doe"`
+	Role     string  `json:"role" validate:"omitempty,oneof=admin employee manager hr payroll_admin auditor" example:"employee"`
+	Salary   float64 `json:"salary" validate:"gte=0" example:"5000000"`
+
+	// Defaults to true
+	MustChangePassword *bool `json:"must_change_password" example:"true"`
+}
+
+type UpdateEmployeeRequest struct {
+	FullName *string  `json:"full_name" validate:"omitempty,min=1" example:"John Doe"`
+	Email    *string  `json:"email" validate:"omitempty,email" example:"jdoe@example.com"`
+	Role     *string  `json:"role" validate:"omitempty,oneof=admin employee manager hr payroll_admin auditor" example:"employee"`
+	Salary   *float64 `json:"salary" validate:"omitempty,gte=0" example:"6000000"`
+}
+
+type UpdateEmployeeStatusRequest struct {
+	Active *bool `json:"active" validate:"required" example:"false"`
+}
+
+// UpdateEmployeeProfileRequest holds HR master data. Phone numbers are in
+// international format, NIK is the 16-digit national ID and NPWP the 15 or
+// 16-digit tax number.
+type UpdateEmployeeProfileRequest struct {
+	EmployeeNumber           *string `json:"employee_number" validate:"omitempty,alphanum,max=30" example:"EMP0001"`
+	Phone                    *string `json:"phone" validate:"omitempty,e164" example:"+6281234567890"`
+	HireDate                 *string `json:"hire_date" validate:"omitempty,datetime=2006-01-02" example:"2024-01-15"`
+	EmploymentType           *string `json:"employment_type" validate:"omitempty,oneof=permanent contract intern" example:"permanent"`
+	JobTitle                 *string `json:"job_title" validate:"omitempty,max=100" example:"Backend Engineer"`
+	NIK                      *string `json:"nik" validate:"omitempty,numeric,len=16" example:"3171234567890001"`
+	NPWP                     *string `json:"npwp" validate:"omitempty,numeric,min=15,max=16" example:"0123456789012345"`
+	PTKPStatus               *string `json:"ptkp_status" validate:"omitempty,oneof=TK/0 TK/1 TK/2 TK/3 K/0 K/1 K/2 K/3 K/I/0 K/I/1 K/I/2 K/I/3" example:"K/1"`
+	BankName                 *string `json:"bank_name" validate:"omitempty,max=50" example:"BCA"`
+	BankAccountNumber        *string `json:"bank_account_number" validate:"omitempty,numeric,min=6,max=20" example:"1234567890"`
+	BankAccountName          *string `json:"bank_account_name" validate:"omitempty,max=100" example:"John Doe"`
+	EmergencyContactName     *string `json:"emergency_contact_name" validate:"omitempty,max=100" example:"Jane Doe"`
+	EmergencyContactPhone    *string `json:"emergency_contact_phone" validate:"omitempty,e164" example:"+6281298765432"`
+	EmergencyContactRelation *string `json:"emergency_contact_relation" validate:"omitempty,max=50" example:"spouse"`
+}
+
+type DepartmentRequest struct {
+	Name string `json:"name" validate:"required,max=100" example:"Engineering"`
+}
+
+type CreatePositionRequest struct {
+	Name         string `json:"name" validate:"required,max=100" example:"Backend Engineer"`
+	DepartmentID uint   `json:"department_id" validate:"required" example:"1"`
+}
+
+type UpdatePositionRequest struct {
+	Name         *string `json:"name" validate:"omitempty,min=1,max=100" example:"Senior Backend Engineer"`
+	DepartmentID *uint   `json:"department_id" validate:"omitempty,min=1" example:"1"`
+}
+
+// AssignEmployeeRequest leaves omitted fields unchanged; 0 clears one.
+type AssignEmployeeRequest struct {
+	DepartmentID *uint `json:"department_id" example:"1"`
+	PositionID   *uint `json:"position_id" example:"3"`
+	ManagerID    *uint `json:"manager_id" example:"2"`
+}
+
+type SetRolePermissionsRequest struct {
+	Permissions []string `json:"permissions" validate:"required,dive,required" example:"employee:read,attendance:read_team"`
+}
+
+type UpdateOwnProfileRequest struct {
+	Phone                    *string `json:"phone" validate:"omitempty,e164" example:"+6281234567890"`
+	EmergencyContactName     *string `json:"emergency_contact_name" validate:"omitempty,max=100" example:"Jane Doe"`
+	EmergencyContactPhone    *string `json:"emergency_contact_phone" validate:"omitempty,e164" example:"+6281298765432"`
+	EmergencyContactRelation *string `json:"emergency_contact_relation" validate:"omitempty,max=50" example:"spouse"`
+}
+
 type CreateAttendancePeriodRequest struct {
-	StartDate string `json:"start_date" binding:"required" example:"2025-06-01T00:00:00Z"`
-	EndDate   string `json:"end_date" binding:"required" example:"2025-06-15T23:59:59Z"`
+	StartDate string `json:"start_date" binding:"required" example:"2025-06-01"`
+	EndDate   string `json:"end_date" binding:"required" example:"2025-06-15"`
+}
+
+type UpdateAttendancePeriodRequest struct {
+	StartDate string `json:"start_date" validate:"required" example:"2025-06-01"`
+	EndDate   string `json:"end_date" validate:"required" example:"2025-06-15"`
+}
+
+type TransitionAttendancePeriodRequest struct {
+	Status string `json:"status" validate:"required,oneof=open locked payroll_processing closed reopened" example:"locked"`
+	Note   string `json:"note" example:"Attendance reviewed"`
+}
+
+type AttendanceCorrectionRequest struct {
+	Date       string `json:"date" validate:"required" example:"2025-06-10"`
+	Type       string `json:"type" validate:"required,oneof=missing_check_in missing_check_out wrong_time" example:"missing_check_out"`
+	CheckInAt  string `json:"check_in_at" example:"2025-06-10T09:00:00+07:00"`
+	CheckOutAt string `json:"check_out_at" example:"2025-06-10T17:00:00+07:00"`
+	Reason     string `json:"reason" validate:"required"`
+}
+
+type ReviewAttendanceCorrectionRequest struct {
+	Action string `json:"action" validate:"required,oneof=approve reject" example:"approve"`
+	Note   string `json:"note"`
+}
+
+type CreateShiftRequest struct {
+	Name         string `json:"name" validate:"required" example:"Night"`
+	StartTime    string `json:"start_time" validate:"required" example:"22:00"`
+	EndTime      string `json:"end_time" validate:"required" example:"06:00"`
+	BreakMinutes int    `json:"break_minutes" validate:"min=0" example:"60"`
+}
+
+type UpdateShiftRequest struct {
+	Name         *string `json:"name" example:"Night"`
+	StartTime    *string `json:"start_time" example:"22:00"`
+	EndTime      *string `json:"end_time" example:"06:00"`
+	BreakMinutes *int    `json:"break_minutes" validate:"omitempty,min=0" example:"60"`
+}
+
+type AssignRosterRequest struct {
+	UserID    uint    `json:"user_id" validate:"required" example:"2"`
+	WeekStart string  `json:"week_start" validate:"required" example:"2025-06-09"`
+	ShiftIDs  []*uint `json:"shift_ids" validate:"len=7"` // Monday to Sunday, null is a day off
+}
+
+type PunchRequest struct {
+	Latitude  *float64 `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90" example:"-6.175392"`
+	Longitude *float64 `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180" example:"106.827153"`
+	Accuracy  float64  `json:"accuracy" validate:"min=0" example:"12.5"` // meters, as reported by the device
+
+	KioskToken string `json:"kiosk_token" example:"3.58312047.9f2c41d0a7b3e6f1.Jm0ZfQ8xR4yWb2nC5vLk1aTgHs7uPdEo3iXqYz6M9cU"` // scanned from a kiosk QR code
+}
+
+type CheckInRequest struct {
+	PunchRequest
+	WorkMode string `json:"work_mode" validate:"omitempty,oneof=office wfh client_site business_trip" example:"office"`
+}
+
+type TravelRequestRequest struct {
+	Destination string `json:"destination" validate:"required" example:"Surabaya"`
+	Purpose     string `json:"purpose" validate:"required" example:"Client onboarding"`
+	StartDate   string `json:"start_date" validate:"required" example:"2025-06-10"`
+	EndDate     string `json:"end_date" validate:"required" example:"2025-06-12"`
+}
+
+type CreateHolidayRequest struct {
+	Date string `json:"date" validate:"required" example:"2025-08-17"`
+	Name string `json:"name" validate:"required" example:"Independence Day"`
+}
+
+type ReviewTravelRequestRequest struct {
+	Action string `json:"action" validate:"required,oneof=approve reject" example:"approve"`
+	Note   string `json:"note"`
+}
+
+type CreateOfficeLocationRequest struct {
+	Name         string  `json:"name" validate:"required" example:"Head Office"`
+	Latitude     float64 `json:"latitude" validate:"min=-90,max=90" example:"-6.175392"`
+	Longitude    float64 `json:"longitude" validate:"min=-180,max=180" example:"106.827153"`
+	RadiusMeters float64 `json:"radius_meters" validate:"gt=0" example:"100"`
+}
+
+type UpdateOfficeLocationRequest struct {
+	Name         *string  `json:"name" example:"Head Office"`
+	Latitude     *float64 `json:"latitude" validate:"omitempty,min=-90,max=90" example:"-6.175392"`
+	Longitude    *float64 `json:"longitude" validate:"omitempty,min=-180,max=180" example:"106.827153"`
+	RadiusMeters *float64 `json:"radius_meters" validate:"omitempty,gt=0" example:"100"`
+	Active       *bool    `json:"active" example:"true"`
+}
+
+type RegisterKioskRequest struct {
+	Name             string `json:"name" validate:"required" example:"Lobby Kiosk"`
+	OfficeLocationID uint   `json:"office_location_id" validate:"required" example:"1"`
+}
+
+type UpdateKioskRequest struct {
+	Name   *string `json:"name" example:"Lobby Kiosk"`
+	Active *bool   `json:"active" example:"true"`
+}
+
+type RegisterTimeClockRequest struct {
+	Name             string `json:"name" validate:"required" example:"Lobby Fingerprint"`
+	OfficeLocationID *uint  `json:"office_location_id" example:"1"`
+}
+
+type MapTimeClockEmployeeRequest struct {
+	EmployeeCode string `json:"employee_code" validate:"required" example:"00042"`
+	UserID       uint   `json:"user_id" validate:"required" example:"7"`
+}
+
+type PunchBatchRequest struct {
+	Punches []RawPunchRequest `json:"punches" validate:"required,min=1,max=1000,dive"`
+}
+
+type RawPunchRequest struct {
+	EmployeeCode string    `json:"employee_code" validate:"required" example:"00042"`
+	PunchedAt    time.Time `json:"punched_at" validate:"required" example:"2025-06-10T08:57:12+07:00"`
+}
+
+type RegisterDeviceRequest struct {
+	Name string `json:"name" validate:"required" example:"Pixel 8"`
+}
+
+type UpdateDeviceRequest struct {
+	Active *bool `json:"active" validate:"required" example:"false"`
+}
+
+type SyncAttendanceRequest struct {
+	DeviceID   uint                  `json:"device_id" validate:"required" example:"1"`
+	DeviceTime time.Time             `json:"device_time" validate:"required" example:"2025-06-10T18:30:00+07:00"` // device clock when sending
+	Events     []OfflineEventRequest `json:"events" validate:"required,min=1,max=200,dive"`
+}
+
+type OfflineEventRequest struct {
+	ClientID   string    `json:"client_id" validate:"required,max=64" example:"5b0c3a8e-2f4d-4f7e-9a51-0d3c6e2b7f10"`
+	Type       string    `json:"type" validate:"required,oneof=check_in check_out" example:"check_in"`
+	RecordedAt time.Time `json:"recorded_at" validate:"required" example:"2025-06-10T08:58:00+07:00"`
+	Latitude   *float64  `json:"latitude" validate:"required_with=Longitude,omitempty,min=-90,max=90" example:"-6.175392"`
+	Longitude  *float64  `json:"longitude" validate:"required_with=Latitude,omitempty,min=-180,max=180" example:"106.827153"`
+	Accuracy   float64   `json:"accuracy" validate:"min=0" example:"12.5"`
+	Signature  string    `json:"signature" validate:"required" example:"9c1f0e..."` // hex HMAC-SHA256 of the event with the device secret
 }
@@ -28,7 +28,88 @@ type GenericResponse struct {
 }
 
 type AuthResponse struct {
-	Token string `json:"token"`
+	Token        string    `json:"token"` // access token
+	ExpiresAt    time.Time `json:"expires_at"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+
+	// The token only works for PUT /api/password until the password is
+	// changed
+	MustChangePassword bool `json:"must_change_password,omitempty"`
+
+	// Only set when this login completed two-factor enrolment
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+// LoginChallengeResponse is returned instead of tokens when a second
+// factor is needed. Send the challenge token with a code to /login/2fa.
+type LoginChallengeResponse struct {
+	TwoFactorRequired  bool      `json:"two_factor_required"`
+	EnrollmentRequired bool      `json:"enrollment_required"`
+	ChallengeToken     string    `json:"challenge_token"`
+	ExpiresAt          time.Time `json:"expires_at"`
+}
+
+type TwoFactorEnrollmentResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // render as a QR code
+}
+
+type TwoFactorStatusResponse struct {
+	Enabled           bool `json:"enabled"`
+	Pending           bool `json:"pending"`
+	Required          bool `json:"required"`
+	RecoveryCodesLeft int  `json:"recovery_codes_left"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type SecurityEventResp struct {
+	ID        uint      `json:"id"`
+	Type      string    `json:"type"`
+	UserID    *uint     `json:"user_id,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	IPAddress string    `json:"ip_address,omitempty"`
+	UserAgent string    `json:"user_agent,omitempty"`
+	ActorID   *uint     `json:"actor_id,omitempty"` // who unlocked
+	Detail    string    `json:"detail,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type SecurityEventListResponse struct {
+	Data       []SecurityEventResp `json:"data"`
+	TotalData  int                 `json:"total_data"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+type JWKResp struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSResponse struct {
+	Keys []JWKResp `json:"keys"`
+}
+
+type SessionResp struct {
+	ID         uint      `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+type SessionListResponse struct {
+	Data []SessionResp `json:"data"`
 }
 
 type PayslipListResponse struct {
@@ -42,7 +123,17 @@ type GetPayrollSummaryResponse struct {
 	GrandTotal float64                     `json:"grand_total"`
 }
 
+type GetPayrollVarianceResponse struct {
+	PreviousPeriodID uint                         `json:"previous_period_id"`
+	CurrentPeriodID  uint                         `json:"current_period_id"`
+	ThresholdPercent float64                      `json:"threshold_percent"`
+	FlaggedCount     int                          `json:"flagged_count"`
+	Items            []entity.PayrollVarianceItem `json:"data"`
+}
+
 type PayslipDataResp struct {
+	ID                 uint      `json:"id"`
+	UserID             uint      `json:"user_id"`
 	AttendancePeriodID uint      `json:"attendance_period_id"`
 	BaseSalary         string    `json:"base_salary"`
 	WorkingDays        int       `json:"working_days"`
@@ -51,6 +142,375 @@ type PayslipDataResp struct {
 	OvertimeHours      float64   `json:"overtime_hours"`
 	OvertimePay        string    `json:"overtime_pay"`
 	ReimbursementTotal string    `json:"reimbursement_total"`
+	LateMinutes        int       `json:"late_minutes"`
+	EarlyLeaveMinutes  int       `json:"early_leave_minutes"`
+	LatenessDeduction  string    `json:"lateness_deduction"`
 	TotalPay           string    `json:"total_pay"`
+	Status             string    `json:"status"`
 	CreatedAt          time.Time `json:"created_at"`
 }
+
+type AttendanceCorrectionResp struct {
+	ID                  uint       `json:"id"`
+	UserID              uint       `json:"user_id"`
+	AttendanceID        *uint      `json:"attendance_id"`
+	Date                string     `json:"date"`
+	Type                string     `json:"type"`
+	RequestedCheckInAt  *time.Time `json:"requested_check_in_at"`
+	RequestedCheckOutAt *time.Time `json:"requested_check_out_at"`
+	OriginalCheckInAt   *time.Time `json:"original_check_in_at"`
+	OriginalCheckOutAt  *time.Time `json:"original_check_out_at"`
+	Reason              string     `json:"reason"`
+	Status              string     `json:"status"`
+	ReviewedBy          *uint      `json:"reviewed_by"`
+	ReviewedAt          *time.Time `json:"reviewed_at"`
+	ReviewNote          string     `json:"review_note"`
+	CreatedAt           time.Time  `json:"created_at"`
+}
+
+type AttendanceCorrectionListResponse struct {
+	Data []AttendanceCorrectionResp `json:"data"`
+}
+
+type ShiftResp struct {
+	ID              uint   `json:"id"`
+	Name            string `json:"name"`
+	StartTime       string `json:"start_time"`
+	EndTime         string `json:"end_time"`
+	BreakMinutes    int    `json:"break_minutes"`
+	CrossesMidnight bool   `json:"crosses_midnight"`
+}
+
+type ShiftListResponse struct {
+	Data []ShiftResp `json:"data"`
+}
+
+type RosterResp struct {
+	Date  string    `json:"date"`
+	Shift ShiftResp `json:"shift"`
+}
+
+type RosterListResponse struct {
+	UserID uint         `json:"user_id"`
+	Data   []RosterResp `json:"data"`
+}
+
+type AttendanceReportItem struct {
+	ID                 uint       `json:"id"`
+	Date               string     `json:"date"`
+	ShiftID            *uint      `json:"shift_id"`
+	WorkMode           string     `json:"work_mode"`
+	CheckedInAt        *time.Time `json:"checked_in_at"`
+	CheckedOutAt       *time.Time `json:"checked_out_at"`
+	WorkedMinutes      int        `json:"worked_minutes"`
+	BreakMinutes       int        `json:"break_minutes"`
+	LateMinutes        int        `json:"late_minutes"`
+	EarlyLeaveMinutes  int        `json:"early_leave_minutes"`
+	CheckInLocationID  *uint      `json:"check_in_location_id"`
+	CheckOutLocationID *uint      `json:"check_out_location_id"`
+	OutsideGeofence    bool       `json:"outside_geofence"`
+	AutoClosed         bool       `json:"auto_closed"`
+}
+
+type AttendanceReportResponse struct {
+	UserID                 uint                   `json:"user_id"`
+	StartDate              string                 `json:"start_date"`
+	EndDate                string                 `json:"end_date"`
+	AttendedDays           int                    `json:"attended_days"`
+	TotalWorkedMinutes     int                    `json:"total_worked_minutes"`
+	LateDays               int                    `json:"late_days"`
+	TotalLateMinutes       int                    `json:"total_late_minutes"`
+	EarlyLeaveDays         int                    `json:"early_leave_days"`
+	TotalEarlyLeaveMinutes int                    `json:"total_early_leave_minutes"`
+	ByWorkMode             []WorkModeSummaryResp  `json:"by_work_mode"`
+	Data                   []AttendanceReportItem `json:"data"`
+}
+
+type AttendanceDayResp struct {
+	Date          string                `json:"date"`
+	Status        string                `json:"status" enums:"present,absent,weekend,holiday,leave"`
+	Weekend       bool                  `json:"weekend"`
+	Holiday       bool                  `json:"holiday"`
+	HolidayName   string                `json:"holiday_name,omitempty"`
+	Absent        bool                  `json:"absent"`
+	WorkedHours   float64               `json:"worked_hours"`
+	OvertimeHours float64               `json:"overtime_hours"`
+	Attendance    *AttendanceReportItem `json:"attendance"`
+}
+
+type AttendanceHistoryResponse struct {
+	Data       []AttendanceDayResp `json:"data"`
+	TotalData  int                 `json:"total_data"`
+	TotalPages int                 `json:"total_pages"`
+}
+
+type WorkModeSummaryResp struct {
+	UserID        uint   `json:"user_id"`
+	WorkMode      string `json:"work_mode"`
+	Days          int    `json:"days"`
+	WorkedMinutes int    `json:"worked_minutes"`
+}
+
+type WorkModeReportResponse struct {
+	AttendancePeriodID uint                  `json:"attendance_period_id"`
+	Data               []WorkModeSummaryResp `json:"data"`
+}
+
+type TravelRequestResp struct {
+	ID          uint       `json:"id"`
+	UserID      uint       `json:"user_id"`
+	Destination string     `json:"destination"`
+	Purpose     string     `json:"purpose"`
+	StartDate   string     `json:"start_date"`
+	EndDate     string     `json:"end_date"`
+	Status      string     `json:"status"`
+	ReviewedBy  *uint      `json:"reviewed_by"`
+	ReviewedAt  *time.Time `json:"reviewed_at"`
+	ReviewNote  string     `json:"review_note"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type TravelRequestListResponse struct {
+	Data []TravelRequestResp `json:"data"`
+}
+
+type AttendancePeriodResp struct {
+	ID        uint       `json:"id"`
+	StartDate string     `json:"start_date"`
+	EndDate   string     `json:"end_date"`
+	Status    string     `json:"status"`
+	ClosedAt  *time.Time `json:"closed_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type AttendancePeriodListResponse struct {
+	Data []AttendancePeriodResp `json:"data"`
+}
+
+type AttendancePeriodTransitionResp struct {
+	ID         uint      `json:"id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ChangedBy  *uint     `json:"changed_by"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type AttendancePeriodTransitionListResponse struct {
+	AttendancePeriodID uint                             `json:"attendance_period_id"`
+	Data               []AttendancePeriodTransitionResp `json:"data"`
+}
+
+type EmployeeResp struct {
+	ID                 uint       `json:"id"`
+	Username           string     `json:"username"`
+	FullName           string     `json:"full_name"`
+	Email              string     `json:"email"`
+	Role               string     `json:"role"`
+	Salary             float64    `json:"salary"`
+	Active             bool       `json:"active"`
+	DepartmentID       *uint      `json:"department_id"`
+	PositionID         *uint      `json:"position_id"`
+	ManagerID          *uint      `json:"manager_id"`
+	MustChangePassword bool       `json:"must_change_password"`
+	PasswordChangedAt  *time.Time `json:"password_changed_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+}
+
+type EmployeeListResponse struct {
+	Data       []EmployeeResp `json:"data"`
+	TotalData  int            `json:"total_data"`
+	TotalPages int            `json:"total_pages"`
+}
+
+type EmployeeProfileResp struct {
+	UserID                   uint   `json:"user_id"`
+	EmployeeNumber           string `json:"employee_number"`
+	Phone                    string `json:"phone"`
+	HireDate                 string `json:"hire_date,omitempty"`
+	EmploymentType           string `json:"employment_type"`
+	JobTitle                 string `json:"job_title"`
+	NIK                      string `json:"nik"`
+	NPWP                     string `json:"npwp"`
+	PTKPStatus               string `json:"ptkp_status"`
+	BankName                 string `json:"bank_name"`
+	BankAccountNumber        string `json:"bank_account_number"`
+	BankAccountName          string `json:"bank_account_name"`
+	EmergencyContactName     string `json:"emergency_contact_name"`
+	EmergencyContactPhone    string `json:"emergency_contact_phone"`
+	EmergencyContactRelation string `json:"emergency_contact_relation"`
+}
+
+type DepartmentResp struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+type DepartmentListResponse struct {
+	Data []DepartmentResp `json:"data"`
+}
+
+type PositionResp struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	DepartmentID uint   `json:"department_id"`
+}
+
+type PositionListResponse struct {
+	Data []PositionResp `json:"data"`
+}
+
+type OrgChartNodeResp struct {
+	ID           uint               `json:"id"`
+	FullName     string             `json:"full_name"`
+	DepartmentID *uint              `json:"department_id"`
+	PositionID   *uint              `json:"position_id"`
+	Reports      []OrgChartNodeResp `json:"reports"`
+}
+
+type OrgChartResponse struct {
+	Data []OrgChartNodeResp `json:"data"`
+}
+
+type ApproverChainResponse struct {
+	Data []EmployeeResp `json:"data"`
+}
+
+type RoleResp struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type RoleListResponse struct {
+	Data []RoleResp `json:"data"`
+}
+
+type HolidayResp struct {
+	ID   uint   `json:"id"`
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+type HolidayListResponse struct {
+	Data []HolidayResp `json:"data"`
+}
+
+type OfficeLocationResp struct {
+	ID           uint    `json:"id"`
+	Name         string  `json:"name"`
+	Latitude     float64 `json:"latitude"`
+	Longitude    float64 `json:"longitude"`
+	RadiusMeters float64 `json:"radius_meters"`
+	Active       bool    `json:"active"`
+}
+
+type OfficeLocationListResponse struct {
+	Data []OfficeLocationResp `json:"data"`
+}
+
+type RegisterKioskResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	ID      uint   `json:"id"`
+	Secret  string `json:"secret"` // shown only once
+}
+
+type KioskResp struct {
+	ID               uint   `json:"id"`
+	Name             string `json:"name"`
+	OfficeLocationID uint   `json:"office_location_id"`
+	Active           bool   `json:"active"`
+}
+
+type KioskListResponse struct {
+	Data []KioskResp `json:"data"`
+}
+
+type RegisterTimeClockResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	ID      uint   `json:"id"`
+	Secret  string `json:"secret"` // shown only once
+}
+
+type TimeClockResp struct {
+	ID               uint   `json:"id"`
+	Name             string `json:"name"`
+	OfficeLocationID *uint  `json:"office_location_id"`
+	Active           bool   `json:"active"`
+}
+
+type TimeClockListResponse struct {
+	Data []TimeClockResp `json:"data"`
+}
+
+type PunchIngestResponse struct {
+	Success    bool `json:"success"`
+	Received   int  `json:"received"`
+	Applied    int  `json:"applied"`
+	Duplicates int  `json:"duplicates"`
+	Unmapped   int  `json:"unmapped"`
+	Unpaired   int  `json:"unpaired"`
+}
+
+type TimeClockPunchResp struct {
+	ID           uint      `json:"id"`
+	TimeClockID  uint      `json:"time_clock_id"`
+	EmployeeCode string    `json:"employee_code"`
+	UserID       *uint     `json:"user_id"`
+	PunchedAt    time.Time `json:"punched_at"`
+	Status       string    `json:"status"`
+	Note         string    `json:"note"`
+}
+
+type TimeClockPunchListResponse struct {
+	Data []TimeClockPunchResp `json:"data"`
+}
+
+type RegisterDeviceResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	ID      uint   `json:"id"`
+	Secret  string `json:"secret"` // shown only once
+}
+
+type DeviceResp struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type DeviceListResponse struct {
+	Data []DeviceResp `json:"data"`
+}
+
+type SyncEventResultResp struct {
+	ClientID string `json:"client_id"`
+	Status   string `json:"status"`
+	Message  string `json:"message,omitempty"`
+}
+
+type SyncAttendanceResponse struct {
+	Success bool                  `json:"success"`
+	Results []SyncEventResultResp `json:"results"`
+}
+
+type NotificationResp struct {
+	ID        uint       `json:"id"`
+	Type      string     `json:"type"`
+	Message   string     `json:"message"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	Data []NotificationResp `json:"data"`
+}
+
+type KioskTokenResponse struct {
+	Token          string    `json:"token"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	RefreshSeconds int       `json:"refresh_seconds"`
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/business/usecase"
 	_ "github.com/zuhrulumam/go-hris/docs"
 	"github.com/zuhrulumam/go-hris/pkg/middlewares"
@@ -42,21 +43,133 @@ func (r rest) Serve() {
 	r.app.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	r.app.POST("/login", r.Login)
+	r.app.POST("/login/2fa", r.VerifyLogin)
+	r.app.POST("/login/2fa/enroll", r.EnrollLoginTwoFactor)
+	r.app.GET("/sso/login", r.StartSSO)
+	r.app.GET("/sso/callback", r.SSOCallback)
 	r.app.POST("/register", r.Register)
+	r.app.POST("/refresh", r.RefreshToken)
+	r.app.POST("/password/forgot", r.ForgotPassword)
+	r.app.POST("/password/reset", r.ResetPassword)
+	r.app.GET("/.well-known/jwks.json", r.GetJWKS)
+	r.app.GET("/kiosk/token", r.GetKioskToken)
+	r.app.POST("/timeclock/punches", r.IngestPunches)
 
 	api := r.app.Group("/api")
-	api.Use(middlewares.JWTMiddleware())
+	api.Use(middlewares.JWTMiddleware(r.uc.SigningKey.Keyfunc, r.uc.User.IsSessionRevoked))
+	api.Use(middlewares.RequirePasswordChange("/api/password", "/api/logout", "/api/logout/all"))
 
+	perm := func(p entity.Permission) gin.HandlerFunc {
+		return middlewares.RequirePermission(r.uc.Access.HasPermission, string(p))
+	}
+
+	api.POST("/logout", r.Logout)
+	api.POST("/logout/all", r.LogoutAll)
+	api.GET("/sessions", r.GetSessions)
+	api.DELETE("/sessions/:id", r.RevokeSession)
+	api.PUT("/password", r.ChangePassword)
+
+	api.GET("/2fa", r.GetTwoFactorStatus)
+	api.POST("/2fa/enroll", r.EnrollTwoFactor)
+	api.POST("/2fa/confirm", r.ConfirmTwoFactor)
+	api.POST("/2fa/recovery-codes", r.RegenerateRecoveryCodes)
+	api.POST("/2fa/disable", r.DisableTwoFactor)
+
+	api.GET("/attendance", r.GetAttendanceHistory)
 	api.POST("/attendance/checkin", r.CheckIn)
 	api.POST("/attendance/checkout", r.CheckOut)
+	api.POST("/attendance/break/start", r.StartBreak)
+	api.POST("/attendance/break/end", r.EndBreak)
+	api.POST("/attendance/sync", r.SyncAttendance)
 	api.POST("/attendance/overtime", r.CreateOvertime)
+	api.GET("/attendance/report", r.GetAttendanceReport)
+	api.GET("/attendance/report/work-mode", perm(entity.PermAttendanceReadAll), r.GetWorkModeReport)
+
+	api.POST("/attendance/correction", r.SubmitAttendanceCorrection)
+	api.GET("/attendance/correction", r.GetAttendanceCorrections)
+	api.POST("/attendance/correction/:id/review", perm(entity.PermAttendanceReview), r.ReviewAttendanceCorrection)
 
 	api.POST("/reimbursement/submit", r.SubmitReimbursement)
 
-	api.POST("/payroll/create", r.CreatePayroll)
+	api.POST("/payroll/create", perm(entity.PermPayrollRun), r.CreatePayroll)
 	api.GET("/payslip", r.GetPayslip)
+	api.GET("/payslips", r.ListPayslip)
+
+	api.GET("/payroll/summary", perm(entity.PermPayrollReadAll), r.GetPayrollSummary)
+	api.GET("/payroll/variance", perm(entity.PermPayrollReadAll), r.GetPayrollVariance)
+
+	api.POST("/attendance/period", perm(entity.PermPeriodManage), r.CreateAttendancePeriod)
+	api.GET("/attendance/period", r.GetAttendancePeriods)
+	api.PUT("/attendance/period/:id", perm(entity.PermPeriodManage), r.UpdateAttendancePeriod)
+	api.POST("/attendance/period/:id/transition", perm(entity.PermPeriodManage), r.TransitionAttendancePeriod)
+	api.GET("/attendance/period/:id/history", perm(entity.PermPeriodManage), r.GetAttendancePeriodHistory)
+
+	api.POST("/shift", perm(entity.PermScheduleManage), r.CreateShift)
+	api.GET("/shift", r.GetShifts)
+	api.PUT("/shift/:id", perm(entity.PermScheduleManage), r.UpdateShift)
+
+	api.PUT("/roster", perm(entity.PermScheduleManage), r.AssignRoster)
+	api.GET("/roster", r.GetRoster)
+
+	api.POST("/location", perm(entity.PermScheduleManage), r.CreateOfficeLocation)
+	api.GET("/location", r.GetOfficeLocations)
+	api.PUT("/location/:id", perm(entity.PermScheduleManage), r.UpdateOfficeLocation)
+
+	api.POST("/kiosk", perm(entity.PermScheduleManage), r.RegisterKiosk)
+	api.GET("/kiosk", perm(entity.PermScheduleManage), r.GetKiosks)
+	api.PUT("/kiosk/:id", perm(entity.PermScheduleManage), r.UpdateKiosk)
+
+	api.POST("/timeclock", perm(entity.PermScheduleManage), r.RegisterTimeClock)
+	api.GET("/timeclock", perm(entity.PermScheduleManage), r.GetTimeClocks)
+	api.PUT("/timeclock/employee", perm(entity.PermScheduleManage), r.MapTimeClockEmployee)
+	api.GET("/timeclock/reconciliation", perm(entity.PermAttendanceReadAll), r.GetPunchReconciliation)
+
+	api.POST("/device", r.RegisterDevice)
+	api.GET("/device", r.GetDevices)
+	api.PUT("/device/:id", r.UpdateDevice)
+
+	api.GET("/notification", r.GetNotifications)
+	api.PUT("/notification/:id/read", r.MarkNotificationRead)
+
+	api.POST("/travel", r.SubmitTravelRequest)
+	api.GET("/travel", r.GetTravelRequests)
+	api.POST("/travel/:id/review", perm(entity.PermTravelReview), r.ReviewTravelRequest)
+
+	api.POST("/employee", perm(entity.PermEmployeeManage), r.CreateEmployee)
+	api.GET("/employee", perm(entity.PermEmployeeRead), r.ListEmployees)
+	api.GET("/employee/:id", perm(entity.PermEmployeeRead), r.GetEmployee)
+	api.PUT("/employee/:id", perm(entity.PermEmployeeManage), r.UpdateEmployee)
+	api.PUT("/employee/:id/status", perm(entity.PermEmployeeManage), r.UpdateEmployeeStatus)
+	api.GET("/employee/:id/profile", perm(entity.PermEmployeeRead), r.GetEmployeeProfile)
+	api.PUT("/employee/:id/profile", perm(entity.PermEmployeeManage), r.UpdateEmployeeProfile)
+	api.PUT("/employee/:id/assignment", perm(entity.PermOrgManage), r.AssignEmployee)
+	api.GET("/employee/:id/approvers", perm(entity.PermEmployeeRead), r.GetApproverChain)
+	api.GET("/employee/:id/sessions", perm(entity.PermEmployeeRead), r.GetEmployeeSessions)
+	api.POST("/employee/:id/logout", perm(entity.PermEmployeeManage), r.LogoutEmployee)
+	api.DELETE("/employee/:id/2fa", perm(entity.PermEmployeeManage), r.ResetEmployeeTwoFactor)
+	api.POST("/employee/:id/password-reset", perm(entity.PermEmployeeManage), r.SendEmployeePasswordReset)
+	api.POST("/employee/:id/unlock", perm(entity.PermEmployeeManage), r.UnlockEmployee)
+
+	api.GET("/security/events", perm(entity.PermSecurityRead), r.ListSecurityEvents)
+	api.POST("/security/unlock-ip", perm(entity.PermEmployeeManage), r.UnlockIP)
+
+	api.POST("/department", perm(entity.PermOrgManage), r.CreateDepartment)
+	api.GET("/department", r.GetDepartments)
+	api.PUT("/department/:id", perm(entity.PermOrgManage), r.UpdateDepartment)
+
+	api.POST("/position", perm(entity.PermOrgManage), r.CreatePosition)
+	api.GET("/position", r.GetPositions)
+	api.PUT("/position/:id", perm(entity.PermOrgManage), r.UpdatePosition)
+
+	api.GET("/org-chart", r.GetOrgChart)
+
+	api.GET("/role", perm(entity.PermRoleManage), r.GetRoles)
+	api.PUT("/role/:name/permissions", perm(entity.PermRoleManage), r.SetRolePermissions)
 
-	api.GET("/payroll/summary", r.GetPayrollSummary)
+	api.GET("/profile", r.GetOwnProfile)
+	api.PUT("/profile", r.UpdateOwnProfile)
 
-	api.POST("/attendance/period", r.CreateAttendancePeriod)
+	api.POST("/holiday", perm(entity.PermScheduleManage), r.CreateHoliday)
+	api.GET("/holiday", r.GetHolidays)
+	api.DELETE("/holiday/:id", perm(entity.PermScheduleManage), r.DeleteHoliday)
 }
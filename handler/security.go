@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// UnlockEmployee godoc
+// @Summary      Unlock an employee's login
+// @Description  Lifts a lockout after too many failed logins before it expires and forgets earlier failures
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/unlock [post]
+func (e *rest) UnlockEmployee(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.uc.User.UnlockAccount(c.Request.Context(), uint(id), userID.(uint)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Account unlocked successfully!",
+	})
+}
+
+// UnlockIP godoc
+// @Summary      Unlock an IP address
+// @Description  Lifts a lockout on an IP address after too many failed logins from it
+// @Tags         Security
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.UnlockIPRequest true "IP address"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/security/unlock-ip [post]
+func (e *rest) UnlockIP(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var input UnlockIPRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	if err := e.uc.User.UnlockIP(c.Request.Context(), input.IPAddress, userID.(uint)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "IP address unlocked successfully!",
+	})
+}
+
+// ListSecurityEvents godoc
+// @Summary      Authentication audit trail
+// @Description  Failed logins, failed two-factor codes, lockouts and unlocks, newest first
+// @Tags         Security
+// @Accept       json
+// @Produce      json
+// @Param        type query string false "login_failed, login_blocked, two_factor_failed, account_locked, ip_locked, account_unlocked or ip_unlocked"
+// @Param        user_id query int false "Account the event belongs to"
+// @Param        username query string false "Username as typed at login"
+// @Param        ip_address query string false "Client IP address"
+// @Param        start_date query string false "From date (YYYY-MM-DD)"
+// @Param        end_date query string false "To date, inclusive (YYYY-MM-DD)"
+// @Param        page query int false "Page number"
+// @Param        limit query int false "Page limit"
+// @Success      200 {object} handler.SecurityEventListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/security/events [get]
+func (e *rest) ListSecurityEvents(c *gin.Context) {
+	page, limit, err := getPagination(c)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	filter := entity.GetSecurityEventFilter{
+		Type:      entity.SecurityEventType(c.Query("type")),
+		Username:  c.Query("username"),
+		IPAddress: c.Query("ip_address"),
+		Page:      page,
+		Limit:     limit,
+	}
+
+	if v := c.Query("user_id"); v != "" {
+		id, err := strconv.Atoi(v)
+		if err != nil || id <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid user_id"))
+			return
+		}
+		filter.UserID = uint(id)
+	}
+
+	if v := c.Query("start_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+			return
+		}
+		filter.From = &t
+	}
+
+	if v := c.Query("end_date"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+			return
+		}
+		to := t.AddDate(0, 0, 1)
+		filter.To = &to
+	}
+
+	events, totalData, totalPages, err := e.uc.User.ListSecurityEvents(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]SecurityEventResp, 0, len(events))
+	for _, ev := range events {
+		data = append(data, SecurityEventResp{
+			ID:        ev.ID,
+			Type:      string(ev.Type),
+			UserID:    ev.UserID,
+			Username:  ev.Username,
+			IPAddress: ev.IPAddress,
+			UserAgent: ev.UserAgent,
+			ActorID:   ev.ActorID,
+			Detail:    ev.Detail,
+			CreatedAt: ev.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, SecurityEventListResponse{
+		Data:       data,
+		TotalData:  int(totalData),
+		TotalPages: totalPages,
+	})
+}
@@ -0,0 +1,278 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// RefreshToken godoc
+// @Summary      Refresh an access token
+// @Description  Trades a refresh token for a new access token and a new refresh token. Each refresh token works once; reusing an old one revokes the session.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.RefreshTokenRequest true "Refresh token"
+// @Success      200 {object} handler.AuthResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /refresh [post]
+func (e *rest) RefreshToken(c *gin.Context) {
+	var input RefreshTokenRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	tokens, err := e.uc.User.RefreshSession(c.Request.Context(), entity.RefreshSessionRequest{
+		RefreshToken: input.RefreshToken,
+		UserAgent:    c.Request.UserAgent(),
+		IPAddress:    c.ClientIP(),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAuthResponse(tokens))
+}
+
+// Logout godoc
+// @Summary      Log out
+// @Description  Ends the current session. Its access and refresh tokens stop working immediately.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.GenericResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/logout [post]
+func (e *rest) Logout(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := e.uc.User.RevokeSession(c.Request.Context(), userID.(uint), c.GetUint("sessionID")); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Logged out successfully!",
+	})
+}
+
+// LogoutAll godoc
+// @Summary      Log out of all sessions
+// @Description  Ends every session of the current user, including this one
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.GenericResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/logout/all [post]
+func (e *rest) LogoutAll(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := e.uc.User.RevokeAllSessions(c.Request.Context(), userID.(uint)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Logged out of all sessions successfully!",
+	})
+}
+
+// GetSessions godoc
+// @Summary      List my sessions
+// @Description  Lists the current user's active sessions, most recently used first
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.SessionListResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/sessions [get]
+func (e *rest) GetSessions(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	sessions, err := e.uc.User.GetSessions(c.Request.Context(), userID.(uint))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, SessionListResponse{Data: toSessionResps(sessions, c.GetUint("sessionID"))})
+}
+
+// RevokeSession godoc
+// @Summary      Log out one of my sessions
+// @Description  Ends one of the current user's sessions, for example a lost phone
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Session ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/sessions/{id} [delete]
+func (e *rest) RevokeSession(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid session id"))
+		return
+	}
+
+	if err := e.uc.User.RevokeSession(c.Request.Context(), userID.(uint), uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Session revoked successfully!",
+	})
+}
+
+// GetEmployeeSessions godoc
+// @Summary      List an employee's sessions
+// @Description  Lists an employee's active sessions, most recently used first. Without employee:read_all only the caller and their reporting line can be viewed.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.SessionListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/sessions [get]
+func (e *rest) GetEmployeeSessions(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.checkEmployeeScope(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	sessions, err := e.uc.User.GetSessions(c.Request.Context(), uint(id))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, SessionListResponse{Data: toSessionResps(sessions, c.GetUint("sessionID"))})
+}
+
+// LogoutEmployee godoc
+// @Summary      Log an employee out everywhere
+// @Description  Ends every session of an employee, for example after a lost laptop
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/logout [post]
+func (e *rest) LogoutEmployee(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.uc.User.RevokeAllSessions(c.Request.Context(), uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee logged out of all sessions successfully!",
+	})
+}
+
+// GetJWKS godoc
+// @Summary      Public keys for access tokens
+// @Description  JSON Web Key Set other services use to verify our access tokens. Tokens name their key in the kid header; keys rotate, so refetch when a kid is unknown.
+// @Tags         Auth
+// @Produce      json
+// @Success      200 {object} handler.JWKSResponse
+// @Router       /.well-known/jwks.json [get]
+func (e *rest) GetJWKS(c *gin.Context) {
+	keys := e.uc.SigningKey.JWKS()
+
+	data := make([]JWKResp, 0, len(keys))
+	for _, k := range keys {
+		data = append(data, JWKResp{
+			Kty: k.Kty,
+			Kid: k.Kid,
+			Alg: k.Alg,
+			Use: k.Use,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		})
+	}
+
+	// Retired keys stay published until their tokens expire, so a short
+	// cache is safe as long as verifiers refetch on an unknown kid
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, JWKSResponse{Keys: data})
+}
+
+func toAuthResponse(tokens entity.AuthTokens) AuthResponse {
+	return AuthResponse{
+		Token:         tokens.AccessToken,
+		ExpiresAt:     tokens.AccessTokenExpiresAt,
+		RefreshToken:  tokens.RefreshToken,
+		RecoveryCodes: tokens.RecoveryCodes,
+
+		MustChangePassword: tokens.MustChangePassword,
+	}
+}
+
+func toSessionResps(sessions []entity.Session, currentID uint) []SessionResp {
+	data := make([]SessionResp, 0, len(sessions))
+	for _, s := range sessions {
+		data = append(data, SessionResp{
+			ID:         s.ID,
+			UserAgent:  s.UserAgent,
+			IPAddress:  s.IPAddress,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.ID == currentID,
+		})
+	}
+	return data
+}
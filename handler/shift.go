@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// CreateShift godoc
+// @Summary      Create a shift definition
+// @Description  Admin defines a shift with start, end and scheduled break. The break is unpaid even when not punched, as is break time beyond it. An end earlier than the start crosses midnight.
+// @Tags         Shift
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.CreateShiftRequest true "Shift Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/shift [post]
+func (e *rest) CreateShift(c *gin.Context) {
+	var input CreateShiftRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.Shift.CreateShift(c.Request.Context(), entity.CreateShiftRequest{
+		Name:         input.Name,
+		StartTime:    input.StartTime,
+		EndTime:      input.EndTime,
+		BreakMinutes: input.BreakMinutes,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Shift created successfully!",
+	})
+}
+
+// UpdateShift godoc
+// @Summary      Update a shift definition
+// @Description  Admin updates a shift's name, times or break
+// @Tags         Shift
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Shift ID"
+// @Param        body body handler.UpdateShiftRequest true "Shift Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/shift/{id} [put]
+func (e *rest) UpdateShift(c *gin.Context) {
+	var input UpdateShiftRequest
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid shift id"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Shift.UpdateShift(c.Request.Context(), entity.UpdateShift{
+		ID:           uint(id),
+		Name:         input.Name,
+		StartTime:    input.StartTime,
+		EndTime:      input.EndTime,
+		BreakMinutes: input.BreakMinutes,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Shift updated successfully!",
+	})
+}
+
+// GetShifts godoc
+// @Summary      List shift definitions
+// @Description  Returns all defined shifts
+// @Tags         Shift
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.ShiftListResponse
+// @Failure      500 {object} handler.ErrorResponse
+// @Router       /api/shift [get]
+func (e *rest) GetShifts(c *gin.Context) {
+	shifts, err := e.uc.Shift.GetShifts(c.Request.Context(), entity.GetShiftFilter{})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]ShiftResp, 0, len(shifts))
+	for _, s := range shifts {
+		data = append(data, toShiftResp(s))
+	}
+
+	c.JSON(http.StatusOK, ShiftListResponse{Data: data})
+}
+
+// AssignRoster godoc
+// @Summary      Assign a weekly roster
+// @Description  Admin assigns shifts to an employee for the week starting on week_start (a Monday). Replaces any existing roster for that week.
+// @Tags         Shift
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.AssignRosterRequest true "Roster Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/roster [put]
+func (e *rest) AssignRoster(c *gin.Context) {
+	var input AssignRosterRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	weekStart, err := time.Parse("2006-01-02", input.WeekStart)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid week_start"))
+		return
+	}
+
+	req := entity.AssignWeeklyRosterRequest{
+		UserID:    input.UserID,
+		WeekStart: weekStart,
+	}
+	copy(req.ShiftIDs[:], input.ShiftIDs)
+
+	err = e.uc.Shift.AssignWeeklyRoster(c.Request.Context(), req)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Roster assigned successfully!",
+	})
+}
+
+// GetRoster godoc
+// @Summary      Get an employee's roster
+// @Description  Returns rostered shifts between start_date and end_date. Employees see their own roster; managers and attendance:read_all holders can pass user_id.
+// @Tags         Shift
+// @Accept       json
+// @Produce      json
+// @Param        start_date query string true "Start date (YYYY-MM-DD)"
+// @Param        end_date query string true "End date (YYYY-MM-DD)"
+// @Param        user_id query int false "User ID, must be within your access scope"
+// @Success      200 {object} handler.RosterListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/roster [get]
+func (e *rest) GetRoster(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", c.Query("start_date"))
+	if err != nil {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", c.Query("end_date"))
+	if err != nil {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+		return
+	}
+
+	if startDate.After(endDate) {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "start date cannot be after end date"))
+		return
+	}
+
+	targetID, err := scopedUserID(c, scope)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+	if targetID == 0 {
+		targetID = userID.(uint)
+	}
+
+	rosters, err := e.uc.Shift.GetRosters(c.Request.Context(), entity.GetShiftRosterFilter{
+		UserID:    targetID,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]RosterResp, 0, len(rosters))
+	for _, r := range rosters {
+		data = append(data, RosterResp{
+			Date:  r.Date.Format("2006-01-02"),
+			Shift: toShiftResp(r.Shift),
+		})
+	}
+
+	c.JSON(http.StatusOK, RosterListResponse{
+		UserID: targetID,
+		Data:   data,
+	})
+}
+
+func toShiftResp(s entity.Shift) ShiftResp {
+	return ShiftResp{
+		ID:              s.ID,
+		Name:            s.Name,
+		StartTime:       s.StartTime,
+		EndTime:         s.EndTime,
+		BreakMinutes:    s.BreakMinutes,
+		CrossesMidnight: s.CrossesMidnight,
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// ssoBindingCookie ties a single sign-on to the browser that started it.
+const ssoBindingCookie = "sso_binding"
+
+// StartSSO godoc
+// @Summary      Sign in with the company identity provider
+// @Description  Redirects the browser to the OpenID Connect provider's sign-in page and sets the sso_binding cookie, which GET /sso/callback requires. The provider sends the browser back there. Returns 404 when single sign-on is not configured.
+// @Tags         Auth
+// @Produce      json
+// @Success      302
+// @Failure      404 {object} handler.ErrorResponse
+// @Failure      500 {object} handler.ErrorResponse
+// @Router       /sso/login [get]
+func (e *rest) StartSSO(c *gin.Context) {
+	start, err := e.uc.User.StartSSO(c.Request.Context())
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	// Lax still sends it on the provider's top-level redirect back
+	setSSOBindingCookie(c, start.Binding, int(time.Until(start.ExpiresAt).Seconds()))
+
+	c.Redirect(http.StatusFound, start.AuthURL)
+}
+
+// SSOCallback godoc
+// @Summary      Finish signing in with the identity provider
+// @Description  Where the provider sends the browser back. It has to be the browser that called GET /sso/login, holding its sso_binding cookie. The ID token is verified and its subject mapped to a user: through an earlier sign-on, or the first time through the email address, which has to be verified by the provider unless configured otherwise. Responds like POST /login, with 202 and a challenge when a second factor is due.
+// @Tags         Auth
+// @Produce      json
+// @Param        code  query string true "Authorization code"
+// @Param        state query string true "State from GET /sso/login"
+// @Success      200 {object} handler.AuthResponse
+// @Success      202 {object} handler.LoginChallengeResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /sso/callback [get]
+func (e *rest) SSOCallback(c *gin.Context) {
+	if reason := c.Query("error"); reason != "" {
+		if desc := c.Query("error_description"); desc != "" {
+			reason += ": " + desc
+		}
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "sign-in was cancelled at the identity provider: "+reason))
+		return
+	}
+
+	code, state := c.Query("code"), c.Query("state")
+	if code == "" || state == "" {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "code and state are required"))
+		return
+	}
+
+	// A missing cookie is rejected along with a wrong one
+	binding, _ := c.Cookie(ssoBindingCookie)
+
+	result, err := e.uc.User.CompleteSSO(c.Request.Context(), entity.SSOCallbackRequest{
+		Code:      code,
+		State:     state,
+		Binding:   binding,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	setSSOBindingCookie(c, "", -1)
+	renderLoginResult(c, result)
+}
+
+// setSSOBindingCookie sets the binding cookie, or deletes it when maxAge is
+// negative. It is only sent back to the /sso routes.
+func setSSOBindingCookie(c *gin.Context, value string, maxAge int) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     ssoBindingCookie,
+		Value:    value,
+		Path:     "/sso",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
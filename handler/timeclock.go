@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/pkg"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// RegisterTimeClock godoc
+// @Summary      Register a time clock
+// @Description  Admin registers a biometric time clock. The returned secret is shown only once and must be configured on the device.
+// @Tags         TimeClock
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.RegisterTimeClockRequest true "Time Clock Info"
+// @Success      200 {object} handler.RegisterTimeClockResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/timeclock [post]
+func (e *rest) RegisterTimeClock(c *gin.Context) {
+	var input RegisterTimeClockRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	clock, err := e.uc.TimeClock.RegisterTimeClock(c.Request.Context(), entity.RegisterTimeClockRequest{
+		Name:             input.Name,
+		OfficeLocationID: input.OfficeLocationID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, RegisterTimeClockResponse{
+		Success: true,
+		Message: "Time clock registered successfully!",
+		ID:      clock.ID,
+		Secret:  clock.Secret,
+	})
+}
+
+// GetTimeClocks godoc
+// @Summary      List time clocks
+// @Description  Admin lists the registered time clocks. Secrets are never returned.
+// @Tags         TimeClock
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.TimeClockListResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/timeclock [get]
+func (e *rest) GetTimeClocks(c *gin.Context) {
+	clocks, err := e.uc.TimeClock.GetTimeClocks(c.Request.Context(), entity.GetTimeClockFilter{})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]TimeClockResp, 0, len(clocks))
+	for _, t := range clocks {
+		data = append(data, TimeClockResp{
+			ID:               t.ID,
+			Name:             t.Name,
+			OfficeLocationID: t.OfficeLocationID,
+			Active:           t.Active,
+		})
+	}
+
+	c.JSON(http.StatusOK, TimeClockListResponse{Data: data})
+}
+
+// MapTimeClockEmployee godoc
+// @Summary      Map a device employee code
+// @Description  Admin links the code an employee is enrolled under on the time clocks to their user. Re-mapping a code moves it to the new user; punches already received are not reprocessed.
+// @Tags         TimeClock
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.MapTimeClockEmployeeRequest true "Employee Code Mapping"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/timeclock/employee [put]
+func (e *rest) MapTimeClockEmployee(c *gin.Context) {
+	var input MapTimeClockEmployeeRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err := e.uc.TimeClock.MapEmployee(c.Request.Context(), entity.MapTimeClockEmployeeRequest{
+		EmployeeCode: input.EmployeeCode,
+		UserID:       input.UserID,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Employee code mapped successfully!",
+	})
+}
+
+// GetPunchReconciliation godoc
+// @Summary      Time clock reconciliation
+// @Description  Admin lists punches that could not be folded into attendances, either because the employee code is not mapped or because the punch could not be paired
+// @Tags         TimeClock
+// @Accept       json
+// @Produce      json
+// @Param        time_clock_id query int false "Time clock ID"
+// @Param        status query string false "unmapped or unpaired, both when omitted"
+// @Param        start_date query string false "Start date (YYYY-MM-DD)"
+// @Param        end_date query string false "End date (YYYY-MM-DD)"
+// @Success      200 {object} handler.TimeClockPunchListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/timeclock/reconciliation [get]
+func (e *rest) GetPunchReconciliation(c *gin.Context) {
+	filter := entity.GetTimeClockPunchFilter{
+		Statuses: []entity.TimeClockPunchStatus{entity.PunchUnmapped, entity.PunchUnpaired},
+	}
+
+	if idStr := c.Query("time_clock_id"); idStr != "" {
+		id, err := strconv.Atoi(idStr)
+		if err != nil || id <= 0 {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid time_clock_id"))
+			return
+		}
+		filter.TimeClockID = uint(id)
+	}
+
+	switch status := entity.TimeClockPunchStatus(c.Query("status")); status {
+	case "":
+	case entity.PunchUnmapped, entity.PunchUnpaired:
+		filter.Statuses = []entity.TimeClockPunchStatus{status}
+	default:
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid status"))
+		return
+	}
+
+	if startStr := c.Query("start_date"); startStr != "" {
+		startDate, err := time.Parse("2006-01-02", startStr)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid start_date"))
+			return
+		}
+		filter.StartDate = &startDate
+	}
+
+	if endStr := c.Query("end_date"); endStr != "" {
+		endDate, err := time.Parse("2006-01-02", endStr)
+		if err != nil {
+			e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid end_date"))
+			return
+		}
+		// Include punches made on the end date itself
+		filter.EndDate = pkg.TimePtr(endDate.AddDate(0, 0, 1))
+	}
+
+	punches, err := e.uc.TimeClock.GetPunches(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]TimeClockPunchResp, 0, len(punches))
+	for _, p := range punches {
+		data = append(data, TimeClockPunchResp{
+			ID:           p.ID,
+			TimeClockID:  p.TimeClockID,
+			EmployeeCode: p.EmployeeCode,
+			UserID:       p.UserID,
+			PunchedAt:    p.PunchedAt,
+			Status:       string(p.Status),
+			Note:         p.Note,
+		})
+	}
+
+	c.JSON(http.StatusOK, TimeClockPunchListResponse{Data: data})
+}
+
+// IngestPunches godoc
+// @Summary      Push time clock punches
+// @Description  Called by a time clock to upload a batch of raw punches. The device authenticates with its id and secret. Punches already received are skipped, so a batch can safely be resent.
+// @Tags         TimeClock
+// @Accept       json
+// @Produce      json
+// @Param        X-Time-Clock-ID header int true "Time clock ID"
+// @Param        X-Time-Clock-Secret header string true "Time clock secret"
+// @Param        body body handler.PunchBatchRequest true "Punches"
+// @Success      200 {object} handler.PunchIngestResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /timeclock/punches [post]
+func (e *rest) IngestPunches(c *gin.Context) {
+	var input PunchBatchRequest
+
+	clockID, err := strconv.Atoi(c.GetHeader("X-Time-Clock-ID"))
+	if err != nil || clockID <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "invalid time clock credentials"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	punches := make([]entity.RawPunch, 0, len(input.Punches))
+	for _, p := range input.Punches {
+		punches = append(punches, entity.RawPunch{
+			EmployeeCode: p.EmployeeCode,
+			PunchedAt:    p.PunchedAt,
+		})
+	}
+
+	result, err := e.uc.Attendance.IngestPunches(c.Request.Context(), entity.IngestPunchesRequest{
+		TimeClockID: uint(clockID),
+		Secret:      c.GetHeader("X-Time-Clock-Secret"),
+		Punches:     punches,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, PunchIngestResponse{
+		Success:    true,
+		Received:   result.Received,
+		Applied:    result.Applied,
+		Duplicates: result.Duplicates,
+		Unmapped:   result.Unmapped,
+		Unpaired:   result.Unpaired,
+	})
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// SubmitTravelRequest godoc
+// @Summary      Submit a travel request
+// @Description  Employee requests a business trip. Once approved, business trip check-ins are accepted on the days it covers.
+// @Tags         Travel
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.TravelRequestRequest true "Travel Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/travel [post]
+func (e *rest) SubmitTravelRequest(c *gin.Context) {
+	var input TravelRequestRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	startDate, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid start_date"))
+		return
+	}
+
+	endDate, err := time.Parse("2006-01-02", input.EndDate)
+	if err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid end_date"))
+		return
+	}
+
+	err = e.uc.Travel.SubmitTravelRequest(c.Request.Context(), entity.SubmitTravelRequest{
+		UserID:      userID.(uint),
+		Destination: input.Destination,
+		Purpose:     input.Purpose,
+		StartDate:   startDate,
+		EndDate:     endDate,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Travel request submitted successfully!",
+	})
+}
+
+// GetTravelRequests godoc
+// @Summary      List travel requests
+// @Description  Employees see their own travel requests, managers also see their reports', and attendance:read_all holders see everyone
+// @Tags         Travel
+// @Accept       json
+// @Produce      json
+// @Param        status query string false "pending, approved or rejected"
+// @Param        user_id query int false "User ID, must be within your access scope"
+// @Success      200 {object} handler.TravelRequestListResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/travel [get]
+func (e *rest) GetTravelRequests(c *gin.Context) {
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	targetID, err := scopedUserID(c, scope)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	filter := entity.GetTravelRequestFilter{
+		UserID:  targetID,
+		UserIDs: scope.UserIDs,
+		Status:  c.Query("status"),
+	}
+
+	requests, err := e.uc.Travel.GetTravelRequests(c.Request.Context(), filter)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	data := make([]TravelRequestResp, 0, len(requests))
+	for _, r := range requests {
+		data = append(data, TravelRequestResp{
+			ID:          r.ID,
+			UserID:      r.UserID,
+			Destination: r.Destination,
+			Purpose:     r.Purpose,
+			StartDate:   r.StartDate.Format("2006-01-02"),
+			EndDate:     r.EndDate.Format("2006-01-02"),
+			Status:      r.Status,
+			ReviewedBy:  r.ReviewedBy,
+			ReviewedAt:  r.ReviewedAt,
+			ReviewNote:  r.ReviewNote,
+			CreatedAt:   r.CreatedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, TravelRequestListResponse{Data: data})
+}
+
+// ReviewTravelRequest godoc
+// @Summary      Approve or reject a travel request
+// @Description  Reviews a pending travel request. Nobody can review their own request, and managers can only review their reports' requests.
+// @Tags         Travel
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Travel Request ID"
+// @Param        body body handler.ReviewTravelRequestRequest true "Review Info"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/travel/{id}/review [post]
+func (e *rest) ReviewTravelRequest(c *gin.Context) {
+	var input ReviewTravelRequestRequest
+
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid travel request id"))
+		return
+	}
+
+	scope, err := e.accessScope(c, entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	requests, err := e.uc.Travel.GetTravelRequests(c.Request.Context(), entity.GetTravelRequestFilter{ID: uint(id)})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	if len(requests) == 0 {
+		e.compileError(c, x.NewWithCode(http.StatusNotFound, "travel request not found"))
+		return
+	}
+
+	// Nobody reviews their own request, whatever their scope
+	if requests[0].UserID == userID.(uint) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "you cannot review your own request"))
+		return
+	}
+
+	// Managers may only review their reports' requests
+	if !scope.All && !scope.Allows(requests[0].UserID) {
+		e.compileError(c, x.NewWithCode(http.StatusForbidden, "you can only review requests from your reports"))
+		return
+	}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	err = e.uc.Travel.ReviewTravelRequest(c.Request.Context(), entity.ReviewTravelRequest{
+		ID:         uint(id),
+		ReviewerID: userID.(uint),
+		Approve:    input.Action == "approve",
+		Note:       input.Note,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Travel request reviewed successfully!",
+	})
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	mockAccess "github.com/zuhrulumam/go-hris/mocks/usecase/access"
+	mockTravel "github.com/zuhrulumam/go-hris/mocks/usecase/travel"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewTravelRequest_OwnRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAccessUc := mockAccess.NewMockUsecaseItf(ctrl)
+	mockTravelUc := mockTravel.NewMockUsecaseItf(ctrl)
+
+	// Holding attendance:read_all does not allow approving your own trip
+	mockAccessUc.EXPECT().Scope(gomock.Any(), uint(3), string(entity.RoleHR), entity.PermAttendanceReadAll, entity.PermAttendanceReadTeam).
+		Return(entity.AccessScope{All: true}, nil)
+	mockTravelUc.EXPECT().GetTravelRequests(gomock.Any(), entity.GetTravelRequestFilter{ID: 4}).
+		Return([]entity.TravelRequest{{ID: 4, UserID: 3}}, nil)
+
+	r, e := newTestRouter(&usecase.Usecase{Access: mockAccessUc, Travel: mockTravelUc}, 3, string(entity.RoleHR))
+	r.POST("/api/travel/:id/review", e.ReviewTravelRequest)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/travel/4/review", strings.NewReader(`{"action":"approve"}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, decodeError(t, w).DebugError, "you cannot review your own request")
+}
@@ -0,0 +1,277 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+)
+
+// VerifyLogin godoc
+// @Summary      Complete a login with a second factor
+// @Description  Answers the challenge from POST /login with a code from the authenticator app or a recovery code. When the login also completed enrolment, the response carries the user's recovery codes. A challenge allows 5 wrong codes.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.VerifyLoginRequest true "Challenge and code"
+// @Success      200 {object} handler.AuthResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /login/2fa [post]
+func (e *rest) VerifyLogin(c *gin.Context) {
+	var input VerifyLoginRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	tokens, err := e.uc.User.VerifyLogin(c.Request.Context(), entity.VerifyLoginRequest{
+		ChallengeToken: input.ChallengeToken,
+		Code:           input.Code,
+	})
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAuthResponse(tokens))
+}
+
+// EnrollLoginTwoFactor godoc
+// @Summary      Set up an authenticator while logging in
+// @Description  For logins that returned enrollment_required. Returns a TOTP secret and an otpauth:// URI to show as a QR code; send a code from the app to POST /login/2fa to finish.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.LoginChallengeRequest true "Challenge"
+// @Success      200 {object} handler.TwoFactorEnrollmentResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      409 {object} handler.ErrorResponse
+// @Router       /login/2fa/enroll [post]
+func (e *rest) EnrollLoginTwoFactor(c *gin.Context) {
+	var input LoginChallengeRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	enrollment, err := e.uc.User.EnrollLoginTwoFactor(c.Request.Context(), input.ChallengeToken)
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTwoFactorEnrollmentResponse(enrollment))
+}
+
+// GetTwoFactorStatus godoc
+// @Summary      My two-factor settings
+// @Description  Whether two-factor authentication is enabled or required for the current user, and how many recovery codes are left
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.TwoFactorStatusResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/2fa [get]
+func (e *rest) GetTwoFactorStatus(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	status, err := e.uc.User.GetTwoFactorStatus(c.Request.Context(), userID.(uint))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, TwoFactorStatusResponse{
+		Enabled:           status.Enabled,
+		Pending:           status.Pending,
+		Required:          status.Required,
+		RecoveryCodesLeft: status.RecoveryCodesLeft,
+	})
+}
+
+// EnrollTwoFactor godoc
+// @Summary      Set up an authenticator
+// @Description  Returns a new TOTP secret and an otpauth:// URI to show as a QR code. Two-factor authentication is enabled once POST /api/2fa/confirm sees a code from the app.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} handler.TwoFactorEnrollmentResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      409 {object} handler.ErrorResponse
+// @Router       /api/2fa/enroll [post]
+func (e *rest) EnrollTwoFactor(c *gin.Context) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	enrollment, err := e.uc.User.EnrollTwoFactor(c.Request.Context(), userID.(uint))
+	if err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTwoFactorEnrollmentResponse(enrollment))
+}
+
+// ConfirmTwoFactor godoc
+// @Summary      Enable two-factor authentication
+// @Description  Confirms the authenticator with its first code and returns recovery codes. They are only shown once.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.TwoFactorCodeRequest true "Code from the authenticator"
+// @Success      200 {object} handler.RecoveryCodesResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      409 {object} handler.ErrorResponse
+// @Router       /api/2fa/confirm [post]
+func (e *rest) ConfirmTwoFactor(c *gin.Context) {
+	e.withTwoFactorCode(c, func(userID uint, code string) {
+		codes, err := e.uc.User.ConfirmTwoFactor(c.Request.Context(), userID, code)
+		if err != nil {
+			e.compileError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+	})
+}
+
+// RegenerateRecoveryCodes godoc
+// @Summary      New recovery codes
+// @Description  Replaces all recovery codes, used or not. Needs a current code from the authenticator or a recovery code.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.TwoFactorCodeRequest true "Current code"
+// @Success      200 {object} handler.RecoveryCodesResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Router       /api/2fa/recovery-codes [post]
+func (e *rest) RegenerateRecoveryCodes(c *gin.Context) {
+	e.withTwoFactorCode(c, func(userID uint, code string) {
+		codes, err := e.uc.User.RegenerateRecoveryCodes(c.Request.Context(), userID, code)
+		if err != nil {
+			e.compileError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+	})
+}
+
+// DisableTwoFactor godoc
+// @Summary      Disable two-factor authentication
+// @Description  Removes the authenticator and recovery codes. Not allowed for roles that require two-factor authentication.
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Param        body body handler.TwoFactorCodeRequest true "Current code"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Router       /api/2fa/disable [post]
+func (e *rest) DisableTwoFactor(c *gin.Context) {
+	e.withTwoFactorCode(c, func(userID uint, code string) {
+		if err := e.uc.User.DisableTwoFactor(c.Request.Context(), userID, code); err != nil {
+			e.compileError(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, GenericResponse{
+			Success: true,
+			Message: "Two-factor authentication disabled successfully!",
+		})
+	})
+}
+
+// ResetEmployeeTwoFactor godoc
+// @Summary      Reset an employee's two-factor authentication
+// @Description  Removes an employee's authenticator and recovery codes when both are lost. If their role requires two-factor authentication they set it up again at their next login. Only admins can reset admin accounts, and accounts whose role grants permissions you lack need role:manage.
+// @Tags         Employee
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Employee ID"
+// @Success      200 {object} handler.GenericResponse
+// @Failure      400 {object} handler.ErrorResponse
+// @Failure      401 {object} handler.ErrorResponse
+// @Failure      403 {object} handler.ErrorResponse
+// @Failure      404 {object} handler.ErrorResponse
+// @Router       /api/employee/{id}/2fa [delete]
+func (e *rest) ResetEmployeeTwoFactor(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		e.compileError(c, x.NewWithCode(http.StatusBadRequest, "invalid employee id"))
+		return
+	}
+
+	if err := e.checkTargetAccount(c, uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	if err := e.uc.User.ResetTwoFactor(c.Request.Context(), uint(id)); err != nil {
+		e.compileError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, GenericResponse{
+		Success: true,
+		Message: "Two-factor authentication reset successfully!",
+	})
+}
+
+// withTwoFactorCode reads the current user and the code from the body
+// before handing over to next.
+func (e *rest) withTwoFactorCode(c *gin.Context, next func(userID uint, code string)) {
+	userID, ok := c.Get("userID")
+	if !ok {
+		e.compileError(c, x.NewWithCode(http.StatusUnauthorized, "missing user context"))
+		return
+	}
+
+	var input TwoFactorCodeRequest
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "invalid input"))
+		return
+	}
+
+	if err := validate.Struct(input); err != nil {
+		e.compileError(c, x.WrapWithCode(err, http.StatusBadRequest, "failed validation"))
+		return
+	}
+
+	next(userID.(uint), input.Code)
+}
+
+func toTwoFactorEnrollmentResponse(enrollment entity.TwoFactorEnrollment) TwoFactorEnrollmentResponse {
+	return TwoFactorEnrollmentResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningURI: enrollment.ProvisioningURI,
+	}
+}
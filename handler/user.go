@@ -17,6 +17,7 @@ import (
 // @Param        registerRequest  body      RegisterRequest  true  "Register payload"
 // @Success      201              {object}  map[string]string "User registered successfully"
 // @Failure      400              {object}  map[string]string "Invalid input"
+// @Failure      403              {object}  map[string]string "Self-registration is disabled"
 // @Failure      500              {object}  map[string]string "Internal server error"
 // @Router       /auth/register [post]
 func (r *rest) Register(c *gin.Context) {
@@ -30,6 +31,7 @@ func (r *rest) Register(c *gin.Context) {
 		Username: req.Username,
 		Password: req.Password,
 		FullName: req.Fullname,
+		Email:    req.Email,
 		Salary:   req.Salary,
 	})
 	if err != nil {
@@ -42,14 +44,17 @@ func (r *rest) Register(c *gin.Context) {
 
 // Login godoc
 // @Summary      Login user and get JWT token
-// @Description  Authenticate user and return access token
+// @Description  Authenticate user and start a session. Returns a short-lived access token and a refresh token for POST /refresh. Users with two-factor authentication, or whose role requires it, get 202 with a challenge to complete at POST /login/2fa instead. Repeated failures slow down further attempts and then lock the username or IP address out for a while.
 // @Tags         Auth
 // @Accept       json
 // @Produce      json
 // @Param        loginRequest  body      LoginRequest  true  "Login payload"
 // @Success      200           {object}  AuthResponse
+// @Success      202           {object}  LoginChallengeResponse
 // @Failure      400           {object}  map[string]string "Invalid input"
 // @Failure      401           {object}  map[string]string "Unauthorized"
+// @Failure      403           {object}  map[string]string "Account is deactivated"
+// @Failure      429           {object}  map[string]string "Locked out after too many failed attempts"
 // @Failure      500           {object}  map[string]string "Internal server error"
 // @Router       /auth/login [post]
 func (r *rest) Login(c *gin.Context) {
@@ -59,14 +64,32 @@ func (r *rest) Login(c *gin.Context) {
 		return
 	}
 
-	token, err := r.uc.User.Login(c.Request.Context(), entity.LoginRequest{
-		Username: req.Username,
-		Password: req.Password,
+	result, err := r.uc.User.Login(c.Request.Context(), entity.LoginRequest{
+		Username:  req.Username,
+		Password:  req.Password,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
 	})
 	if err != nil {
 		r.compileError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, AuthResponse{Token: token})
+	renderLoginResult(c, result)
+}
+
+// renderLoginResult answers a first-factor login with tokens, or with 202
+// and the challenge when a second factor is due.
+func renderLoginResult(c *gin.Context, result entity.LoginResult) {
+	if result.Challenge != nil {
+		c.JSON(http.StatusAccepted, LoginChallengeResponse{
+			TwoFactorRequired:  true,
+			EnrollmentRequired: result.Challenge.EnrollmentRequired,
+			ChallengeToken:     result.Challenge.Token,
+			ExpiresAt:          result.Challenge.ExpiresAt,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toAuthResponse(*result.Tokens))
 }
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/business/entity"
+	"github.com/zuhrulumam/go-hris/business/usecase"
+	mockUser "github.com/zuhrulumam/go-hris/mocks/usecase/user"
+	x "github.com/zuhrulumam/go-hris/pkg/errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLogin(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		setupMocks   func(u *mockUser.MockUsecaseItf)
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name: "success",
+			body: `{"username":"jdoe","password":"secret"}`,
+			setupMocks: func(u *mockUser.MockUsecaseItf) {
+				u.EXPECT().Login(gomock.Any(), gomock.Any()).
+					Return(entity.LoginResult{Tokens: &entity.AuthTokens{AccessToken: "access", RefreshToken: "refresh", SessionID: 7}}, nil)
+			},
+			expectStatus: http.StatusOK,
+		},
+		{
+			name: "wrong password",
+			body: `{"username":"jdoe","password":"wrong"}`,
+			setupMocks: func(u *mockUser.MockUsecaseItf) {
+				u.EXPECT().Login(gomock.Any(), gomock.Any()).
+					Return(entity.LoginResult{}, x.NewWithCode(http.StatusUnauthorized, "invalid username or password"))
+			},
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "invalid username or password",
+		},
+		{
+			name: "locked account",
+			body: `{"username":"jdoe","password":"secret"}`,
+			setupMocks: func(u *mockUser.MockUsecaseItf) {
+				u.EXPECT().Login(gomock.Any(), entity.LoginRequest{Username: "jdoe", Password: "secret", IPAddress: "192.0.2.1"}).
+					Return(entity.LoginResult{}, x.NewWithCode(http.StatusTooManyRequests, "too many failed login attempts, try again after 2025-06-02T09:15:00Z"))
+			},
+			expectStatus: http.StatusTooManyRequests,
+			expectError:  "too many failed login attempts",
+		},
+		{
+			name:         "invalid body",
+			body:         `{`,
+			setupMocks:   func(u *mockUser.MockUsecaseItf) {},
+			expectStatus: http.StatusBadRequest,
+			expectError:  "invalid input",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserUc := mockUser.NewMockUsecaseItf(ctrl)
+			tt.setupMocks(mockUserUc)
+
+			r, e := newTestRouter(&usecase.Usecase{User: mockUserUc}, 0, "")
+			r.POST("/login", e.Login)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.RemoteAddr = "192.0.2.1:1234"
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, decodeError(t, w).DebugError, tt.expectError)
+			}
+		})
+	}
+}
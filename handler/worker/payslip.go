@@ -8,11 +8,13 @@ import (
 	"github.com/hibiken/asynq"
 	"github.com/zuhrulumam/go-hris/business/entity"
 	"github.com/zuhrulumam/go-hris/business/usecase/payslip"
+	"github.com/zuhrulumam/go-hris/business/usecase/user"
 	"github.com/zuhrulumam/go-hris/task"
 )
 
 type Handler struct {
 	Payslip payslip.UsecaseItf
+	User    user.UsecaseItf
 }
 
 func (h *Handler) HandleCreatePayrollTask(ctx context.Context, t *asynq.Task) error {
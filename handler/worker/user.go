@@ -0,0 +1,18 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hibiken/asynq"
+	"github.com/zuhrulumam/go-hris/task"
+)
+
+func (h *Handler) HandleSendPasswordResetTask(ctx context.Context, t *asynq.Task) error {
+	var payload task.SendPasswordResetPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return err
+	}
+
+	return h.User.SendPasswordResetEmail(ctx, payload.Login)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/access/access.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/access/access.go -destination=mocks/domain/access/mock_access.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// GetRolePermissions mocks base method.
+func (m *MockDomainItf) GetRolePermissions(ctx context.Context, role entity.UserRole) ([]entity.RolePermission, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRolePermissions", ctx, role)
+	ret0, _ := ret[0].([]entity.RolePermission)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRolePermissions indicates an expected call of GetRolePermissions.
+func (mr *MockDomainItfMockRecorder) GetRolePermissions(ctx, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRolePermissions", reflect.TypeOf((*MockDomainItf)(nil).GetRolePermissions), ctx, role)
+}
+
+// GetRoles mocks base method.
+func (m *MockDomainItf) GetRoles(ctx context.Context) ([]entity.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockDomainItfMockRecorder) GetRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockDomainItf)(nil).GetRoles), ctx)
+}
+
+// ReplaceRolePermissions mocks base method.
+func (m *MockDomainItf) ReplaceRolePermissions(ctx context.Context, role entity.UserRole, permissions []entity.Permission) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRolePermissions", ctx, role, permissions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceRolePermissions indicates an expected call of ReplaceRolePermissions.
+func (mr *MockDomainItfMockRecorder) ReplaceRolePermissions(ctx, role, permissions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRolePermissions", reflect.TypeOf((*MockDomainItf)(nil).ReplaceRolePermissions), ctx, role, permissions)
+}
@@ -55,6 +55,34 @@ func (mr *MockDomainItfMockRecorder) CreateAttendance(ctx, data any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendance", reflect.TypeOf((*MockDomainItf)(nil).CreateAttendance), ctx, data)
 }
 
+// CreateAttendanceBreak mocks base method.
+func (m *MockDomainItf) CreateAttendanceBreak(ctx context.Context, data entity.AttendanceBreak) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttendanceBreak", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttendanceBreak indicates an expected call of CreateAttendanceBreak.
+func (mr *MockDomainItfMockRecorder) CreateAttendanceBreak(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendanceBreak", reflect.TypeOf((*MockDomainItf)(nil).CreateAttendanceBreak), ctx, data)
+}
+
+// CreateAttendanceCorrection mocks base method.
+func (m *MockDomainItf) CreateAttendanceCorrection(ctx context.Context, data entity.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttendanceCorrection", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttendanceCorrection indicates an expected call of CreateAttendanceCorrection.
+func (mr *MockDomainItfMockRecorder) CreateAttendanceCorrection(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendanceCorrection", reflect.TypeOf((*MockDomainItf)(nil).CreateAttendanceCorrection), ctx, data)
+}
+
 // CreateAttendancePeriod mocks base method.
 func (m *MockDomainItf) CreateAttendancePeriod(ctx context.Context, data entity.AttendancePeriod) error {
 	m.ctrl.T.Helper()
@@ -69,6 +97,20 @@ func (mr *MockDomainItfMockRecorder) CreateAttendancePeriod(ctx, data any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendancePeriod", reflect.TypeOf((*MockDomainItf)(nil).CreateAttendancePeriod), ctx, data)
 }
 
+// CreateAttendancePeriodTransition mocks base method.
+func (m *MockDomainItf) CreateAttendancePeriodTransition(ctx context.Context, data entity.AttendancePeriodTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttendancePeriodTransition", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttendancePeriodTransition indicates an expected call of CreateAttendancePeriodTransition.
+func (mr *MockDomainItfMockRecorder) CreateAttendancePeriodTransition(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendancePeriodTransition", reflect.TypeOf((*MockDomainItf)(nil).CreateAttendancePeriodTransition), ctx, data)
+}
+
 // CreateOvertime mocks base method.
 func (m *MockDomainItf) CreateOvertime(ctx context.Context, data entity.CreateOvertimeData) error {
 	m.ctrl.T.Helper()
@@ -98,6 +140,51 @@ func (mr *MockDomainItfMockRecorder) GetAttendance(ctx, filter any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockDomainItf)(nil).GetAttendance), ctx, filter)
 }
 
+// GetAttendanceBreaks mocks base method.
+func (m *MockDomainItf) GetAttendanceBreaks(ctx context.Context, filter entity.GetAttendanceBreakFilter) ([]entity.AttendanceBreak, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceBreaks", ctx, filter)
+	ret0, _ := ret[0].([]entity.AttendanceBreak)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceBreaks indicates an expected call of GetAttendanceBreaks.
+func (mr *MockDomainItfMockRecorder) GetAttendanceBreaks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceBreaks", reflect.TypeOf((*MockDomainItf)(nil).GetAttendanceBreaks), ctx, filter)
+}
+
+// GetAttendanceCorrections mocks base method.
+func (m *MockDomainItf) GetAttendanceCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceCorrections", ctx, filter)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceCorrections indicates an expected call of GetAttendanceCorrections.
+func (mr *MockDomainItfMockRecorder) GetAttendanceCorrections(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceCorrections", reflect.TypeOf((*MockDomainItf)(nil).GetAttendanceCorrections), ctx, filter)
+}
+
+// GetAttendancePeriodTransitions mocks base method.
+func (m *MockDomainItf) GetAttendancePeriodTransitions(ctx context.Context, filter entity.GetAttendancePeriodTransitionFilter) ([]entity.AttendancePeriodTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancePeriodTransitions", ctx, filter)
+	ret0, _ := ret[0].([]entity.AttendancePeriodTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendancePeriodTransitions indicates an expected call of GetAttendancePeriodTransitions.
+func (mr *MockDomainItfMockRecorder) GetAttendancePeriodTransitions(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePeriodTransitions", reflect.TypeOf((*MockDomainItf)(nil).GetAttendancePeriodTransitions), ctx, filter)
+}
+
 // GetAttendancePeriods mocks base method.
 func (m *MockDomainItf) GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +215,21 @@ func (mr *MockDomainItfMockRecorder) GetOvertime(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertime", reflect.TypeOf((*MockDomainItf)(nil).GetOvertime), ctx, filter)
 }
 
+// GetWorkModeSummary mocks base method.
+func (m *MockDomainItf) GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkModeSummary", ctx, filter)
+	ret0, _ := ret[0].([]entity.WorkModeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkModeSummary indicates an expected call of GetWorkModeSummary.
+func (mr *MockDomainItfMockRecorder) GetWorkModeSummary(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkModeSummary", reflect.TypeOf((*MockDomainItf)(nil).GetWorkModeSummary), ctx, filter)
+}
+
 // UpdateAttendance mocks base method.
 func (m *MockDomainItf) UpdateAttendance(ctx context.Context, data entity.UpdateAttendance) error {
 	m.ctrl.T.Helper()
@@ -142,6 +244,34 @@ func (mr *MockDomainItfMockRecorder) UpdateAttendance(ctx, data any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendance", reflect.TypeOf((*MockDomainItf)(nil).UpdateAttendance), ctx, data)
 }
 
+// UpdateAttendanceBreak mocks base method.
+func (m *MockDomainItf) UpdateAttendanceBreak(ctx context.Context, data entity.UpdateAttendanceBreak) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceBreak", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceBreak indicates an expected call of UpdateAttendanceBreak.
+func (mr *MockDomainItfMockRecorder) UpdateAttendanceBreak(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceBreak", reflect.TypeOf((*MockDomainItf)(nil).UpdateAttendanceBreak), ctx, data)
+}
+
+// UpdateAttendanceCorrection mocks base method.
+func (m *MockDomainItf) UpdateAttendanceCorrection(ctx context.Context, data entity.UpdateAttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceCorrection", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceCorrection indicates an expected call of UpdateAttendanceCorrection.
+func (mr *MockDomainItfMockRecorder) UpdateAttendanceCorrection(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceCorrection", reflect.TypeOf((*MockDomainItf)(nil).UpdateAttendanceCorrection), ctx, data)
+}
+
 // UpdateAttendancePeriod mocks base method.
 func (m *MockDomainItf) UpdateAttendancePeriod(ctx context.Context, data entity.UpdateAttendancePeriod) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/device/device.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/device/device.go -destination=mocks/domain/device/mock_device.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateDevice mocks base method.
+func (m *MockDomainItf) CreateDevice(ctx context.Context, data entity.MobileDevice) (*entity.MobileDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDevice", ctx, data)
+	ret0, _ := ret[0].(*entity.MobileDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDevice indicates an expected call of CreateDevice.
+func (mr *MockDomainItfMockRecorder) CreateDevice(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockDomainItf)(nil).CreateDevice), ctx, data)
+}
+
+// CreateSyncEvent mocks base method.
+func (m *MockDomainItf) CreateSyncEvent(ctx context.Context, data entity.SyncEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSyncEvent", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSyncEvent indicates an expected call of CreateSyncEvent.
+func (mr *MockDomainItfMockRecorder) CreateSyncEvent(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSyncEvent", reflect.TypeOf((*MockDomainItf)(nil).CreateSyncEvent), ctx, data)
+}
+
+// GetDevices mocks base method.
+func (m *MockDomainItf) GetDevices(ctx context.Context, filter entity.GetDeviceFilter) ([]entity.MobileDevice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", ctx, filter)
+	ret0, _ := ret[0].([]entity.MobileDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockDomainItfMockRecorder) GetDevices(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDomainItf)(nil).GetDevices), ctx, filter)
+}
+
+// GetSyncEvents mocks base method.
+func (m *MockDomainItf) GetSyncEvents(ctx context.Context, filter entity.GetSyncEventFilter) ([]entity.SyncEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncEvents", ctx, filter)
+	ret0, _ := ret[0].([]entity.SyncEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncEvents indicates an expected call of GetSyncEvents.
+func (mr *MockDomainItfMockRecorder) GetSyncEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncEvents", reflect.TypeOf((*MockDomainItf)(nil).GetSyncEvents), ctx, filter)
+}
+
+// UpdateDevice mocks base method.
+func (m *MockDomainItf) UpdateDevice(ctx context.Context, data entity.UpdateDevice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDevice", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDevice indicates an expected call of UpdateDevice.
+func (mr *MockDomainItfMockRecorder) UpdateDevice(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDevice", reflect.TypeOf((*MockDomainItf)(nil).UpdateDevice), ctx, data)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/holiday/holiday.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/holiday/holiday.go -destination=mocks/domain/holiday/mock_holiday.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateHoliday mocks base method.
+func (m *MockDomainItf) CreateHoliday(ctx context.Context, data entity.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoliday", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateHoliday indicates an expected call of CreateHoliday.
+func (mr *MockDomainItfMockRecorder) CreateHoliday(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoliday", reflect.TypeOf((*MockDomainItf)(nil).CreateHoliday), ctx, data)
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockDomainItf) DeleteHoliday(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockDomainItfMockRecorder) DeleteHoliday(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockDomainItf)(nil).DeleteHoliday), ctx, id)
+}
+
+// GetHolidays mocks base method.
+func (m *MockDomainItf) GetHolidays(ctx context.Context, filter entity.GetHolidayFilter) ([]entity.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHolidays", ctx, filter)
+	ret0, _ := ret[0].([]entity.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHolidays indicates an expected call of GetHolidays.
+func (mr *MockDomainItfMockRecorder) GetHolidays(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHolidays", reflect.TypeOf((*MockDomainItf)(nil).GetHolidays), ctx, filter)
+}
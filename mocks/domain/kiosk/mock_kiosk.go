@@ -0,0 +1,115 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/kiosk/kiosk.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/kiosk/kiosk.go -destination=mocks/domain/kiosk/mock_kiosk.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CountKioskScans mocks base method.
+func (m *MockDomainItf) CountKioskScans(ctx context.Context, filter entity.GetKioskScanFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountKioskScans", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountKioskScans indicates an expected call of CountKioskScans.
+func (mr *MockDomainItfMockRecorder) CountKioskScans(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountKioskScans", reflect.TypeOf((*MockDomainItf)(nil).CountKioskScans), ctx, filter)
+}
+
+// CreateKiosk mocks base method.
+func (m *MockDomainItf) CreateKiosk(ctx context.Context, data entity.Kiosk) (*entity.Kiosk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKiosk", ctx, data)
+	ret0, _ := ret[0].(*entity.Kiosk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKiosk indicates an expected call of CreateKiosk.
+func (mr *MockDomainItfMockRecorder) CreateKiosk(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKiosk", reflect.TypeOf((*MockDomainItf)(nil).CreateKiosk), ctx, data)
+}
+
+// CreateKioskScan mocks base method.
+func (m *MockDomainItf) CreateKioskScan(ctx context.Context, data entity.KioskScan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKioskScan", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateKioskScan indicates an expected call of CreateKioskScan.
+func (mr *MockDomainItfMockRecorder) CreateKioskScan(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKioskScan", reflect.TypeOf((*MockDomainItf)(nil).CreateKioskScan), ctx, data)
+}
+
+// GetKiosks mocks base method.
+func (m *MockDomainItf) GetKiosks(ctx context.Context, filter entity.GetKioskFilter) ([]entity.Kiosk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKiosks", ctx, filter)
+	ret0, _ := ret[0].([]entity.Kiosk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKiosks indicates an expected call of GetKiosks.
+func (mr *MockDomainItfMockRecorder) GetKiosks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKiosks", reflect.TypeOf((*MockDomainItf)(nil).GetKiosks), ctx, filter)
+}
+
+// UpdateKiosk mocks base method.
+func (m *MockDomainItf) UpdateKiosk(ctx context.Context, data entity.UpdateKiosk) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKiosk", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKiosk indicates an expected call of UpdateKiosk.
+func (mr *MockDomainItfMockRecorder) UpdateKiosk(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKiosk", reflect.TypeOf((*MockDomainItf)(nil).UpdateKiosk), ctx, data)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/location/location.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/location/location.go -destination=mocks/domain/location/mock_location.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateOfficeLocation mocks base method.
+func (m *MockDomainItf) CreateOfficeLocation(ctx context.Context, data entity.OfficeLocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOfficeLocation", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOfficeLocation indicates an expected call of CreateOfficeLocation.
+func (mr *MockDomainItfMockRecorder) CreateOfficeLocation(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOfficeLocation", reflect.TypeOf((*MockDomainItf)(nil).CreateOfficeLocation), ctx, data)
+}
+
+// GetOfficeLocations mocks base method.
+func (m *MockDomainItf) GetOfficeLocations(ctx context.Context, filter entity.GetOfficeLocationFilter) ([]entity.OfficeLocation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOfficeLocations", ctx, filter)
+	ret0, _ := ret[0].([]entity.OfficeLocation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOfficeLocations indicates an expected call of GetOfficeLocations.
+func (mr *MockDomainItfMockRecorder) GetOfficeLocations(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOfficeLocations", reflect.TypeOf((*MockDomainItf)(nil).GetOfficeLocations), ctx, filter)
+}
+
+// UpdateOfficeLocation mocks base method.
+func (m *MockDomainItf) UpdateOfficeLocation(ctx context.Context, data entity.UpdateOfficeLocation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOfficeLocation", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOfficeLocation indicates an expected call of UpdateOfficeLocation.
+func (mr *MockDomainItfMockRecorder) UpdateOfficeLocation(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOfficeLocation", reflect.TypeOf((*MockDomainItf)(nil).UpdateOfficeLocation), ctx, data)
+}
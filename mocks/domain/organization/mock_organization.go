@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/organization/organization.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/organization/organization.go -destination=mocks/domain/organization/mock_organization.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateDepartment mocks base method.
+func (m *MockDomainItf) CreateDepartment(ctx context.Context, data entity.Department) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDepartment", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDepartment indicates an expected call of CreateDepartment.
+func (mr *MockDomainItfMockRecorder) CreateDepartment(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDepartment", reflect.TypeOf((*MockDomainItf)(nil).CreateDepartment), ctx, data)
+}
+
+// CreatePosition mocks base method.
+func (m *MockDomainItf) CreatePosition(ctx context.Context, data entity.Position) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePosition", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePosition indicates an expected call of CreatePosition.
+func (mr *MockDomainItfMockRecorder) CreatePosition(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosition", reflect.TypeOf((*MockDomainItf)(nil).CreatePosition), ctx, data)
+}
+
+// GetDepartments mocks base method.
+func (m *MockDomainItf) GetDepartments(ctx context.Context, filter entity.GetDepartmentFilter) ([]entity.Department, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDepartments", ctx, filter)
+	ret0, _ := ret[0].([]entity.Department)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDepartments indicates an expected call of GetDepartments.
+func (mr *MockDomainItfMockRecorder) GetDepartments(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepartments", reflect.TypeOf((*MockDomainItf)(nil).GetDepartments), ctx, filter)
+}
+
+// GetPositions mocks base method.
+func (m *MockDomainItf) GetPositions(ctx context.Context, filter entity.GetPositionFilter) ([]entity.Position, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPositions", ctx, filter)
+	ret0, _ := ret[0].([]entity.Position)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPositions indicates an expected call of GetPositions.
+func (mr *MockDomainItfMockRecorder) GetPositions(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPositions", reflect.TypeOf((*MockDomainItf)(nil).GetPositions), ctx, filter)
+}
+
+// UpdateDepartment mocks base method.
+func (m *MockDomainItf) UpdateDepartment(ctx context.Context, data entity.UpdateDepartment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDepartment", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDepartment indicates an expected call of UpdateDepartment.
+func (mr *MockDomainItfMockRecorder) UpdateDepartment(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDepartment", reflect.TypeOf((*MockDomainItf)(nil).UpdateDepartment), ctx, data)
+}
+
+// UpdatePosition mocks base method.
+func (m *MockDomainItf) UpdatePosition(ctx context.Context, data entity.UpdatePosition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePosition", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePosition indicates an expected call of UpdatePosition.
+func (mr *MockDomainItfMockRecorder) UpdatePosition(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePosition", reflect.TypeOf((*MockDomainItf)(nil).UpdatePosition), ctx, data)
+}
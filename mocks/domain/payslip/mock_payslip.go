@@ -102,19 +102,19 @@ func (mr *MockDomainItfMockRecorder) GetPayslip(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslip", reflect.TypeOf((*MockDomainItf)(nil).GetPayslip), ctx, filter)
 }
 
-// IsPayrollExists mocks base method.
-func (m *MockDomainItf) IsPayrollExists(ctx context.Context, periodID uint) (bool, error) {
+// GetPayslipComponents mocks base method.
+func (m *MockDomainItf) GetPayslipComponents(ctx context.Context, periodIDs []uint) ([]entity.PayslipComponentItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsPayrollExists", ctx, periodID)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "GetPayslipComponents", ctx, periodIDs)
+	ret0, _ := ret[0].([]entity.PayslipComponentItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// IsPayrollExists indicates an expected call of IsPayrollExists.
-func (mr *MockDomainItfMockRecorder) IsPayrollExists(ctx, periodID any) *gomock.Call {
+// GetPayslipComponents indicates an expected call of GetPayslipComponents.
+func (mr *MockDomainItfMockRecorder) GetPayslipComponents(ctx, periodIDs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsPayrollExists", reflect.TypeOf((*MockDomainItf)(nil).IsPayrollExists), ctx, periodID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPayslipComponents", reflect.TypeOf((*MockDomainItf)(nil).GetPayslipComponents), ctx, periodIDs)
 }
 
 // UpdatePayslipJob mocks base method.
@@ -130,3 +130,17 @@ func (mr *MockDomainItfMockRecorder) UpdatePayslipJob(ctx, data any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayslipJob", reflect.TypeOf((*MockDomainItf)(nil).UpdatePayslipJob), ctx, data)
 }
+
+// VoidPayslips mocks base method.
+func (m *MockDomainItf) VoidPayslips(ctx context.Context, periodID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidPayslips", ctx, periodID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidPayslips indicates an expected call of VoidPayslips.
+func (mr *MockDomainItfMockRecorder) VoidPayslips(ctx, periodID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidPayslips", reflect.TypeOf((*MockDomainItf)(nil).VoidPayslips), ctx, periodID, userID)
+}
@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/security/security.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/security/security.go -destination=mocks/domain/security/mock_security.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// ClearFailures mocks base method.
+func (m *MockDomainItf) ClearFailures(ctx context.Context, subject string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearFailures", ctx, subject)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearFailures indicates an expected call of ClearFailures.
+func (mr *MockDomainItfMockRecorder) ClearFailures(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearFailures", reflect.TypeOf((*MockDomainItf)(nil).ClearFailures), ctx, subject)
+}
+
+// CreateSecurityEvent mocks base method.
+func (m *MockDomainItf) CreateSecurityEvent(ctx context.Context, data entity.SecurityEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityEvent", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSecurityEvent indicates an expected call of CreateSecurityEvent.
+func (mr *MockDomainItfMockRecorder) CreateSecurityEvent(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityEvent", reflect.TypeOf((*MockDomainItf)(nil).CreateSecurityEvent), ctx, data)
+}
+
+// GetFailures mocks base method.
+func (m *MockDomainItf) GetFailures(ctx context.Context, subject string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFailures", ctx, subject)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFailures indicates an expected call of GetFailures.
+func (mr *MockDomainItfMockRecorder) GetFailures(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailures", reflect.TypeOf((*MockDomainItf)(nil).GetFailures), ctx, subject)
+}
+
+// GetSecurityEvents mocks base method.
+func (m *MockDomainItf) GetSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecurityEvents", ctx, filter)
+	ret0, _ := ret[0].([]entity.SecurityEvent)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetSecurityEvents indicates an expected call of GetSecurityEvents.
+func (mr *MockDomainItfMockRecorder) GetSecurityEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityEvents", reflect.TypeOf((*MockDomainItf)(nil).GetSecurityEvents), ctx, filter)
+}
+
+// Lock mocks base method.
+func (m *MockDomainItf) Lock(ctx context.Context, subject string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, subject, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockDomainItfMockRecorder) Lock(ctx, subject, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockDomainItf)(nil).Lock), ctx, subject, until)
+}
+
+// LockedUntil mocks base method.
+func (m *MockDomainItf) LockedUntil(ctx context.Context, subject string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedUntil", ctx, subject)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockedUntil indicates an expected call of LockedUntil.
+func (mr *MockDomainItfMockRecorder) LockedUntil(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedUntil", reflect.TypeOf((*MockDomainItf)(nil).LockedUntil), ctx, subject)
+}
+
+// RecordFailure mocks base method.
+func (m *MockDomainItf) RecordFailure(ctx context.Context, subject string, window time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFailure", ctx, subject, window)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFailure indicates an expected call of RecordFailure.
+func (mr *MockDomainItfMockRecorder) RecordFailure(ctx, subject, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFailure", reflect.TypeOf((*MockDomainItf)(nil).RecordFailure), ctx, subject, window)
+}
+
+// Unlock mocks base method.
+func (m *MockDomainItf) Unlock(ctx context.Context, subject string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, subject)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockDomainItfMockRecorder) Unlock(ctx, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockDomainItf)(nil).Unlock), ctx, subject)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/shift/shift.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/shift/shift.go -destination=mocks/domain/shift/mock_shift.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateRosters mocks base method.
+func (m *MockDomainItf) CreateRosters(ctx context.Context, rosters []entity.ShiftRoster) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRosters", ctx, rosters)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRosters indicates an expected call of CreateRosters.
+func (mr *MockDomainItfMockRecorder) CreateRosters(ctx, rosters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRosters", reflect.TypeOf((*MockDomainItf)(nil).CreateRosters), ctx, rosters)
+}
+
+// CreateShift mocks base method.
+func (m *MockDomainItf) CreateShift(ctx context.Context, data entity.Shift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShift", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShift indicates an expected call of CreateShift.
+func (mr *MockDomainItfMockRecorder) CreateShift(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShift", reflect.TypeOf((*MockDomainItf)(nil).CreateShift), ctx, data)
+}
+
+// DeleteRosters mocks base method.
+func (m *MockDomainItf) DeleteRosters(ctx context.Context, filter entity.DeleteShiftRosterFilter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRosters", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRosters indicates an expected call of DeleteRosters.
+func (mr *MockDomainItfMockRecorder) DeleteRosters(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRosters", reflect.TypeOf((*MockDomainItf)(nil).DeleteRosters), ctx, filter)
+}
+
+// GetRosters mocks base method.
+func (m *MockDomainItf) GetRosters(ctx context.Context, filter entity.GetShiftRosterFilter) ([]entity.ShiftRoster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRosters", ctx, filter)
+	ret0, _ := ret[0].([]entity.ShiftRoster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRosters indicates an expected call of GetRosters.
+func (mr *MockDomainItfMockRecorder) GetRosters(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRosters", reflect.TypeOf((*MockDomainItf)(nil).GetRosters), ctx, filter)
+}
+
+// GetShifts mocks base method.
+func (m *MockDomainItf) GetShifts(ctx context.Context, filter entity.GetShiftFilter) ([]entity.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShifts", ctx, filter)
+	ret0, _ := ret[0].([]entity.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShifts indicates an expected call of GetShifts.
+func (mr *MockDomainItfMockRecorder) GetShifts(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShifts", reflect.TypeOf((*MockDomainItf)(nil).GetShifts), ctx, filter)
+}
+
+// UpdateShift mocks base method.
+func (m *MockDomainItf) UpdateShift(ctx context.Context, data entity.UpdateShift) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShift", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateShift indicates an expected call of UpdateShift.
+func (mr *MockDomainItfMockRecorder) UpdateShift(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShift", reflect.TypeOf((*MockDomainItf)(nil).UpdateShift), ctx, data)
+}
@@ -0,0 +1,87 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/signingkey/signingkey.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/signingkey/signingkey.go -destination=mocks/domain/signingkey/mock_signingkey.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateSigningKey mocks base method.
+func (m *MockDomainItf) CreateSigningKey(ctx context.Context, data entity.SigningKey) (*entity.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSigningKey", ctx, data)
+	ret0, _ := ret[0].(*entity.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSigningKey indicates an expected call of CreateSigningKey.
+func (mr *MockDomainItfMockRecorder) CreateSigningKey(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSigningKey", reflect.TypeOf((*MockDomainItf)(nil).CreateSigningKey), ctx, data)
+}
+
+// GetSigningKeys mocks base method.
+func (m *MockDomainItf) GetSigningKeys(ctx context.Context, filter entity.GetSigningKeyFilter) ([]entity.SigningKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSigningKeys", ctx, filter)
+	ret0, _ := ret[0].([]entity.SigningKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSigningKeys indicates an expected call of GetSigningKeys.
+func (mr *MockDomainItfMockRecorder) GetSigningKeys(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSigningKeys", reflect.TypeOf((*MockDomainItf)(nil).GetSigningKeys), ctx, filter)
+}
+
+// RetireSigningKeys mocks base method.
+func (m *MockDomainItf) RetireSigningKeys(ctx context.Context, ids []uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireSigningKeys", ctx, ids, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetireSigningKeys indicates an expected call of RetireSigningKeys.
+func (mr *MockDomainItfMockRecorder) RetireSigningKeys(ctx, ids, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireSigningKeys", reflect.TypeOf((*MockDomainItf)(nil).RetireSigningKeys), ctx, ids, at)
+}
@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/sso/sso.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/sso/sso.go -destination=mocks/domain/sso/mock_sso.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockDomainItf) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, state, nonce, codeChallenge)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockDomainItfMockRecorder) AuthCodeURL(ctx, state, nonce, codeChallenge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockDomainItf)(nil).AuthCodeURL), ctx, state, nonce, codeChallenge)
+}
+
+// CreateSSOState mocks base method.
+func (m *MockDomainItf) CreateSSOState(ctx context.Context, data entity.SSOState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSSOState", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSSOState indicates an expected call of CreateSSOState.
+func (mr *MockDomainItfMockRecorder) CreateSSOState(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSOState", reflect.TypeOf((*MockDomainItf)(nil).CreateSSOState), ctx, data)
+}
+
+// CreateUserIdentity mocks base method.
+func (m *MockDomainItf) CreateUserIdentity(ctx context.Context, data entity.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserIdentity", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserIdentity indicates an expected call of CreateUserIdentity.
+func (mr *MockDomainItfMockRecorder) CreateUserIdentity(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserIdentity", reflect.TypeOf((*MockDomainItf)(nil).CreateUserIdentity), ctx, data)
+}
+
+// Exchange mocks base method.
+func (m *MockDomainItf) Exchange(ctx context.Context, code, codeVerifier string) (entity.OIDCClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, codeVerifier)
+	ret0, _ := ret[0].(entity.OIDCClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockDomainItfMockRecorder) Exchange(ctx, code, codeVerifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockDomainItf)(nil).Exchange), ctx, code, codeVerifier)
+}
+
+// GetSSOState mocks base method.
+func (m *MockDomainItf) GetSSOState(ctx context.Context, stateHash string) (*entity.SSOState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSSOState", ctx, stateHash)
+	ret0, _ := ret[0].(*entity.SSOState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSSOState indicates an expected call of GetSSOState.
+func (mr *MockDomainItfMockRecorder) GetSSOState(ctx, stateHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSOState", reflect.TypeOf((*MockDomainItf)(nil).GetSSOState), ctx, stateHash)
+}
+
+// GetUserIdentity mocks base method.
+func (m *MockDomainItf) GetUserIdentity(ctx context.Context, issuer, subject string) (*entity.UserIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIdentity", ctx, issuer, subject)
+	ret0, _ := ret[0].(*entity.UserIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIdentity indicates an expected call of GetUserIdentity.
+func (mr *MockDomainItfMockRecorder) GetUserIdentity(ctx, issuer, subject any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIdentity", reflect.TypeOf((*MockDomainItf)(nil).GetUserIdentity), ctx, issuer, subject)
+}
+
+// UseSSOState mocks base method.
+func (m *MockDomainItf) UseSSOState(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseSSOState", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseSSOState indicates an expected call of UseSSOState.
+func (mr *MockDomainItfMockRecorder) UseSSOState(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseSSOState", reflect.TypeOf((*MockDomainItf)(nil).UseSSOState), ctx, id, at)
+}
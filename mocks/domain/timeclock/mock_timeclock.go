@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/timeclock/timeclock.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/timeclock/timeclock.go -destination=mocks/domain/timeclock/mock_timeclock.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CountTimeClockPunches mocks base method.
+func (m *MockDomainItf) CountTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountTimeClockPunches", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountTimeClockPunches indicates an expected call of CountTimeClockPunches.
+func (mr *MockDomainItfMockRecorder) CountTimeClockPunches(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountTimeClockPunches", reflect.TypeOf((*MockDomainItf)(nil).CountTimeClockPunches), ctx, filter)
+}
+
+// CreateTimeClock mocks base method.
+func (m *MockDomainItf) CreateTimeClock(ctx context.Context, data entity.TimeClock) (*entity.TimeClock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeClock", ctx, data)
+	ret0, _ := ret[0].(*entity.TimeClock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeClock indicates an expected call of CreateTimeClock.
+func (mr *MockDomainItfMockRecorder) CreateTimeClock(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeClock", reflect.TypeOf((*MockDomainItf)(nil).CreateTimeClock), ctx, data)
+}
+
+// CreateTimeClockEmployee mocks base method.
+func (m *MockDomainItf) CreateTimeClockEmployee(ctx context.Context, data entity.TimeClockEmployee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeClockEmployee", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTimeClockEmployee indicates an expected call of CreateTimeClockEmployee.
+func (mr *MockDomainItfMockRecorder) CreateTimeClockEmployee(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeClockEmployee", reflect.TypeOf((*MockDomainItf)(nil).CreateTimeClockEmployee), ctx, data)
+}
+
+// CreateTimeClockPunch mocks base method.
+func (m *MockDomainItf) CreateTimeClockPunch(ctx context.Context, data entity.TimeClockPunch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeClockPunch", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTimeClockPunch indicates an expected call of CreateTimeClockPunch.
+func (mr *MockDomainItfMockRecorder) CreateTimeClockPunch(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeClockPunch", reflect.TypeOf((*MockDomainItf)(nil).CreateTimeClockPunch), ctx, data)
+}
+
+// GetTimeClockEmployees mocks base method.
+func (m *MockDomainItf) GetTimeClockEmployees(ctx context.Context, filter entity.GetTimeClockEmployeeFilter) ([]entity.TimeClockEmployee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeClockEmployees", ctx, filter)
+	ret0, _ := ret[0].([]entity.TimeClockEmployee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeClockEmployees indicates an expected call of GetTimeClockEmployees.
+func (mr *MockDomainItfMockRecorder) GetTimeClockEmployees(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeClockEmployees", reflect.TypeOf((*MockDomainItf)(nil).GetTimeClockEmployees), ctx, filter)
+}
+
+// GetTimeClockPunches mocks base method.
+func (m *MockDomainItf) GetTimeClockPunches(ctx context.Context, filter entity.GetTimeClockPunchFilter) ([]entity.TimeClockPunch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeClockPunches", ctx, filter)
+	ret0, _ := ret[0].([]entity.TimeClockPunch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeClockPunches indicates an expected call of GetTimeClockPunches.
+func (mr *MockDomainItfMockRecorder) GetTimeClockPunches(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeClockPunches", reflect.TypeOf((*MockDomainItf)(nil).GetTimeClockPunches), ctx, filter)
+}
+
+// GetTimeClocks mocks base method.
+func (m *MockDomainItf) GetTimeClocks(ctx context.Context, filter entity.GetTimeClockFilter) ([]entity.TimeClock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeClocks", ctx, filter)
+	ret0, _ := ret[0].([]entity.TimeClock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeClocks indicates an expected call of GetTimeClocks.
+func (mr *MockDomainItfMockRecorder) GetTimeClocks(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeClocks", reflect.TypeOf((*MockDomainItf)(nil).GetTimeClocks), ctx, filter)
+}
+
+// UpdateTimeClockEmployee mocks base method.
+func (m *MockDomainItf) UpdateTimeClockEmployee(ctx context.Context, data entity.UpdateTimeClockEmployee) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeClockEmployee", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimeClockEmployee indicates an expected call of UpdateTimeClockEmployee.
+func (mr *MockDomainItfMockRecorder) UpdateTimeClockEmployee(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeClockEmployee", reflect.TypeOf((*MockDomainItf)(nil).UpdateTimeClockEmployee), ctx, data)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/travel/travel.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/travel/travel.go -destination=mocks/domain/travel/mock_travel.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateTravelRequest mocks base method.
+func (m *MockDomainItf) CreateTravelRequest(ctx context.Context, data entity.TravelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTravelRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTravelRequest indicates an expected call of CreateTravelRequest.
+func (mr *MockDomainItfMockRecorder) CreateTravelRequest(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTravelRequest", reflect.TypeOf((*MockDomainItf)(nil).CreateTravelRequest), ctx, data)
+}
+
+// GetTravelRequests mocks base method.
+func (m *MockDomainItf) GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTravelRequests", ctx, filter)
+	ret0, _ := ret[0].([]entity.TravelRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTravelRequests indicates an expected call of GetTravelRequests.
+func (mr *MockDomainItfMockRecorder) GetTravelRequests(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTravelRequests", reflect.TypeOf((*MockDomainItf)(nil).GetTravelRequests), ctx, filter)
+}
+
+// UpdateTravelRequest mocks base method.
+func (m *MockDomainItf) UpdateTravelRequest(ctx context.Context, data entity.UpdateTravelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTravelRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTravelRequest indicates an expected call of UpdateTravelRequest.
+func (mr *MockDomainItfMockRecorder) UpdateTravelRequest(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTravelRequest", reflect.TypeOf((*MockDomainItf)(nil).UpdateTravelRequest), ctx, data)
+}
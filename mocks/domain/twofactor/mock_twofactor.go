@@ -0,0 +1,214 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/domain/twofactor/twofactor.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/domain/twofactor/twofactor.go -destination=mocks/domain/twofactor/mock_twofactor.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainItf is a mock of DomainItf interface.
+type MockDomainItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainItfMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainItfMockRecorder is the mock recorder for MockDomainItf.
+type MockDomainItfMockRecorder struct {
+	mock *MockDomainItf
+}
+
+// NewMockDomainItf creates a new mock instance.
+func NewMockDomainItf(ctrl *gomock.Controller) *MockDomainItf {
+	mock := &MockDomainItf{ctrl: ctrl}
+	mock.recorder = &MockDomainItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
+	return m.recorder
+}
+
+// CreateLoginChallenge mocks base method.
+func (m *MockDomainItf) CreateLoginChallenge(ctx context.Context, data entity.LoginChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoginChallenge", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLoginChallenge indicates an expected call of CreateLoginChallenge.
+func (mr *MockDomainItfMockRecorder) CreateLoginChallenge(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoginChallenge", reflect.TypeOf((*MockDomainItf)(nil).CreateLoginChallenge), ctx, data)
+}
+
+// CreateRecoveryCodes mocks base method.
+func (m *MockDomainItf) CreateRecoveryCodes(ctx context.Context, codes []entity.RecoveryCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRecoveryCodes", ctx, codes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRecoveryCodes indicates an expected call of CreateRecoveryCodes.
+func (mr *MockDomainItfMockRecorder) CreateRecoveryCodes(ctx, codes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRecoveryCodes", reflect.TypeOf((*MockDomainItf)(nil).CreateRecoveryCodes), ctx, codes)
+}
+
+// CreateTwoFactor mocks base method.
+func (m *MockDomainItf) CreateTwoFactor(ctx context.Context, data entity.TwoFactor) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTwoFactor", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateTwoFactor indicates an expected call of CreateTwoFactor.
+func (mr *MockDomainItfMockRecorder) CreateTwoFactor(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTwoFactor", reflect.TypeOf((*MockDomainItf)(nil).CreateTwoFactor), ctx, data)
+}
+
+// DeleteRecoveryCodes mocks base method.
+func (m *MockDomainItf) DeleteRecoveryCodes(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRecoveryCodes", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRecoveryCodes indicates an expected call of DeleteRecoveryCodes.
+func (mr *MockDomainItfMockRecorder) DeleteRecoveryCodes(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRecoveryCodes", reflect.TypeOf((*MockDomainItf)(nil).DeleteRecoveryCodes), ctx, userID)
+}
+
+// DeleteTwoFactor mocks base method.
+func (m *MockDomainItf) DeleteTwoFactor(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTwoFactor indicates an expected call of DeleteTwoFactor.
+func (mr *MockDomainItfMockRecorder) DeleteTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTwoFactor", reflect.TypeOf((*MockDomainItf)(nil).DeleteTwoFactor), ctx, userID)
+}
+
+// EnableTwoFactor mocks base method.
+func (m *MockDomainItf) EnableTwoFactor(ctx context.Context, userID uint, step int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, userID, step, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockDomainItfMockRecorder) EnableTwoFactor(ctx, userID, step, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockDomainItf)(nil).EnableTwoFactor), ctx, userID, step, at)
+}
+
+// GetLoginChallenge mocks base method.
+func (m *MockDomainItf) GetLoginChallenge(ctx context.Context, tokenHash string) (*entity.LoginChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginChallenge", ctx, tokenHash)
+	ret0, _ := ret[0].(*entity.LoginChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginChallenge indicates an expected call of GetLoginChallenge.
+func (mr *MockDomainItfMockRecorder) GetLoginChallenge(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginChallenge", reflect.TypeOf((*MockDomainItf)(nil).GetLoginChallenge), ctx, tokenHash)
+}
+
+// GetRecoveryCodes mocks base method.
+func (m *MockDomainItf) GetRecoveryCodes(ctx context.Context, filter entity.GetRecoveryCodeFilter) ([]entity.RecoveryCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecoveryCodes", ctx, filter)
+	ret0, _ := ret[0].([]entity.RecoveryCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecoveryCodes indicates an expected call of GetRecoveryCodes.
+func (mr *MockDomainItfMockRecorder) GetRecoveryCodes(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecoveryCodes", reflect.TypeOf((*MockDomainItf)(nil).GetRecoveryCodes), ctx, filter)
+}
+
+// GetTwoFactor mocks base method.
+func (m *MockDomainItf) GetTwoFactor(ctx context.Context, userID uint) (*entity.TwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(*entity.TwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactor indicates an expected call of GetTwoFactor.
+func (mr *MockDomainItfMockRecorder) GetTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactor", reflect.TypeOf((*MockDomainItf)(nil).GetTwoFactor), ctx, userID)
+}
+
+// UpdateLoginChallenge mocks base method.
+func (m *MockDomainItf) UpdateLoginChallenge(ctx context.Context, data entity.UpdateLoginChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoginChallenge", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLoginChallenge indicates an expected call of UpdateLoginChallenge.
+func (mr *MockDomainItfMockRecorder) UpdateLoginChallenge(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoginChallenge", reflect.TypeOf((*MockDomainItf)(nil).UpdateLoginChallenge), ctx, data)
+}
+
+// UseRecoveryCode mocks base method.
+func (m *MockDomainItf) UseRecoveryCode(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode.
+func (mr *MockDomainItfMockRecorder) UseRecoveryCode(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockDomainItf)(nil).UseRecoveryCode), ctx, id, at)
+}
+
+// UseTOTPStep mocks base method.
+func (m *MockDomainItf) UseTOTPStep(ctx context.Context, userID uint, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseTOTPStep", ctx, userID, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseTOTPStep indicates an expected call of UseTOTPStep.
+func (mr *MockDomainItfMockRecorder) UseTOTPStep(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseTOTPStep", reflect.TypeOf((*MockDomainItf)(nil).UseTOTPStep), ctx, userID, step)
+}
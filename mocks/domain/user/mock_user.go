@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/zuhrulumam/go-hris/business/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,93 @@ func (m *MockDomainItf) EXPECT() *MockDomainItfMockRecorder {
 	return m.recorder
 }
 
+// CheckPassword mocks base method.
+func (m *MockDomainItf) CheckPassword(ctx context.Context, userID uint, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckPassword", ctx, userID, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPassword indicates an expected call of CheckPassword.
+func (mr *MockDomainItfMockRecorder) CheckPassword(ctx, userID, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPassword", reflect.TypeOf((*MockDomainItf)(nil).CheckPassword), ctx, userID, password)
+}
+
+// CreateEmployeeProfile mocks base method.
+func (m *MockDomainItf) CreateEmployeeProfile(ctx context.Context, data entity.EmployeeProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmployeeProfile", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmployeeProfile indicates an expected call of CreateEmployeeProfile.
+func (mr *MockDomainItfMockRecorder) CreateEmployeeProfile(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployeeProfile", reflect.TypeOf((*MockDomainItf)(nil).CreateEmployeeProfile), ctx, data)
+}
+
+// CreatePasswordResetToken mocks base method.
+func (m *MockDomainItf) CreatePasswordResetToken(ctx context.Context, data entity.PasswordResetToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetToken", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePasswordResetToken indicates an expected call of CreatePasswordResetToken.
+func (mr *MockDomainItfMockRecorder) CreatePasswordResetToken(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetToken", reflect.TypeOf((*MockDomainItf)(nil).CreatePasswordResetToken), ctx, data)
+}
+
+// CreateUser mocks base method.
+func (m *MockDomainItf) CreateUser(ctx context.Context, req entity.RegisterRequest) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, req)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockDomainItfMockRecorder) CreateUser(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockDomainItf)(nil).CreateUser), ctx, req)
+}
+
+// GetEmployeeProfiles mocks base method.
+func (m *MockDomainItf) GetEmployeeProfiles(ctx context.Context, filter entity.GetEmployeeProfileFilter) ([]entity.EmployeeProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeProfiles", ctx, filter)
+	ret0, _ := ret[0].([]entity.EmployeeProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeProfiles indicates an expected call of GetEmployeeProfiles.
+func (mr *MockDomainItfMockRecorder) GetEmployeeProfiles(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeProfiles", reflect.TypeOf((*MockDomainItf)(nil).GetEmployeeProfiles), ctx, filter)
+}
+
+// GetPasswordResetToken mocks base method.
+func (m *MockDomainItf) GetPasswordResetToken(ctx context.Context, tokenHash string) (*entity.PasswordResetToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordResetToken", ctx, tokenHash)
+	ret0, _ := ret[0].(*entity.PasswordResetToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordResetToken indicates an expected call of GetPasswordResetToken.
+func (mr *MockDomainItfMockRecorder) GetPasswordResetToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordResetToken", reflect.TypeOf((*MockDomainItf)(nil).GetPasswordResetToken), ctx, tokenHash)
+}
+
 // GetUsers mocks base method.
 func (m *MockDomainItf) GetUsers(ctx context.Context, filter entity.GetUserFilter) ([]entity.User, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +144,37 @@ func (mr *MockDomainItfMockRecorder) GetUsers(ctx, filter any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockDomainItf)(nil).GetUsers), ctx, filter)
 }
 
+// InvalidatePasswordResetTokens mocks base method.
+func (m *MockDomainItf) InvalidatePasswordResetTokens(ctx context.Context, userID uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidatePasswordResetTokens", ctx, userID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidatePasswordResetTokens indicates an expected call of InvalidatePasswordResetTokens.
+func (mr *MockDomainItfMockRecorder) InvalidatePasswordResetTokens(ctx, userID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidatePasswordResetTokens", reflect.TypeOf((*MockDomainItf)(nil).InvalidatePasswordResetTokens), ctx, userID, at)
+}
+
+// ListUsers mocks base method.
+func (m *MockDomainItf) ListUsers(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, filter)
+	ret0, _ := ret[0].([]entity.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockDomainItfMockRecorder) ListUsers(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockDomainItf)(nil).ListUsers), ctx, filter)
+}
+
 // Login mocks base method.
 func (m *MockDomainItf) Login(ctx context.Context, req entity.LoginRequest) (*entity.User, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +203,59 @@ func (mr *MockDomainItfMockRecorder) Register(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockDomainItf)(nil).Register), ctx, req)
 }
+
+// SetPassword mocks base method.
+func (m *MockDomainItf) SetPassword(ctx context.Context, userID uint, password string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPassword", ctx, userID, password, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPassword indicates an expected call of SetPassword.
+func (mr *MockDomainItfMockRecorder) SetPassword(ctx, userID, password, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPassword", reflect.TypeOf((*MockDomainItf)(nil).SetPassword), ctx, userID, password, at)
+}
+
+// UpdateEmployeeProfile mocks base method.
+func (m *MockDomainItf) UpdateEmployeeProfile(ctx context.Context, data entity.UpdateEmployeeProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployeeProfile", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmployeeProfile indicates an expected call of UpdateEmployeeProfile.
+func (mr *MockDomainItfMockRecorder) UpdateEmployeeProfile(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployeeProfile", reflect.TypeOf((*MockDomainItf)(nil).UpdateEmployeeProfile), ctx, data)
+}
+
+// UpdateUser mocks base method.
+func (m *MockDomainItf) UpdateUser(ctx context.Context, data entity.UpdateUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockDomainItfMockRecorder) UpdateUser(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockDomainItf)(nil).UpdateUser), ctx, data)
+}
+
+// UsePasswordResetToken mocks base method.
+func (m *MockDomainItf) UsePasswordResetToken(ctx context.Context, id uint, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UsePasswordResetToken", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UsePasswordResetToken indicates an expected call of UsePasswordResetToken.
+func (mr *MockDomainItfMockRecorder) UsePasswordResetToken(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UsePasswordResetToken", reflect.TypeOf((*MockDomainItf)(nil).UsePasswordResetToken), ctx, id, at)
+}
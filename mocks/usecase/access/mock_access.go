@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/usecase/access/access.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/usecase/access/access.go -destination=mocks/usecase/access/mock_access.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecaseItf is a mock of UsecaseItf interface.
+type MockUsecaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseItfMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseItfMockRecorder is the mock recorder for MockUsecaseItf.
+type MockUsecaseItfMockRecorder struct {
+	mock *MockUsecaseItf
+}
+
+// NewMockUsecaseItf creates a new mock instance.
+func NewMockUsecaseItf(ctrl *gomock.Controller) *MockUsecaseItf {
+	mock := &MockUsecaseItf{ctrl: ctrl}
+	mock.recorder = &MockUsecaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecaseItf) EXPECT() *MockUsecaseItfMockRecorder {
+	return m.recorder
+}
+
+// CanManageRole mocks base method.
+func (m *MockUsecaseItf) CanManageRole(ctx context.Context, role string, target entity.UserRole) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanManageRole", ctx, role, target)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanManageRole indicates an expected call of CanManageRole.
+func (mr *MockUsecaseItfMockRecorder) CanManageRole(ctx, role, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManageRole", reflect.TypeOf((*MockUsecaseItf)(nil).CanManageRole), ctx, role, target)
+}
+
+// GetRoles mocks base method.
+func (m *MockUsecaseItf) GetRoles(ctx context.Context) ([]entity.RoleAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", ctx)
+	ret0, _ := ret[0].([]entity.RoleAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockUsecaseItfMockRecorder) GetRoles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockUsecaseItf)(nil).GetRoles), ctx)
+}
+
+// HasPermission mocks base method.
+func (m *MockUsecaseItf) HasPermission(ctx context.Context, role, permission string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasPermission", ctx, role, permission)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermission indicates an expected call of HasPermission.
+func (mr *MockUsecaseItfMockRecorder) HasPermission(ctx, role, permission any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockUsecaseItf)(nil).HasPermission), ctx, role, permission)
+}
+
+// Scope mocks base method.
+func (m *MockUsecaseItf) Scope(ctx context.Context, userID uint, role string, all, team entity.Permission) (entity.AccessScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scope", ctx, userID, role, all, team)
+	ret0, _ := ret[0].(entity.AccessScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Scope indicates an expected call of Scope.
+func (mr *MockUsecaseItfMockRecorder) Scope(ctx, userID, role, all, team any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scope", reflect.TypeOf((*MockUsecaseItf)(nil).Scope), ctx, userID, role, all, team)
+}
+
+// SetRolePermissions mocks base method.
+func (m *MockUsecaseItf) SetRolePermissions(ctx context.Context, req entity.SetRolePermissionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRolePermissions", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRolePermissions indicates an expected call of SetRolePermissions.
+func (mr *MockUsecaseItfMockRecorder) SetRolePermissions(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRolePermissions", reflect.TypeOf((*MockUsecaseItf)(nil).SetRolePermissions), ctx, req)
+}
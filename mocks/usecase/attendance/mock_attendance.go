@@ -0,0 +1,348 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/usecase/attendance/attendance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/usecase/attendance/attendance.go -destination=mocks/usecase/attendance/mock_attendance.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecaseItf is a mock of UsecaseItf interface.
+type MockUsecaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseItfMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseItfMockRecorder is the mock recorder for MockUsecaseItf.
+type MockUsecaseItfMockRecorder struct {
+	mock *MockUsecaseItf
+}
+
+// NewMockUsecaseItf creates a new mock instance.
+func NewMockUsecaseItf(ctrl *gomock.Controller) *MockUsecaseItf {
+	mock := &MockUsecaseItf{ctrl: ctrl}
+	mock.recorder = &MockUsecaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecaseItf) EXPECT() *MockUsecaseItfMockRecorder {
+	return m.recorder
+}
+
+// AutoCloseAttendances mocks base method.
+func (m *MockUsecaseItf) AutoCloseAttendances(ctx context.Context, cutoff time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoCloseAttendances", ctx, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AutoCloseAttendances indicates an expected call of AutoCloseAttendances.
+func (mr *MockUsecaseItfMockRecorder) AutoCloseAttendances(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoCloseAttendances", reflect.TypeOf((*MockUsecaseItf)(nil).AutoCloseAttendances), ctx, cutoff)
+}
+
+// CheckIn mocks base method.
+func (m *MockUsecaseItf) CheckIn(ctx context.Context, data entity.CheckIn) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockUsecaseItfMockRecorder) CheckIn(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockUsecaseItf)(nil).CheckIn), ctx, data)
+}
+
+// CheckOut mocks base method.
+func (m *MockUsecaseItf) CheckOut(ctx context.Context, data entity.CheckOut) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOut", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOut indicates an expected call of CheckOut.
+func (mr *MockUsecaseItfMockRecorder) CheckOut(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOut", reflect.TypeOf((*MockUsecaseItf)(nil).CheckOut), ctx, data)
+}
+
+// CreateAttendancePeriod mocks base method.
+func (m *MockUsecaseItf) CreateAttendancePeriod(ctx context.Context, req entity.CreateAttendancePeriodRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAttendancePeriod", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAttendancePeriod indicates an expected call of CreateAttendancePeriod.
+func (mr *MockUsecaseItfMockRecorder) CreateAttendancePeriod(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttendancePeriod", reflect.TypeOf((*MockUsecaseItf)(nil).CreateAttendancePeriod), ctx, req)
+}
+
+// CreateOvertime mocks base method.
+func (m *MockUsecaseItf) CreateOvertime(ctx context.Context, data entity.CreateOvertimeData) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOvertime", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOvertime indicates an expected call of CreateOvertime.
+func (mr *MockUsecaseItfMockRecorder) CreateOvertime(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOvertime", reflect.TypeOf((*MockUsecaseItf)(nil).CreateOvertime), ctx, data)
+}
+
+// EndBreak mocks base method.
+func (m *MockUsecaseItf) EndBreak(ctx context.Context, data entity.EndBreak) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndBreak", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndBreak indicates an expected call of EndBreak.
+func (mr *MockUsecaseItfMockRecorder) EndBreak(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndBreak", reflect.TypeOf((*MockUsecaseItf)(nil).EndBreak), ctx, data)
+}
+
+// GenerateAttendancePeriods mocks base method.
+func (m *MockUsecaseItf) GenerateAttendancePeriods(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAttendancePeriods", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAttendancePeriods indicates an expected call of GenerateAttendancePeriods.
+func (mr *MockUsecaseItfMockRecorder) GenerateAttendancePeriods(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAttendancePeriods", reflect.TypeOf((*MockUsecaseItf)(nil).GenerateAttendancePeriods), ctx)
+}
+
+// GetAttendanceHistory mocks base method.
+func (m *MockUsecaseItf) GetAttendanceHistory(ctx context.Context, req entity.GetAttendanceHistoryRequest) (*entity.AttendanceHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceHistory", ctx, req)
+	ret0, _ := ret[0].(*entity.AttendanceHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceHistory indicates an expected call of GetAttendanceHistory.
+func (mr *MockUsecaseItfMockRecorder) GetAttendanceHistory(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceHistory", reflect.TypeOf((*MockUsecaseItf)(nil).GetAttendanceHistory), ctx, req)
+}
+
+// GetAttendancePeriodTransitions mocks base method.
+func (m *MockUsecaseItf) GetAttendancePeriodTransitions(ctx context.Context, periodID uint) ([]entity.AttendancePeriodTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancePeriodTransitions", ctx, periodID)
+	ret0, _ := ret[0].([]entity.AttendancePeriodTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendancePeriodTransitions indicates an expected call of GetAttendancePeriodTransitions.
+func (mr *MockUsecaseItfMockRecorder) GetAttendancePeriodTransitions(ctx, periodID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePeriodTransitions", reflect.TypeOf((*MockUsecaseItf)(nil).GetAttendancePeriodTransitions), ctx, periodID)
+}
+
+// GetAttendancePeriods mocks base method.
+func (m *MockUsecaseItf) GetAttendancePeriods(ctx context.Context, filter entity.GetAttendancePeriodFilter) ([]entity.AttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendancePeriods", ctx, filter)
+	ret0, _ := ret[0].([]entity.AttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendancePeriods indicates an expected call of GetAttendancePeriods.
+func (mr *MockUsecaseItfMockRecorder) GetAttendancePeriods(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendancePeriods", reflect.TypeOf((*MockUsecaseItf)(nil).GetAttendancePeriods), ctx, filter)
+}
+
+// GetAttendanceReport mocks base method.
+func (m *MockUsecaseItf) GetAttendanceReport(ctx context.Context, req entity.GetAttendanceReportRequest) (*entity.AttendanceReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceReport", ctx, req)
+	ret0, _ := ret[0].(*entity.AttendanceReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceReport indicates an expected call of GetAttendanceReport.
+func (mr *MockUsecaseItfMockRecorder) GetAttendanceReport(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceReport", reflect.TypeOf((*MockUsecaseItf)(nil).GetAttendanceReport), ctx, req)
+}
+
+// GetCorrections mocks base method.
+func (m *MockUsecaseItf) GetCorrections(ctx context.Context, filter entity.GetAttendanceCorrectionFilter) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCorrections", ctx, filter)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCorrections indicates an expected call of GetCorrections.
+func (mr *MockUsecaseItfMockRecorder) GetCorrections(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCorrections", reflect.TypeOf((*MockUsecaseItf)(nil).GetCorrections), ctx, filter)
+}
+
+// GetOvertime mocks base method.
+func (m *MockUsecaseItf) GetOvertime(ctx context.Context, filter entity.GetOvertimeFilter) ([]entity.Overtime, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertime", ctx, filter)
+	ret0, _ := ret[0].([]entity.Overtime)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertime indicates an expected call of GetOvertime.
+func (mr *MockUsecaseItfMockRecorder) GetOvertime(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertime", reflect.TypeOf((*MockUsecaseItf)(nil).GetOvertime), ctx, filter)
+}
+
+// GetWorkModeSummary mocks base method.
+func (m *MockUsecaseItf) GetWorkModeSummary(ctx context.Context, filter entity.GetWorkModeSummaryFilter) ([]entity.WorkModeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkModeSummary", ctx, filter)
+	ret0, _ := ret[0].([]entity.WorkModeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkModeSummary indicates an expected call of GetWorkModeSummary.
+func (mr *MockUsecaseItfMockRecorder) GetWorkModeSummary(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkModeSummary", reflect.TypeOf((*MockUsecaseItf)(nil).GetWorkModeSummary), ctx, filter)
+}
+
+// IngestPunches mocks base method.
+func (m *MockUsecaseItf) IngestPunches(ctx context.Context, req entity.IngestPunchesRequest) (*entity.PunchIngestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestPunches", ctx, req)
+	ret0, _ := ret[0].(*entity.PunchIngestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestPunches indicates an expected call of IngestPunches.
+func (mr *MockUsecaseItfMockRecorder) IngestPunches(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestPunches", reflect.TypeOf((*MockUsecaseItf)(nil).IngestPunches), ctx, req)
+}
+
+// ReviewCorrection mocks base method.
+func (m *MockUsecaseItf) ReviewCorrection(ctx context.Context, data entity.ReviewAttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewCorrection", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewCorrection indicates an expected call of ReviewCorrection.
+func (mr *MockUsecaseItfMockRecorder) ReviewCorrection(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewCorrection", reflect.TypeOf((*MockUsecaseItf)(nil).ReviewCorrection), ctx, data)
+}
+
+// StartBreak mocks base method.
+func (m *MockUsecaseItf) StartBreak(ctx context.Context, data entity.StartBreak) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBreak", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartBreak indicates an expected call of StartBreak.
+func (mr *MockUsecaseItfMockRecorder) StartBreak(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBreak", reflect.TypeOf((*MockUsecaseItf)(nil).StartBreak), ctx, data)
+}
+
+// SubmitCorrection mocks base method.
+func (m *MockUsecaseItf) SubmitCorrection(ctx context.Context, data entity.SubmitAttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitCorrection", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitCorrection indicates an expected call of SubmitCorrection.
+func (mr *MockUsecaseItfMockRecorder) SubmitCorrection(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitCorrection", reflect.TypeOf((*MockUsecaseItf)(nil).SubmitCorrection), ctx, data)
+}
+
+// SyncOfflineEvents mocks base method.
+func (m *MockUsecaseItf) SyncOfflineEvents(ctx context.Context, req entity.SyncAttendanceRequest) ([]entity.SyncEventResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncOfflineEvents", ctx, req)
+	ret0, _ := ret[0].([]entity.SyncEventResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncOfflineEvents indicates an expected call of SyncOfflineEvents.
+func (mr *MockUsecaseItfMockRecorder) SyncOfflineEvents(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncOfflineEvents", reflect.TypeOf((*MockUsecaseItf)(nil).SyncOfflineEvents), ctx, req)
+}
+
+// TransitionAttendancePeriod mocks base method.
+func (m *MockUsecaseItf) TransitionAttendancePeriod(ctx context.Context, req entity.TransitionAttendancePeriodRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionAttendancePeriod", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransitionAttendancePeriod indicates an expected call of TransitionAttendancePeriod.
+func (mr *MockUsecaseItfMockRecorder) TransitionAttendancePeriod(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionAttendancePeriod", reflect.TypeOf((*MockUsecaseItf)(nil).TransitionAttendancePeriod), ctx, req)
+}
+
+// UpdateAttendancePeriod mocks base method.
+func (m *MockUsecaseItf) UpdateAttendancePeriod(ctx context.Context, req entity.UpdateAttendancePeriodRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendancePeriod", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendancePeriod indicates an expected call of UpdateAttendancePeriod.
+func (mr *MockUsecaseItfMockRecorder) UpdateAttendancePeriod(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendancePeriod", reflect.TypeOf((*MockUsecaseItf)(nil).UpdateAttendancePeriod), ctx, req)
+}
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/usecase/travel/travel.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/usecase/travel/travel.go -destination=mocks/usecase/travel/mock_travel.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecaseItf is a mock of UsecaseItf interface.
+type MockUsecaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseItfMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseItfMockRecorder is the mock recorder for MockUsecaseItf.
+type MockUsecaseItfMockRecorder struct {
+	mock *MockUsecaseItf
+}
+
+// NewMockUsecaseItf creates a new mock instance.
+func NewMockUsecaseItf(ctrl *gomock.Controller) *MockUsecaseItf {
+	mock := &MockUsecaseItf{ctrl: ctrl}
+	mock.recorder = &MockUsecaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecaseItf) EXPECT() *MockUsecaseItfMockRecorder {
+	return m.recorder
+}
+
+// GetTravelRequests mocks base method.
+func (m *MockUsecaseItf) GetTravelRequests(ctx context.Context, filter entity.GetTravelRequestFilter) ([]entity.TravelRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTravelRequests", ctx, filter)
+	ret0, _ := ret[0].([]entity.TravelRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTravelRequests indicates an expected call of GetTravelRequests.
+func (mr *MockUsecaseItfMockRecorder) GetTravelRequests(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTravelRequests", reflect.TypeOf((*MockUsecaseItf)(nil).GetTravelRequests), ctx, filter)
+}
+
+// ReviewTravelRequest mocks base method.
+func (m *MockUsecaseItf) ReviewTravelRequest(ctx context.Context, data entity.ReviewTravelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewTravelRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReviewTravelRequest indicates an expected call of ReviewTravelRequest.
+func (mr *MockUsecaseItfMockRecorder) ReviewTravelRequest(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTravelRequest", reflect.TypeOf((*MockUsecaseItf)(nil).ReviewTravelRequest), ctx, data)
+}
+
+// SubmitTravelRequest mocks base method.
+func (m *MockUsecaseItf) SubmitTravelRequest(ctx context.Context, data entity.SubmitTravelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitTravelRequest", ctx, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitTravelRequest indicates an expected call of SubmitTravelRequest.
+func (mr *MockUsecaseItfMockRecorder) SubmitTravelRequest(ctx, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitTravelRequest", reflect.TypeOf((*MockUsecaseItf)(nil).SubmitTravelRequest), ctx, data)
+}
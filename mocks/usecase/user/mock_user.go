@@ -0,0 +1,526 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: business/usecase/user/user.go
+//
+// Generated by this command:
+//
+//	mockgen -source=business/usecase/user/user.go -destination=mocks/usecase/user/mock_user.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/zuhrulumam/go-hris/business/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecaseItf is a mock of UsecaseItf interface.
+type MockUsecaseItf struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseItfMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseItfMockRecorder is the mock recorder for MockUsecaseItf.
+type MockUsecaseItfMockRecorder struct {
+	mock *MockUsecaseItf
+}
+
+// NewMockUsecaseItf creates a new mock instance.
+func NewMockUsecaseItf(ctrl *gomock.Controller) *MockUsecaseItf {
+	mock := &MockUsecaseItf{ctrl: ctrl}
+	mock.recorder = &MockUsecaseItfMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecaseItf) EXPECT() *MockUsecaseItfMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockUsecaseItf) ChangePassword(ctx context.Context, input entity.ChangePasswordRequest) (entity.AuthTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, input)
+	ret0, _ := ret[0].(entity.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockUsecaseItfMockRecorder) ChangePassword(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUsecaseItf)(nil).ChangePassword), ctx, input)
+}
+
+// CompleteSSO mocks base method.
+func (m *MockUsecaseItf) CompleteSSO(ctx context.Context, input entity.SSOCallbackRequest) (entity.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteSSO", ctx, input)
+	ret0, _ := ret[0].(entity.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteSSO indicates an expected call of CompleteSSO.
+func (mr *MockUsecaseItfMockRecorder) CompleteSSO(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSSO", reflect.TypeOf((*MockUsecaseItf)(nil).CompleteSSO), ctx, input)
+}
+
+// ConfirmTwoFactor mocks base method.
+func (m *MockUsecaseItf) ConfirmTwoFactor(ctx context.Context, userID uint, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTwoFactor", ctx, userID, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTwoFactor indicates an expected call of ConfirmTwoFactor.
+func (mr *MockUsecaseItfMockRecorder) ConfirmTwoFactor(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTwoFactor", reflect.TypeOf((*MockUsecaseItf)(nil).ConfirmTwoFactor), ctx, userID, code)
+}
+
+// CreateEmployee mocks base method.
+func (m *MockUsecaseItf) CreateEmployee(ctx context.Context, input entity.RegisterRequest) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmployee", ctx, input)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEmployee indicates an expected call of CreateEmployee.
+func (mr *MockUsecaseItfMockRecorder) CreateEmployee(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmployee", reflect.TypeOf((*MockUsecaseItf)(nil).CreateEmployee), ctx, input)
+}
+
+// DisableTwoFactor mocks base method.
+func (m *MockUsecaseItf) DisableTwoFactor(ctx context.Context, userID uint, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTwoFactor", ctx, userID, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTwoFactor indicates an expected call of DisableTwoFactor.
+func (mr *MockUsecaseItfMockRecorder) DisableTwoFactor(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTwoFactor", reflect.TypeOf((*MockUsecaseItf)(nil).DisableTwoFactor), ctx, userID, code)
+}
+
+// EnrollLoginTwoFactor mocks base method.
+func (m *MockUsecaseItf) EnrollLoginTwoFactor(ctx context.Context, challengeToken string) (entity.TwoFactorEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollLoginTwoFactor", ctx, challengeToken)
+	ret0, _ := ret[0].(entity.TwoFactorEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollLoginTwoFactor indicates an expected call of EnrollLoginTwoFactor.
+func (mr *MockUsecaseItfMockRecorder) EnrollLoginTwoFactor(ctx, challengeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollLoginTwoFactor", reflect.TypeOf((*MockUsecaseItf)(nil).EnrollLoginTwoFactor), ctx, challengeToken)
+}
+
+// EnrollTwoFactor mocks base method.
+func (m *MockUsecaseItf) EnrollTwoFactor(ctx context.Context, userID uint) (entity.TwoFactorEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(entity.TwoFactorEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTwoFactor indicates an expected call of EnrollTwoFactor.
+func (mr *MockUsecaseItfMockRecorder) EnrollTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTwoFactor", reflect.TypeOf((*MockUsecaseItf)(nil).EnrollTwoFactor), ctx, userID)
+}
+
+// GetEmployee mocks base method.
+func (m *MockUsecaseItf) GetEmployee(ctx context.Context, id uint) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployee", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployee indicates an expected call of GetEmployee.
+func (mr *MockUsecaseItfMockRecorder) GetEmployee(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployee", reflect.TypeOf((*MockUsecaseItf)(nil).GetEmployee), ctx, id)
+}
+
+// GetEmployeeProfile mocks base method.
+func (m *MockUsecaseItf) GetEmployeeProfile(ctx context.Context, userID uint) (*entity.EmployeeProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmployeeProfile", ctx, userID)
+	ret0, _ := ret[0].(*entity.EmployeeProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmployeeProfile indicates an expected call of GetEmployeeProfile.
+func (mr *MockUsecaseItfMockRecorder) GetEmployeeProfile(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmployeeProfile", reflect.TypeOf((*MockUsecaseItf)(nil).GetEmployeeProfile), ctx, userID)
+}
+
+// GetSessions mocks base method.
+func (m *MockUsecaseItf) GetSessions(ctx context.Context, userID uint) ([]entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userID)
+	ret0, _ := ret[0].([]entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockUsecaseItfMockRecorder) GetSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockUsecaseItf)(nil).GetSessions), ctx, userID)
+}
+
+// GetTwoFactorStatus mocks base method.
+func (m *MockUsecaseItf) GetTwoFactorStatus(ctx context.Context, userID uint) (entity.TwoFactorStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTwoFactorStatus", ctx, userID)
+	ret0, _ := ret[0].(entity.TwoFactorStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTwoFactorStatus indicates an expected call of GetTwoFactorStatus.
+func (mr *MockUsecaseItfMockRecorder) GetTwoFactorStatus(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTwoFactorStatus", reflect.TypeOf((*MockUsecaseItf)(nil).GetTwoFactorStatus), ctx, userID)
+}
+
+// IsSessionRevoked mocks base method.
+func (m *MockUsecaseItf) IsSessionRevoked(ctx context.Context, sessionID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSessionRevoked", ctx, sessionID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSessionRevoked indicates an expected call of IsSessionRevoked.
+func (mr *MockUsecaseItfMockRecorder) IsSessionRevoked(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSessionRevoked", reflect.TypeOf((*MockUsecaseItf)(nil).IsSessionRevoked), ctx, sessionID)
+}
+
+// ListEmployees mocks base method.
+func (m *MockUsecaseItf) ListEmployees(ctx context.Context, filter entity.ListUserFilter) ([]entity.User, int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEmployees", ctx, filter)
+	ret0, _ := ret[0].([]entity.User)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListEmployees indicates an expected call of ListEmployees.
+func (mr *MockUsecaseItfMockRecorder) ListEmployees(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEmployees", reflect.TypeOf((*MockUsecaseItf)(nil).ListEmployees), ctx, filter)
+}
+
+// ListSecurityEvents mocks base method.
+func (m *MockUsecaseItf) ListSecurityEvents(ctx context.Context, filter entity.GetSecurityEventFilter) ([]entity.SecurityEvent, int64, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityEvents", ctx, filter)
+	ret0, _ := ret[0].([]entity.SecurityEvent)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(int)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// ListSecurityEvents indicates an expected call of ListSecurityEvents.
+func (mr *MockUsecaseItfMockRecorder) ListSecurityEvents(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityEvents", reflect.TypeOf((*MockUsecaseItf)(nil).ListSecurityEvents), ctx, filter)
+}
+
+// Login mocks base method.
+func (m *MockUsecaseItf) Login(ctx context.Context, input entity.LoginRequest) (entity.LoginResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, input)
+	ret0, _ := ret[0].(entity.LoginResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockUsecaseItfMockRecorder) Login(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUsecaseItf)(nil).Login), ctx, input)
+}
+
+// RefreshSession mocks base method.
+func (m *MockUsecaseItf) RefreshSession(ctx context.Context, input entity.RefreshSessionRequest) (entity.AuthTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSession", ctx, input)
+	ret0, _ := ret[0].(entity.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshSession indicates an expected call of RefreshSession.
+func (mr *MockUsecaseItfMockRecorder) RefreshSession(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSession", reflect.TypeOf((*MockUsecaseItf)(nil).RefreshSession), ctx, input)
+}
+
+// RegenerateRecoveryCodes mocks base method.
+func (m *MockUsecaseItf) RegenerateRecoveryCodes(ctx context.Context, userID uint, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateRecoveryCodes", ctx, userID, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateRecoveryCodes indicates an expected call of RegenerateRecoveryCodes.
+func (mr *MockUsecaseItfMockRecorder) RegenerateRecoveryCodes(ctx, userID, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateRecoveryCodes", reflect.TypeOf((*MockUsecaseItf)(nil).RegenerateRecoveryCodes), ctx, userID, code)
+}
+
+// Register mocks base method.
+func (m *MockUsecaseItf) Register(ctx context.Context, input entity.RegisterRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockUsecaseItfMockRecorder) Register(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUsecaseItf)(nil).Register), ctx, input)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockUsecaseItf) RequestPasswordReset(ctx context.Context, input entity.ForgotPasswordRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUsecaseItfMockRecorder) RequestPasswordReset(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUsecaseItf)(nil).RequestPasswordReset), ctx, input)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUsecaseItf) ResetPassword(ctx context.Context, input entity.ResetPasswordRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUsecaseItfMockRecorder) ResetPassword(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUsecaseItf)(nil).ResetPassword), ctx, input)
+}
+
+// ResetTwoFactor mocks base method.
+func (m *MockUsecaseItf) ResetTwoFactor(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTwoFactor indicates an expected call of ResetTwoFactor.
+func (mr *MockUsecaseItfMockRecorder) ResetTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTwoFactor", reflect.TypeOf((*MockUsecaseItf)(nil).ResetTwoFactor), ctx, userID)
+}
+
+// RevokeAllSessions mocks base method.
+func (m *MockUsecaseItf) RevokeAllSessions(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAllSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAllSessions indicates an expected call of RevokeAllSessions.
+func (mr *MockUsecaseItfMockRecorder) RevokeAllSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAllSessions", reflect.TypeOf((*MockUsecaseItf)(nil).RevokeAllSessions), ctx, userID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockUsecaseItf) RevokeSession(ctx context.Context, userID, sessionID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userID, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockUsecaseItfMockRecorder) RevokeSession(ctx, userID, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUsecaseItf)(nil).RevokeSession), ctx, userID, sessionID)
+}
+
+// SendPasswordReset mocks base method.
+func (m *MockUsecaseItf) SendPasswordReset(ctx context.Context, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordReset", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordReset indicates an expected call of SendPasswordReset.
+func (mr *MockUsecaseItfMockRecorder) SendPasswordReset(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordReset", reflect.TypeOf((*MockUsecaseItf)(nil).SendPasswordReset), ctx, userID)
+}
+
+// SendPasswordResetEmail mocks base method.
+func (m *MockUsecaseItf) SendPasswordResetEmail(ctx context.Context, login string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPasswordResetEmail", ctx, login)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPasswordResetEmail indicates an expected call of SendPasswordResetEmail.
+func (mr *MockUsecaseItfMockRecorder) SendPasswordResetEmail(ctx, login any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPasswordResetEmail", reflect.TypeOf((*MockUsecaseItf)(nil).SendPasswordResetEmail), ctx, login)
+}
+
+// SetEmployeeActive mocks base method.
+func (m *MockUsecaseItf) SetEmployeeActive(ctx context.Context, id, actorID uint, active bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmployeeActive", ctx, id, actorID, active)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmployeeActive indicates an expected call of SetEmployeeActive.
+func (mr *MockUsecaseItfMockRecorder) SetEmployeeActive(ctx, id, actorID, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmployeeActive", reflect.TypeOf((*MockUsecaseItf)(nil).SetEmployeeActive), ctx, id, actorID, active)
+}
+
+// StartSSO mocks base method.
+func (m *MockUsecaseItf) StartSSO(ctx context.Context) (entity.SSOStart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSSO", ctx)
+	ret0, _ := ret[0].(entity.SSOStart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSSO indicates an expected call of StartSSO.
+func (mr *MockUsecaseItfMockRecorder) StartSSO(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSSO", reflect.TypeOf((*MockUsecaseItf)(nil).StartSSO), ctx)
+}
+
+// UnlockAccount mocks base method.
+func (m *MockUsecaseItf) UnlockAccount(ctx context.Context, userID, actorID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockAccount", ctx, userID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockAccount indicates an expected call of UnlockAccount.
+func (mr *MockUsecaseItfMockRecorder) UnlockAccount(ctx, userID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockAccount", reflect.TypeOf((*MockUsecaseItf)(nil).UnlockAccount), ctx, userID, actorID)
+}
+
+// UnlockIP mocks base method.
+func (m *MockUsecaseItf) UnlockIP(ctx context.Context, ipAddress string, actorID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockIP", ctx, ipAddress, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockIP indicates an expected call of UnlockIP.
+func (mr *MockUsecaseItfMockRecorder) UnlockIP(ctx, ipAddress, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockIP", reflect.TypeOf((*MockUsecaseItf)(nil).UnlockIP), ctx, ipAddress, actorID)
+}
+
+// UpdateEmployee mocks base method.
+func (m *MockUsecaseItf) UpdateEmployee(ctx context.Context, input entity.UpdateEmployeeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployee", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmployee indicates an expected call of UpdateEmployee.
+func (mr *MockUsecaseItfMockRecorder) UpdateEmployee(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployee", reflect.TypeOf((*MockUsecaseItf)(nil).UpdateEmployee), ctx, input)
+}
+
+// UpdateEmployeeProfile mocks base method.
+func (m *MockUsecaseItf) UpdateEmployeeProfile(ctx context.Context, input entity.UpdateEmployeeProfile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmployeeProfile", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmployeeProfile indicates an expected call of UpdateEmployeeProfile.
+func (mr *MockUsecaseItfMockRecorder) UpdateEmployeeProfile(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmployeeProfile", reflect.TypeOf((*MockUsecaseItf)(nil).UpdateEmployeeProfile), ctx, input)
+}
+
+// UpdateOwnProfile mocks base method.
+func (m *MockUsecaseItf) UpdateOwnProfile(ctx context.Context, input entity.UpdateOwnProfileRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOwnProfile", ctx, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOwnProfile indicates an expected call of UpdateOwnProfile.
+func (mr *MockUsecaseItfMockRecorder) UpdateOwnProfile(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOwnProfile", reflect.TypeOf((*MockUsecaseItf)(nil).UpdateOwnProfile), ctx, input)
+}
+
+// VerifyLogin mocks base method.
+func (m *MockUsecaseItf) VerifyLogin(ctx context.Context, input entity.VerifyLoginRequest) (entity.AuthTokens, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyLogin", ctx, input)
+	ret0, _ := ret[0].(entity.AuthTokens)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyLogin indicates an expected call of VerifyLogin.
+func (mr *MockUsecaseItfMockRecorder) VerifyLogin(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyLogin", reflect.TypeOf((*MockUsecaseItf)(nil).VerifyLogin), ctx, input)
+}
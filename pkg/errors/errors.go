@@ -1,6 +1,8 @@
 package errors
 
 import (
+	"strings"
+
 	"github.com/palantir/stacktrace"
 )
 
@@ -17,3 +19,15 @@ var RootCause = stacktrace.RootCause
 var Wrap = stacktrace.Propagate
 var WrapWithCode = stacktrace.PropagateWithCode
 var Wrapf = stacktrace.Propagate
+
+// Summary returns the message of the outermost error that has one, without
+// the stack trace, for reporting an error inside a response body.
+func Summary(err error) string {
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimPrefix(line, "Caused by: ")
+		if line != "" && !strings.HasPrefix(line, " --- at ") {
+			return line
+		}
+	}
+	return ""
+}
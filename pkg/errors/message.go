@@ -25,6 +25,22 @@ var (
 			EN: `Unauthorized Access. You are not authorized to access this resource.`,
 			ID: `Akses Ditolak. Anda Belum Diijinkan Untuk Mengakses Aplikasi.`,
 		},
+		"forbidden": ErrorMessage{
+			EN: `Access Denied. You do not have permission to perform this action.`,
+			ID: `Akses Ditolak. Anda Tidak Memiliki Izin Untuk Melakukan Tindakan Ini.`,
+		},
+		"conflict": ErrorMessage{
+			EN: `Record Was Changed. Please Reload And Try Again.`,
+			ID: `Data Telah Berubah. Mohon Muat Ulang Dan Coba Lagi.`,
+		},
+		"toomanyrequests": ErrorMessage{
+			EN: `Too Many Attempts. Please Try Again Later.`,
+			ID: `Terlalu Banyak Percobaan. Mohon Coba Lagi Nanti.`,
+		},
+		"badgateway": ErrorMessage{
+			EN: `An External Service Is Unavailable. Please Try Again Later.`,
+			ID: `Layanan Eksternal Tidak Tersedia. Mohon Coba Lagi Nanti.`,
+		},
 		"uniqueconst": ErrorMessage{
 			EN: `Record has existed and must be unique. Please Validate Your Input Or Contact Administrator.`,
 			ID: `Data sudah ada. Mohon Cek Kembali Masukkan Anda Atau Hubungi Administrator.`,
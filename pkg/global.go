@@ -1,6 +1,7 @@
 package pkg
 
 import (
+	"math"
 	"time"
 )
 
@@ -34,6 +35,38 @@ func StringPtr(b string) *string {
 	return &b
 }
 
+// StringValue dereferences s, returning "" for nil.
+func StringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func UintPtr(b uint) *uint {
 	return &b
 }
+
+func IntPtr(b int) *int {
+	return &b
+}
+
+func Float64Ptr(b float64) *float64 {
+	return &b
+}
+
+// DistanceMeters returns the great-circle distance between two coordinates
+// using the haversine formula.
+func DistanceMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	const earthRadiusMeters = 6371000
+
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return earthRadiusMeters * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
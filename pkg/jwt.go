@@ -1,32 +1,264 @@
 package pkg
 
 import (
-	"os"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecret = []byte(os.Getenv("JWT_SECRET")) // Replace with env var in production
+// Supported access token signing algorithms
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+var (
+	ErrNoSigningKey = errors.New("no signing key loaded")
+	ErrUnknownKeyID = errors.New("unknown key id")
+)
 
 type CustomClaims struct {
-	UserID   uint   `json:"user_id"`
-	Username string `json:"username"`
-	IsAdmin  bool   `json:"is_admin"`
+	UserID    uint   `json:"user_id"`
+	Username  string `json:"username"`
+	IsAdmin   bool   `json:"is_admin"`
+	Role      string `json:"role"`
+	SessionID uint   `json:"sid"`
+
+	// MustChangePassword limits the token to changing the password
+	MustChangePassword bool `json:"mcp,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWT(userID uint, username string, isAdmin bool) (string, error) {
-	claims := CustomClaims{
-		UserID:   userID,
-		Username: username,
-		IsAdmin:  isAdmin,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
-		},
+// SigningKey is a parsed key pair identified by its kid. It verifies tokens
+// straight away but only signs from ActivatesAt.
+type SigningKey struct {
+	ID          string
+	Algorithm   string
+	PrivateKey  crypto.PrivateKey
+	PublicKey   crypto.PublicKey
+	ActivatesAt time.Time
+}
+
+// JWK is the public half of a signing key as published in the JWKS.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // OKP or EC curve
+	X   string `json:"x,omitempty"`   // OKP public key or EC x coordinate
+	Y   string `json:"y,omitempty"`   // EC y coordinate
+}
+
+// KeySet holds the keys access tokens are verified with. The newest key
+// that has activated signs; a newer one is only published until then, and
+// older ones stay until tokens they signed have expired.
+type KeySet struct {
+	mu   sync.RWMutex
+	keys []SigningKey // newest first
+}
+
+func NewKeySet() *KeySet {
+	return &KeySet{}
+}
+
+// Replace swaps in a new set of keys, newest first.
+func (k *KeySet) Replace(keys []SigningKey) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.keys = keys
+}
+
+// GenerateJWT issues an access token with the user and session claims
+// that is valid until expiresAt, signed with the newest active key.
+func (k *KeySet) GenerateJWT(claims CustomClaims, expiresAt time.Time) (string, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now()
+
+	var key *SigningKey
+	for i := range k.keys {
+		if !k.keys[i].ActivatesAt.After(now) {
+			key = &k.keys[i]
+			break
+		}
+	}
+	if key == nil {
+		return "", ErrNoSigningKey
+	}
+
+	claims.IsAdmin = claims.Role == "admin"
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.PrivateKey)
+}
+
+// Keyfunc finds the public key a token was signed with by its kid. It is
+// meant for jwt.Parse.
+func (k *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	for _, key := range k.keys {
+		if key.ID != kid {
+			continue
+		}
+
+		// The algorithm comes from the key, never from the token header
+		if token.Method.Alg() != key.Algorithm {
+			return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+		}
+
+		return key.PublicKey, nil
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrUnknownKeyID, kid)
+}
+
+// JWKS returns the public keys of the set, newest first.
+func (k *KeySet) JWKS() []JWK {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	result := make([]JWK, 0, len(k.keys))
+	for _, key := range k.keys {
+		jwk := JWK{Kid: key.ID, Alg: key.Algorithm, Use: "sig"}
+
+		switch pub := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+
+		result = append(result, jwk)
+	}
+
+	return result
+}
+
+// GenerateSigningKey creates a key pair for algorithm and returns a random
+// kid with the PKCS#8 private key and PKIX public key in PEM.
+func GenerateSigningKey(algorithm string) (kid, privatePEM, publicPEM string, err error) {
+	var (
+		private crypto.PrivateKey
+		public  crypto.PublicKey
+	)
+
+	switch algorithm {
+	case AlgRS256:
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public = key, &key.PublicKey
+	case AlgEdDSA:
+		pub, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return "", "", "", err
+		}
+		private, public = key, pub
+	default:
+		return "", "", "", fmt.Errorf("unsupported signing algorithm %s", algorithm)
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", "", err
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(jwtSecret)
+	privatePEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))
+	publicPEM = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}))
+
+	return hex.EncodeToString(b), privatePEM, publicPEM, nil
+}
+
+// ParseSigningKey reads a PKCS#8 PEM private key stored by
+// GenerateSigningKey.
+func ParseSigningKey(kid, algorithm, privatePEM string) (SigningKey, error) {
+	block, _ := pem.Decode([]byte(privatePEM))
+	if block == nil {
+		return SigningKey{}, fmt.Errorf("key %s is not valid PEM", kid)
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("key %s: %w", kid, err)
+	}
+
+	key := SigningKey{ID: kid, Algorithm: algorithm, PrivateKey: private}
+
+	switch p := private.(type) {
+	case *rsa.PrivateKey:
+		if algorithm != AlgRS256 {
+			return SigningKey{}, fmt.Errorf("key %s is RSA but marked %s", kid, algorithm)
+		}
+		key.PublicKey = &p.PublicKey
+	case ed25519.PrivateKey:
+		if algorithm != AlgEdDSA {
+			return SigningKey{}, fmt.Errorf("key %s is Ed25519 but marked %s", kid, algorithm)
+		}
+		key.PublicKey = p.Public()
+	default:
+		return SigningKey{}, fmt.Errorf("key %s has an unsupported type", kid)
+	}
+
+	return key, nil
+}
+
+// GenerateRefreshToken returns a random opaque refresh token.
+func GenerateRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashRefreshToken returns the hex SHA-256 of a refresh token, which is
+// what gets stored.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
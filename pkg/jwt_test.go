@@ -0,0 +1,161 @@
+package pkg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+func signingKey(t *testing.T, algorithm string) pkg.SigningKey {
+	t.Helper()
+
+	kid, privatePEM, _, err := pkg.GenerateSigningKey(algorithm)
+	assert.NoError(t, err)
+
+	key, err := pkg.ParseSigningKey(kid, algorithm, privatePEM)
+	assert.NoError(t, err)
+
+	return key
+}
+
+func TestKeySet_GenerateJWT(t *testing.T) {
+	current := signingKey(t, pkg.AlgEdDSA)
+	previous := signingKey(t, pkg.AlgRS256)
+
+	keys := pkg.NewKeySet()
+	keys.Replace([]pkg.SigningKey{current, previous})
+
+	signed, err := keys.GenerateJWT(pkg.CustomClaims{UserID: 3, Role: "admin", SessionID: 7}, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+
+	claims := &pkg.CustomClaims{}
+	token, err := jwt.ParseWithClaims(signed, claims, keys.Keyfunc)
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+
+	// Signed with the newest key
+	assert.Equal(t, current.ID, token.Header["kid"])
+	assert.Equal(t, pkg.AlgEdDSA, token.Method.Alg())
+	assert.Equal(t, uint(3), claims.UserID)
+	assert.Equal(t, uint(7), claims.SessionID)
+	assert.True(t, claims.IsAdmin)
+}
+
+func TestKeySet_GenerateJWT_PublishedKey(t *testing.T) {
+	current := signingKey(t, pkg.AlgRS256)
+	next := signingKey(t, pkg.AlgEdDSA)
+	next.ActivatesAt = time.Now().Add(2 * time.Minute)
+
+	keys := pkg.NewKeySet()
+	keys.Replace([]pkg.SigningKey{next, current})
+
+	// The next key is published and verifies, but does not sign yet
+	signed, err := keys.GenerateJWT(pkg.CustomClaims{UserID: 3, SessionID: 7}, time.Now().Add(time.Minute))
+	assert.NoError(t, err)
+
+	token, err := jwt.Parse(signed, keys.Keyfunc)
+	assert.NoError(t, err)
+	assert.Equal(t, current.ID, token.Header["kid"])
+
+	jwks := keys.JWKS()
+	assert.Len(t, jwks, 2)
+	assert.Equal(t, next.ID, jwks[0].Kid)
+
+	// A key that has not activated never signs, even on its own
+	keys.Replace([]pkg.SigningKey{next})
+	_, err = keys.GenerateJWT(pkg.CustomClaims{UserID: 3, SessionID: 7}, time.Now().Add(time.Minute))
+	assert.ErrorIs(t, err, pkg.ErrNoSigningKey)
+}
+
+func TestKeySet_GenerateJWT_NoKeys(t *testing.T) {
+	_, err := pkg.NewKeySet().GenerateJWT(pkg.CustomClaims{UserID: 3}, time.Now().Add(time.Minute))
+
+	assert.ErrorIs(t, err, pkg.ErrNoSigningKey)
+}
+
+func TestKeySet_Keyfunc(t *testing.T) {
+	current := signingKey(t, pkg.AlgEdDSA)
+	previous := signingKey(t, pkg.AlgRS256)
+	retired := signingKey(t, pkg.AlgEdDSA)
+
+	sign := func(key pkg.SigningKey) string {
+		keys := pkg.NewKeySet()
+		keys.Replace([]pkg.SigningKey{key})
+
+		signed, err := keys.GenerateJWT(pkg.CustomClaims{UserID: 3, SessionID: 7}, time.Now().Add(time.Minute))
+		assert.NoError(t, err)
+		return signed
+	}
+
+	keys := pkg.NewKeySet()
+	keys.Replace([]pkg.SigningKey{current, previous})
+
+	tests := []struct {
+		name        string
+		token       string
+		expectErr   bool
+		errorString string
+	}{
+		{
+			name:  "current key",
+			token: sign(current),
+		},
+		{
+			name:  "previous key still verifies",
+			token: sign(previous),
+		},
+		{
+			name:        "retired key",
+			token:       sign(retired),
+			expectErr:   true,
+			errorString: "unknown key id",
+		},
+		{
+			name: "algorithm in the header does not match the key",
+			token: func() string {
+				// An HS256 token claiming the RSA key's kid
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, pkg.CustomClaims{UserID: 3, SessionID: 7})
+				token.Header["kid"] = previous.ID
+				signed, err := token.SignedString([]byte("secret"))
+				assert.NoError(t, err)
+				return signed
+			}(),
+			expectErr:   true,
+			errorString: "unexpected signing method HS256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := jwt.Parse(tt.token, keys.Keyfunc)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorString)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	current := signingKey(t, pkg.AlgEdDSA)
+	previous := signingKey(t, pkg.AlgRS256)
+
+	keys := pkg.NewKeySet()
+	keys.Replace([]pkg.SigningKey{current, previous})
+
+	jwks := keys.JWKS()
+
+	assert.Len(t, jwks, 2)
+	assert.Equal(t, pkg.JWK{Kty: "OKP", Kid: current.ID, Alg: pkg.AlgEdDSA, Use: "sig", Crv: "Ed25519", X: jwks[0].X}, jwks[0])
+	assert.NotEmpty(t, jwks[0].X)
+	assert.Equal(t, "RSA", jwks[1].Kty)
+	assert.Equal(t, previous.ID, jwks[1].Kid)
+	assert.Equal(t, "AQAB", jwks[1].E)
+	assert.NotEmpty(t, jwks[1].N)
+}
@@ -0,0 +1,90 @@
+package pkg
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// KioskTokenPeriod is how often a kiosk QR token rotates
+const KioskTokenPeriod = 30 * time.Second
+
+var ErrInvalidKioskToken = errors.New("invalid kiosk token")
+
+type KioskTokenClaims struct {
+	KioskID   uint
+	Window    int64 // KioskTokenPeriod slot the token was issued in
+	Nonce     string
+	payload   string
+	signature []byte
+}
+
+// KioskWindow returns the rotation slot t falls in.
+func KioskWindow(t time.Time) int64 {
+	return t.Unix() / int64(KioskTokenPeriod/time.Second)
+}
+
+// IssueKioskToken signs a token of the form kioskID.window.nonce.signature
+// and returns it with the end of its rotation slot.
+func IssueKioskToken(kioskID uint, secret string, now time.Time) (string, time.Time, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+
+	window := KioskWindow(now)
+	payload := fmt.Sprintf("%d.%d.%s", kioskID, window, hex.EncodeToString(b))
+	token := payload + "." + base64.RawURLEncoding.EncodeToString(signKioskPayload(payload, secret))
+	expiresAt := time.Unix((window+1)*int64(KioskTokenPeriod/time.Second), 0)
+
+	return token, expiresAt, nil
+}
+
+// ParseKioskToken splits a token without checking its signature.
+func ParseKioskToken(token string) (*KioskTokenClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return nil, ErrInvalidKioskToken
+	}
+
+	kioskID, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidKioskToken
+	}
+
+	window, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidKioskToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[3])
+	if err != nil || parts[2] == "" {
+		return nil, ErrInvalidKioskToken
+	}
+
+	return &KioskTokenClaims{
+		KioskID:   uint(kioskID),
+		Window:    window,
+		Nonce:     parts[2],
+		payload:   strings.Join(parts[:3], "."),
+		signature: signature,
+	}, nil
+}
+
+// Verify reports whether the token was signed with secret.
+func (c KioskTokenClaims) Verify(secret string) bool {
+	return hmac.Equal(c.signature, signKioskPayload(c.payload, secret))
+}
+
+func signKioskPayload(payload, secret string) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
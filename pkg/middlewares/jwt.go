@@ -1,23 +1,31 @@
 package middlewares
 
 import (
+	"context"
 	"net/http"
-	"os"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecret = []byte(os.Getenv("JWT_SECRET"))
-
 type Claims struct {
-	UserID  uint `json:"user_id"`
-	IsAdmin bool `json:"is_admin"`
+	UserID    uint   `json:"user_id"`
+	IsAdmin   bool   `json:"is_admin"`
+	Role      string `json:"role"`
+	SessionID uint   `json:"sid"`
+
+	MustChangePassword bool `json:"mcp,omitempty"`
 	jwt.RegisteredClaims
 }
 
-func JWTMiddleware() gin.HandlerFunc {
+// RevocationChecker reports whether a session has been logged out or
+// revoked.
+type RevocationChecker func(ctx context.Context, sessionID uint) (bool, error)
+
+// JWTMiddleware verifies access tokens with the public key keyfunc finds
+// for the token's kid.
+func JWTMiddleware(keyfunc jwt.Keyfunc, isRevoked RevocationChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -31,9 +39,7 @@ func JWTMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-			return jwtSecret, nil
-		})
+		token, err := jwt.ParseWithClaims(tokenString, &Claims{}, keyfunc, jwt.WithValidMethods([]string{"RS256", "EdDSA"}))
 
 		if err != nil || !token.Valid {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid or expired token"})
@@ -46,9 +52,28 @@ func JWTMiddleware() gin.HandlerFunc {
 			return
 		}
 
+		// Tokens issued before sessions were added cannot be revoked
+		if claims.SessionID == 0 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "session expired, please log in again"})
+			return
+		}
+
+		revoked, err := isRevoked(c.Request.Context(), claims.SessionID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check session"})
+			return
+		}
+
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "session has been revoked"})
+			return
+		}
+
 		// Inject claims into context
 		c.Set("userID", claims.UserID)
-		c.Set("isAdmin", claims.IsAdmin)
+		c.Set("role", claims.Role)
+		c.Set("sessionID", claims.SessionID)
+		c.Set("mustChangePassword", claims.MustChangePassword)
 		c.Next()
 	}
 }
@@ -0,0 +1,120 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/pkg"
+	"github.com/zuhrulumam/go-hris/pkg/middlewares"
+)
+
+func TestJWTMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	kid, privatePEM, _, err := pkg.GenerateSigningKey(pkg.AlgEdDSA)
+	assert.NoError(t, err)
+	key, err := pkg.ParseSigningKey(kid, pkg.AlgEdDSA, privatePEM)
+	assert.NoError(t, err)
+
+	keys := pkg.NewKeySet()
+	keys.Replace([]pkg.SigningKey{key})
+
+	token := func(claims pkg.CustomClaims, expiresAt time.Time) string {
+		signed, err := keys.GenerateJWT(claims, expiresAt)
+		assert.NoError(t, err)
+		return "Bearer " + signed
+	}
+
+	valid := token(pkg.CustomClaims{UserID: 3, Role: "manager", SessionID: 7}, time.Now().Add(time.Minute))
+
+	tests := []struct {
+		name         string
+		header       string
+		revoked      bool
+		revokedErr   error
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name:         "valid token",
+			header:       valid,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "missing header",
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "missing authorization header",
+		},
+		{
+			name:         "not a bearer token",
+			header:       "Basic abc",
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "invalid token format",
+		},
+		{
+			name:         "expired token",
+			header:       token(pkg.CustomClaims{UserID: 3, SessionID: 7}, time.Now().Add(-time.Minute)),
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "invalid or expired token",
+		},
+		{
+			name:         "token without a session",
+			header:       token(pkg.CustomClaims{UserID: 3}, time.Now().Add(time.Minute)),
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "session expired, please log in again",
+		},
+		{
+			name:         "revoked session",
+			header:       valid,
+			revoked:      true,
+			expectStatus: http.StatusUnauthorized,
+			expectError:  "session has been revoked",
+		},
+		{
+			name:         "revocation check fails",
+			header:       valid,
+			revokedErr:   errors.New("redis down"),
+			expectStatus: http.StatusInternalServerError,
+			expectError:  "failed to check session",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var checked uint
+			isRevoked := func(ctx context.Context, sessionID uint) (bool, error) {
+				checked = sessionID
+				return tt.revoked, tt.revokedErr
+			}
+
+			r := gin.New()
+			r.GET("/api/me", middlewares.JWTMiddleware(keys.Keyfunc, isRevoked), func(c *gin.Context) {
+				assert.Equal(t, uint(3), c.GetUint("userID"))
+				assert.Equal(t, "manager", c.GetString("role"))
+				assert.Equal(t, uint(7), c.GetUint("sessionID"))
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/me", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectError)
+			}
+			if tt.revoked || tt.revokedErr != nil {
+				assert.Equal(t, uint(7), checked)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePasswordChange keeps users who must change their password, as set
+// by JWTMiddleware, to the routes in allowed until they have done so.
+func RequirePasswordChange(allowed ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("mustChangePassword") {
+			c.Next()
+			return
+		}
+
+		for _, path := range allowed {
+			if c.FullPath() == path {
+				c.Next()
+				return
+			}
+		}
+
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "password change required"})
+	}
+}
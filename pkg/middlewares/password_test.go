@@ -0,0 +1,69 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/pkg/middlewares"
+)
+
+func TestRequirePasswordChange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		mustChangePassword bool
+		path               string
+		expectStatus       int
+	}{
+		{
+			name:         "password already changed",
+			path:         "/api/attendance",
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:               "allowed route",
+			mustChangePassword: true,
+			path:               "/api/password",
+			expectStatus:       http.StatusOK,
+		},
+		{
+			name:               "other route",
+			mustChangePassword: true,
+			path:               "/api/attendance",
+			expectStatus:       http.StatusForbidden,
+		},
+		{
+			name:               "route that only starts with an allowed path",
+			mustChangePassword: true,
+			path:               "/api/password/history",
+			expectStatus:       http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				c.Set("mustChangePassword", tt.mustChangePassword)
+			})
+			r.Use(middlewares.RequirePasswordChange("/api/password", "/api/logout"))
+
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			r.GET("/api/password", ok)
+			r.GET("/api/password/history", ok)
+			r.GET("/api/attendance", ok)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), "password change required")
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PermissionChecker reports whether role grants permission.
+type PermissionChecker func(ctx context.Context, role string, permission string) (bool, error)
+
+// RequirePermission rejects requests whose role, set by JWTMiddleware, does
+// not grant permission.
+func RequirePermission(check PermissionChecker, permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		allowed, err := check(c.Request.Context(), c.GetString("role"), permission)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check permission"})
+			return
+		}
+
+		if !allowed {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "missing permission " + permission})
+			return
+		}
+
+		c.Next()
+	}
+}
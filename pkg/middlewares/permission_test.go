@@ -0,0 +1,72 @@
+package middlewares_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/pkg/middlewares"
+)
+
+func TestRequirePermission(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		role         string
+		allowed      bool
+		checkErr     error
+		expectStatus int
+		expectError  string
+	}{
+		{
+			name:         "role grants the permission",
+			role:         "payroll_admin",
+			allowed:      true,
+			expectStatus: http.StatusOK,
+		},
+		{
+			name:         "role lacks the permission",
+			role:         "employee",
+			expectStatus: http.StatusForbidden,
+			expectError:  "missing permission payroll:run",
+		},
+		{
+			name:         "permission lookup fails",
+			role:         "hr",
+			checkErr:     errors.New("db down"),
+			expectStatus: http.StatusInternalServerError,
+			expectError:  "failed to check permission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(ctx context.Context, role string, permission string) (bool, error) {
+				assert.Equal(t, tt.role, role)
+				assert.Equal(t, "payroll:run", permission)
+				return tt.allowed, tt.checkErr
+			}
+
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				c.Set("role", tt.role)
+			})
+			r.POST("/api/payroll/run", middlewares.RequirePermission(check, "payroll:run"), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/payroll/run", nil))
+
+			assert.Equal(t, tt.expectStatus, w.Code)
+			if tt.expectError != "" {
+				assert.Contains(t, w.Body.String(), tt.expectError)
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package pkg_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zuhrulumam/go-hris/pkg"
+)
+
+// rfc6238Secret is the SHA-1 seed "12345678901234567890" from RFC 6238
+// appendix B, base32 encoded.
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B lists 8-digit codes; ours are the last 6 digits
+	tests := []struct {
+		unix   int64
+		expect string
+	}{
+		{unix: 59, expect: "287082"},
+		{unix: 1111111109, expect: "081804"},
+		{unix: 1111111111, expect: "050471"},
+		{unix: 1234567890, expect: "005924"},
+		{unix: 2000000000, expect: "279037"},
+		{unix: 20000000000, expect: "353130"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expect, func(t *testing.T) {
+			code, err := pkg.TOTPCode(rfc6238Secret, pkg.TOTPStep(time.Unix(tt.unix, 0)))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, code)
+		})
+	}
+}
+
+func TestTOTPCode_InvalidSecret(t *testing.T) {
+	_, err := pkg.TOTPCode("not base32!", 1)
+
+	assert.Error(t, err)
+}
+
+func TestValidateTOTP(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := pkg.TOTPStep(now)
+
+	tests := []struct {
+		name       string
+		secret     string
+		code       string
+		lastUsed   int64
+		expectStep int64
+		expectOK   bool
+	}{
+		{
+			name:       "current code",
+			code:       "050471",
+			expectStep: step,
+			expectOK:   true,
+		},
+		{
+			name:       "lowercase secret and spaces around the code",
+			secret:     "gezdgnbvgy3tqojqgezdgnbvgy3tqojq",
+			code:       " 050471 ",
+			expectStep: step,
+			expectOK:   true,
+		},
+		{
+			name:       "previous period allowed for clock drift",
+			code:       "081804",
+			expectStep: step - 1,
+			expectOK:   true,
+		},
+		{
+			name:     "replayed code",
+			code:     "050471",
+			lastUsed: step,
+		},
+		{
+			name:     "code older than the last one used",
+			code:     "081804",
+			lastUsed: step,
+		},
+		{
+			name: "code from outside the skew",
+			code: "005924",
+		},
+		{
+			name: "wrong length",
+			code: "50471",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := rfc6238Secret
+			if tt.secret != "" {
+				secret = tt.secret
+			}
+
+			matched, ok := pkg.ValidateTOTP(secret, tt.code, now, tt.lastUsed)
+
+			assert.Equal(t, tt.expectOK, ok)
+			assert.Equal(t, tt.expectStep, matched)
+		})
+	}
+}
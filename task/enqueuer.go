@@ -0,0 +1,10 @@
+package task
+
+import "github.com/hibiken/asynq"
+
+// Enqueuer queues tasks for the worker. *asynq.Client implements it.
+//
+//go:generate mockgen -source=task/enqueuer.go -destination=mocks/task/mock_enqueuer.go -package=mocks
+type Enqueuer interface {
+	Enqueue(task *asynq.Task, opts ...asynq.Option) (*asynq.TaskInfo, error)
+}
@@ -6,7 +6,10 @@ import (
 	"github.com/hibiken/asynq"
 )
 
-const TypeCreatePayroll = "payroll:create"
+const (
+	TypeCreatePayroll     = "payroll:create"
+	TypeSendPasswordReset = "password:send_reset"
+)
 
 type CreatePayrollPayload struct {
 	PeriodID uint
@@ -25,3 +28,17 @@ func NewCreatePayrollTask(periodID, userID, jobID uint) (*asynq.Task, error) {
 	}
 	return asynq.NewTask(TypeCreatePayroll, payload), nil
 }
+
+// SendPasswordResetPayload carries the username or email address a reset
+// link was asked for. The worker looks the account up.
+type SendPasswordResetPayload struct {
+	Login string
+}
+
+func NewSendPasswordResetTask(login string) (*asynq.Task, error) {
+	payload, err := json.Marshal(SendPasswordResetPayload{Login: login})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeSendPasswordReset, payload), nil
+}